@@ -54,14 +54,27 @@ func MapCircuitToRestModel(_ *network.Network, _ api.RequestContext, circuit *ne
 		path.Links = append(path.Links, ToEntityRef(link.Id, link, LinkLinkFactory))
 	}
 
+	var standbyPath *rest_model.CircuitDetailStandbyPath
+	if circuit.StandbyPath != nil {
+		standbyPath = &rest_model.CircuitDetailStandbyPath{}
+		for _, node := range circuit.StandbyPath.Nodes {
+			standbyPath.Nodes = append(standbyPath.Nodes, ToEntityRef(node.Name, node, RouterLinkFactory))
+		}
+		for _, link := range circuit.StandbyPath.Links {
+			standbyPath.Links = append(standbyPath.Links, ToEntityRef(link.Id, link, LinkLinkFactory))
+		}
+	}
+
 	createdAt := strfmt.DateTime(circuit.CreatedAt)
 	ret := &rest_model.CircuitDetail{
-		ID:         &circuit.Id,
-		ClientID:   circuit.ClientId,
-		Path:       path,
-		Service:    ToEntityRef(circuit.Service.Name, circuit.Service, ServiceLinkFactory),
-		Terminator: ToEntityRef(circuit.Terminator.GetId(), circuit.Terminator, TerminatorLinkFactory),
-		CreatedAt:  &createdAt,
+		ID:          &circuit.Id,
+		ClientID:    circuit.ClientId,
+		Path:        path,
+		StandbyPath: standbyPath,
+		Service:     ToEntityRef(circuit.Service.Name, circuit.Service, ServiceLinkFactory),
+		Terminator:  ToEntityRef(circuit.Terminator.GetId(), circuit.Terminator, TerminatorLinkFactory),
+		CreatedAt:   &createdAt,
+		Pinned:      circuit.Pinned.Get(),
 	}
 
 	return ret, nil
@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/fabric/rest_model"
 	"github.com/openziti/fabric/rest_server/operations"
 	"github.com/openziti/fabric/rest_server/operations/circuit"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
 	"sort"
 )
@@ -54,6 +55,10 @@ func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.CircuitRerouteCircuitHandler = circuit.RerouteCircuitHandlerFunc(func(params circuit.RerouteCircuitParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Reroute(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
 }
 
 func (r *CircuitRouter) ListCircuits(n *network.Network, rc api.RequestContext) {
@@ -100,3 +105,19 @@ func (r *CircuitRouter) Delete(network *network.Network, rc api.RequestContext,
 		return network.RemoveCircuit(id, p.Options.Immediate)
 	}))
 }
+
+func (r *CircuitRouter) Reroute(n *network.Network, rc api.RequestContext, p circuit.RerouteCircuitParams) {
+	Detail(rc, func(rc api.RequestContext, id string) (interface{}, error) {
+		if _, found := n.GetCircuit(id); !found {
+			return nil, boltz.NewNotFoundError("circuit", "id", id)
+		}
+		modelCircuit, err := n.PinCircuitPath(id, p.Reroute.Path, p.Reroute.Pin)
+		if fe, ok := err.(*errorz.FieldError); ok {
+			return nil, errorz.NewFieldApiError(fe)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return MapCircuitToRestModel(n, rc, modelCircuit)
+	})
+}
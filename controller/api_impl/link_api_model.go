@@ -41,7 +41,7 @@ func (factory *LinkLinkFactoryIml) Links(entity LinkEntity) rest_model.Links {
 	return links
 }
 
-func MapLinkToRestModel(_ *network.Network, _ api.RequestContext, link *network.Link) (*rest_model.LinkDetail, error) {
+func MapLinkToRestModel(n *network.Network, _ api.RequestContext, link *network.Link) (*rest_model.LinkDetail, error) {
 	staticCost := int64(link.StaticCost)
 	linkState := link.CurrentState()
 	linkStateStr := ""
@@ -62,6 +62,16 @@ func MapLinkToRestModel(_ *network.Network, _ api.RequestContext, link *network.
 		State:         &linkStateStr,
 		StaticCost:    &staticCost,
 		Protocol:      &link.Protocol,
+
+		CostTags:           link.CostTags,
+		ExcludedByCostTags: link.IsExcludedByCostTags(),
+	}
+
+	if flapDamping := n.GetLinkFlapDampingOptions(); flapDamping.Enabled {
+		ret.FlapPenalty = link.GetFlapPenalty()
+		ret.FlapSuppressed = link.IsFlapSuppressed()
+		ret.FlapSuppressThreshold = flapDamping.SuppressThreshold
+		ret.FlapReuseThreshold = flapDamping.ReuseThreshold
 	}
 	return ret, nil
 }
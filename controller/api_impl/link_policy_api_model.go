@@ -0,0 +1,141 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/idgen"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/openziti/foundation/v2/stringz"
+)
+
+const EntityNameLinkPolicy = "link-policies"
+
+var LinkPolicyLinkFactory = NewBasicLinkFactory(EntityNameLinkPolicy)
+
+func MapCreateLinkPolicyToModel(policy *rest_model.LinkPolicyCreate) *network.LinkPolicy {
+	ret := &network.LinkPolicy{
+		BaseEntity: models.BaseEntity{
+			Id:   idgen.New(),
+			Tags: TagsOrDefault(policy.Tags),
+		},
+		Name:               stringz.OrEmpty(policy.Name),
+		SourceRouters:      policy.SourceRouters,
+		DestinationRouters: policy.DestinationRouters,
+		MatchTags:          policy.MatchTags,
+		Action:             string(policy.Action),
+	}
+
+	return ret
+}
+
+func MapUpdateLinkPolicyToModel(id string, policy *rest_model.LinkPolicyUpdate) *network.LinkPolicy {
+	ret := &network.LinkPolicy{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(policy.Tags),
+			Id:   id,
+		},
+		Name:               stringz.OrEmpty(policy.Name),
+		SourceRouters:      policy.SourceRouters,
+		DestinationRouters: policy.DestinationRouters,
+		MatchTags:          policy.MatchTags,
+		Action:             string(policy.Action),
+	}
+
+	return ret
+}
+
+func MapPatchLinkPolicyToModel(id string, policy *rest_model.LinkPolicyPatch) *network.LinkPolicy {
+	ret := &network.LinkPolicy{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(policy.Tags),
+			Id:   id,
+		},
+		Name:               policy.Name,
+		SourceRouters:      policy.SourceRouters,
+		DestinationRouters: policy.DestinationRouters,
+		MatchTags:          policy.MatchTags,
+		Action:             string(policy.Action),
+	}
+
+	return ret
+}
+
+type LinkPolicyModelMapper struct{}
+
+func (LinkPolicyModelMapper) ToApi(_ *network.Network, _ api.RequestContext, policy *network.LinkPolicy) (interface{}, error) {
+	action := rest_model.LinkPolicyAction(policy.Action)
+	return &rest_model.LinkPolicyDetail{
+		BaseEntity:         BaseEntityToRestModel(policy, LinkPolicyLinkFactory),
+		Name:               &policy.Name,
+		SourceRouters:      policy.SourceRouters,
+		DestinationRouters: policy.DestinationRouters,
+		MatchTags:          policy.MatchTags,
+		Action:             &action,
+	}, nil
+}
+
+// MapLinkPolicyPreviewRequestToModel converts the policies in a preview request. If the request doesn't contain a
+// policy list, nil is returned, so that the stored policies are previewed.
+func MapLinkPolicyPreviewRequestToModel(request *rest_model.LinkPolicyPreviewRequest) []*network.LinkPolicy {
+	if request == nil || request.Policies == nil {
+		return nil
+	}
+
+	result := make([]*network.LinkPolicy, 0, len(request.Policies))
+	for _, policy := range request.Policies {
+		result = append(result, &network.LinkPolicy{
+			Name:               stringz.OrEmpty(policy.Name),
+			SourceRouters:      policy.SourceRouters,
+			DestinationRouters: policy.DestinationRouters,
+			MatchTags:          policy.MatchTags,
+			Action:             string(policy.Action),
+		})
+	}
+	return result
+}
+
+func MapLinkPolicyPreviewToRestModel(links []*network.LinkPolicyPreview) *rest_model.LinkPolicyPreview {
+	ret := &rest_model.LinkPolicyPreview{
+		Links: []*rest_model.LinkPolicyPreviewLink{},
+	}
+
+	for _, link := range links {
+		linked := link.Linked
+		restLink := &rest_model.LinkPolicyPreviewLink{
+			SourceRouter: ToEntityRef(link.Src.Name, link.Src, RouterLinkFactory),
+			DestRouter:   ToEntityRef(link.Dst.Name, link.Dst, RouterLinkFactory),
+			Linked:       &linked,
+		}
+		for _, policy := range link.Policies {
+			if policy.Id == "" {
+				// policies submitted for preview aren't stored, so they can only be referenced by name
+				restLink.Policies = append(restLink.Policies, &rest_model.EntityRef{
+					Entity: EntityNameLinkPolicy,
+					Name:   policy.Name,
+				})
+			} else {
+				restLink.Policies = append(restLink.Policies, ToEntityRef(policy.Name, policy, LinkPolicyLinkFactory))
+			}
+		}
+		ret.Links = append(ret.Links, restLink)
+	}
+
+	return ret
+}
@@ -0,0 +1,120 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/openziti/fabric/rest_server/operations"
+	"github.com/openziti/fabric/rest_server/operations/link_policy"
+	"github.com/openziti/foundation/v2/errorz"
+)
+
+func init() {
+	r := NewLinkPolicyRouter()
+	AddRouter(r)
+}
+
+type LinkPolicyRouter struct {
+	BasePath string
+}
+
+func NewLinkPolicyRouter() *LinkPolicyRouter {
+	return &LinkPolicyRouter{
+		BasePath: "/" + EntityNameLinkPolicy,
+	}
+}
+
+func (r *LinkPolicyRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.LinkPolicyDeleteLinkPolicyHandler = link_policy.DeleteLinkPolicyHandlerFunc(func(params link_policy.DeleteLinkPolicyParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkPolicyDetailLinkPolicyHandler = link_policy.DetailLinkPolicyHandlerFunc(func(params link_policy.DetailLinkPolicyParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkPolicyListLinkPoliciesHandler = link_policy.ListLinkPoliciesHandlerFunc(func(params link_policy.ListLinkPoliciesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListLinkPolicies, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.LinkPolicyUpdateLinkPolicyHandler = link_policy.UpdateLinkPolicyHandlerFunc(func(params link_policy.UpdateLinkPolicyParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkPolicyCreateLinkPolicyHandler = link_policy.CreateLinkPolicyHandlerFunc(func(params link_policy.CreateLinkPolicyParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.LinkPolicyPatchLinkPolicyHandler = link_policy.PatchLinkPolicyHandlerFunc(func(params link_policy.PatchLinkPolicyParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkPolicyPreviewLinkPoliciesHandler = link_policy.PreviewLinkPoliciesHandlerFunc(func(params link_policy.PreviewLinkPoliciesParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Preview(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *LinkPolicyRouter) ListLinkPolicies(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*network.LinkPolicy](n, rc, n.Managers.LinkPolicies, LinkPolicyModelMapper{})
+}
+
+func (r *LinkPolicyRouter) Detail(n *network.Network, rc api.RequestContext) {
+	DetailWithHandler[*network.LinkPolicy](n, rc, n.Managers.LinkPolicies, LinkPolicyModelMapper{})
+}
+
+func (r *LinkPolicyRouter) Create(n *network.Network, rc api.RequestContext, params link_policy.CreateLinkPolicyParams) {
+	Create(rc, LinkPolicyLinkFactory, func() (string, error) {
+		policy := MapCreateLinkPolicyToModel(params.LinkPolicy)
+		if err := n.LinkPolicies.Create(policy); err != nil {
+			return "", err
+		}
+		return policy.Id, nil
+	})
+}
+
+func (r *LinkPolicyRouter) Delete(n *network.Network, rc api.RequestContext) {
+	DeleteWithHandler(rc, n.Managers.LinkPolicies)
+}
+
+func (r *LinkPolicyRouter) Update(n *network.Network, rc api.RequestContext, params link_policy.UpdateLinkPolicyParams) {
+	Update(rc, func(id string) error {
+		return n.Managers.LinkPolicies.Update(MapUpdateLinkPolicyToModel(params.ID, params.LinkPolicy), nil)
+	})
+}
+
+func (r *LinkPolicyRouter) Patch(n *network.Network, rc api.RequestContext, params link_policy.PatchLinkPolicyParams) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return n.Managers.LinkPolicies.Update(MapPatchLinkPolicyToModel(params.ID, params.LinkPolicy), fields.FilterMaps("tags"))
+	})
+}
+
+func (r *LinkPolicyRouter) Preview(n *network.Network, rc api.RequestContext, params link_policy.PreviewLinkPoliciesParams) {
+	links, err := n.PreviewLinkPolicies(MapLinkPolicyPreviewRequestToModel(params.Preview))
+	if fe, ok := err.(*errorz.FieldError); ok {
+		rc.RespondWithFieldError(fe)
+		return
+	}
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	RespondWithOk(rc, MapLinkPolicyPreviewToRestModel(links), &rest_model.Meta{})
+}
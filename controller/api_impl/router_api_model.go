@@ -17,6 +17,7 @@
 package api_impl
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/network"
 
@@ -52,10 +53,12 @@ func MapCreateRouterToModel(router *rest_model.RouterCreate) *network.Router {
 			Id:   stringz.OrEmpty(router.ID),
 			Tags: TagsOrDefault(router.Tags),
 		},
-		Name:        stringz.OrEmpty(router.Name),
-		Fingerprint: router.Fingerprint,
-		Cost:        uint16(Int64OrDefault(router.Cost)),
-		NoTraversal: BoolOrDefault(router.NoTraversal),
+		Name:           stringz.OrEmpty(router.Name),
+		Fingerprint:    router.Fingerprint,
+		Cost:           uint16(Int64OrDefault(router.Cost)),
+		NoTraversal:    BoolOrDefault(router.NoTraversal),
+		Draining:       router.Draining,
+		RoleAttributes: router.RoleAttributes,
 	}
 
 	return ret
@@ -67,10 +70,12 @@ func MapUpdateRouterToModel(id string, router *rest_model.RouterUpdate) *network
 			Tags: TagsOrDefault(router.Tags),
 			Id:   id,
 		},
-		Name:        stringz.OrEmpty(router.Name),
-		Fingerprint: router.Fingerprint,
-		Cost:        uint16(Int64OrDefault(router.Cost)),
-		NoTraversal: BoolOrDefault(router.NoTraversal),
+		Name:           stringz.OrEmpty(router.Name),
+		Fingerprint:    router.Fingerprint,
+		Cost:           uint16(Int64OrDefault(router.Cost)),
+		NoTraversal:    BoolOrDefault(router.NoTraversal),
+		Draining:       router.Draining,
+		RoleAttributes: router.RoleAttributes,
 	}
 
 	return ret
@@ -82,10 +87,12 @@ func MapPatchRouterToModel(id string, router *rest_model.RouterPatch) *network.R
 			Tags: TagsOrDefault(router.Tags),
 			Id:   id,
 		},
-		Name:        router.Name,
-		Fingerprint: router.Fingerprint,
-		Cost:        uint16(Int64OrDefault(router.Cost)),
-		NoTraversal: BoolOrDefault(router.NoTraversal),
+		Name:           router.Name,
+		Fingerprint:    router.Fingerprint,
+		Cost:           uint16(Int64OrDefault(router.Cost)),
+		NoTraversal:    BoolOrDefault(router.NoTraversal),
+		Draining:       BoolOrDefault(router.Draining),
+		RoleAttributes: router.RoleAttributes,
 	}
 
 	return ret
@@ -110,13 +117,25 @@ func (RouterModelMapper) ToApi(n *network.Network, _ api.RequestContext, router
 	isConnected := connected != nil
 	cost := int64(router.Cost)
 	ret := &rest_model.RouterDetail{
-		BaseEntity:  BaseEntityToRestModel(router, RouterLinkFactory),
-		Fingerprint: router.Fingerprint,
-		Name:        &router.Name,
-		Connected:   &isConnected,
-		VersionInfo: restVersionInfo,
-		Cost:        &cost,
-		NoTraversal: &router.NoTraversal,
+		BaseEntity:     BaseEntityToRestModel(router, RouterLinkFactory),
+		Fingerprint:    router.Fingerprint,
+		Name:           &router.Name,
+		Connected:      &isConnected,
+		VersionInfo:    restVersionInfo,
+		Cost:           &cost,
+		NoTraversal:    &router.NoTraversal,
+		Draining:       router.Draining,
+		RoleAttributes: router.RoleAttributes,
+	}
+
+	if router.Draining {
+		remaining := int64(n.GetRouterCircuitCount(router.Id))
+		ret.DrainRemainingCircuits = &remaining
+	}
+
+	if !isConnected && router.LastDisconnected != nil {
+		lastDisconnected := strfmt.DateTime(*router.LastDisconnected)
+		ret.LastDisconnected = &lastDisconnected
 	}
 
 	if connected != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/idgen"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/xt"
 
 	"github.com/openziti/fabric/rest_model"
 
@@ -52,8 +53,19 @@ func MapCreateServiceToModel(service *rest_model.ServiceCreate) *network.Service
 		BaseEntity: models.BaseEntity{
 			Tags: TagsOrDefault(service.Tags),
 		},
-		Name:               stringz.OrEmpty(service.Name),
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 stringz.OrEmpty(service.Name),
+		TerminatorStrategy:   service.TerminatorStrategy,
+		AllowedRouters:       service.AllowedRouters,
+		DeniedRouters:        service.DeniedRouters,
+		MaxLinkCount:         uint32(service.MaxLinkCount),
+		WaypointRouters:      service.WaypointRouters,
+		MaxCircuits:          uint32(service.MaxCircuits),
+		MaxCircuitsPerClient: uint32(service.MaxCircuitsPerClient),
+		CircuitRateLimit:     uint32(service.CircuitRateLimit),
+		CircuitRateBurst:     uint32(service.CircuitRateBurst),
+		PinnedPath:           service.PinnedPath,
+		PinnedPathFallback:   service.PinnedPathFallback,
+		TrafficSplit:         MapTrafficSplitToModel(service.TrafficSplit),
 	}
 
 	if ret.Id == "" {
@@ -73,8 +85,19 @@ func MapUpdateServiceToModel(id string, service *rest_model.ServiceUpdate) *netw
 			Tags: TagsOrDefault(service.Tags),
 			Id:   id,
 		},
-		Name:               stringz.OrEmpty(service.Name),
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 stringz.OrEmpty(service.Name),
+		TerminatorStrategy:   service.TerminatorStrategy,
+		AllowedRouters:       service.AllowedRouters,
+		DeniedRouters:        service.DeniedRouters,
+		MaxLinkCount:         uint32(service.MaxLinkCount),
+		WaypointRouters:      service.WaypointRouters,
+		MaxCircuits:          uint32(service.MaxCircuits),
+		MaxCircuitsPerClient: uint32(service.MaxCircuitsPerClient),
+		CircuitRateLimit:     uint32(service.CircuitRateLimit),
+		CircuitRateBurst:     uint32(service.CircuitRateBurst),
+		PinnedPath:           service.PinnedPath,
+		PinnedPathFallback:   service.PinnedPathFallback,
+		TrafficSplit:         MapTrafficSplitToModel(service.TrafficSplit),
 	}
 
 	return ret
@@ -86,8 +109,19 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *networ
 			Tags: TagsOrDefault(service.Tags),
 			Id:   id,
 		},
-		Name:               service.Name,
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 service.Name,
+		TerminatorStrategy:   service.TerminatorStrategy,
+		AllowedRouters:       service.AllowedRouters,
+		DeniedRouters:        service.DeniedRouters,
+		MaxLinkCount:         uint32(service.MaxLinkCount),
+		WaypointRouters:      service.WaypointRouters,
+		MaxCircuits:          uint32(service.MaxCircuits),
+		MaxCircuitsPerClient: uint32(service.MaxCircuitsPerClient),
+		CircuitRateLimit:     uint32(service.CircuitRateLimit),
+		CircuitRateBurst:     uint32(service.CircuitRateBurst),
+		PinnedPath:           service.PinnedPath,
+		PinnedPathFallback:   service.PinnedPathFallback,
+		TrafficSplit:         MapTrafficSplitToModel(service.TrafficSplit),
 	}
 
 	return ret
@@ -97,8 +131,136 @@ type ServiceModelMapper struct{}
 
 func (ServiceModelMapper) ToApi(_ *network.Network, _ api.RequestContext, service *network.Service) (interface{}, error) {
 	return &rest_model.ServiceDetail{
-		BaseEntity:         BaseEntityToRestModel(service, ServiceLinkFactory),
-		Name:               &service.Name,
-		TerminatorStrategy: &service.TerminatorStrategy,
+		BaseEntity:           BaseEntityToRestModel(service, ServiceLinkFactory),
+		Name:                 &service.Name,
+		TerminatorStrategy:   &service.TerminatorStrategy,
+		AllowedRouters:       service.AllowedRouters,
+		DeniedRouters:        service.DeniedRouters,
+		MaxLinkCount:         int64(service.MaxLinkCount),
+		WaypointRouters:      service.WaypointRouters,
+		MaxCircuits:          int64(service.MaxCircuits),
+		MaxCircuitsPerClient: int64(service.MaxCircuitsPerClient),
+		CircuitRateLimit:     int64(service.CircuitRateLimit),
+		CircuitRateBurst:     int64(service.CircuitRateBurst),
+		PinnedPath:           service.PinnedPath,
+		PinnedPathFallback:   service.PinnedPathFallback,
+		TrafficSplit:         MapTrafficSplitToRestModel(service.TrafficSplit),
 	}, nil
 }
+
+func MapTrafficSplitToModel(split *rest_model.TrafficSplit) *xt.TrafficSplit {
+	if split == nil {
+		return nil
+	}
+	result := &xt.TrafficSplit{
+		StickyClients: split.StickyClients,
+	}
+	for _, group := range split.Groups {
+		if group == nil {
+			continue
+		}
+		var weight uint32
+		if group.Weight != nil {
+			weight = uint32(*group.Weight)
+		}
+		result.Groups = append(result.Groups, &xt.TrafficSplitGroup{
+			Name:           stringz.OrEmpty(group.Name),
+			Weight:         weight,
+			TerminatorTags: group.TerminatorTags,
+		})
+	}
+	return result
+}
+
+func MapTrafficSplitToRestModel(split *xt.TrafficSplit) *rest_model.TrafficSplit {
+	if split == nil {
+		return nil
+	}
+	result := &rest_model.TrafficSplit{
+		Groups:        []*rest_model.TrafficSplitGroup{},
+		StickyClients: split.StickyClients,
+	}
+	for _, group := range split.Groups {
+		name := group.Name
+		weight := int64(group.Weight)
+		result.Groups = append(result.Groups, &rest_model.TrafficSplitGroup{
+			Name:           &name,
+			Weight:         &weight,
+			TerminatorTags: group.TerminatorTags,
+		})
+	}
+	return result
+}
+
+func MapRoutePreviewToRestModel(n *network.Network, preview *network.RoutePreview) *rest_model.RoutePreview {
+	ret := &rest_model.RoutePreview{
+		Service:      ToEntityRef(preview.Service.Name, preview.Service, ServiceLinkFactory),
+		SourceRouter: ToEntityRef(preview.SourceRouter.Name, preview.SourceRouter, RouterLinkFactory),
+		InstanceID:   preview.InstanceId,
+		Strategy:     &preview.Strategy,
+		Candidates:   []*rest_model.RoutePreviewCandidate{},
+	}
+
+	if preview.Err != nil {
+		ret.Error = preview.Err.Error()
+	}
+
+	if preview.Selected != nil {
+		ret.SelectedTerminator = ToEntityRef(preview.Selected.Terminator.Id, preview.Selected.Terminator, TerminatorLinkFactory)
+	}
+
+	if preview.Path != nil {
+		ret.Path = &rest_model.RoutePreviewPath{}
+		for _, node := range preview.Path.Nodes {
+			ret.Path.Nodes = append(ret.Path.Nodes, ToEntityRef(node.Name, node, RouterLinkFactory))
+		}
+		for _, link := range preview.Path.Links {
+			ret.Path.Links = append(ret.Path.Links, ToEntityRef(link.Id, link, LinkLinkFactory))
+		}
+	}
+
+	for _, candidate := range preview.Candidates {
+		terminator := candidate.Terminator
+		staticCost := rest_model.TerminatorCost(int64(terminator.Cost))
+		dynamicCost := rest_model.TerminatorCost(int64(candidate.DynamicCost))
+		precedence := MapPrecedenceToRestModel(terminator.Precedence)
+		if candidate.Routing != nil {
+			precedence = MapPrecedenceToRestModel(candidate.Routing.GetPrecedence())
+		}
+		selected := candidate == preview.Selected
+
+		// terminators can't exist without their router, so this will only fail if the router was just deleted
+		router, err := n.Managers.Routers.Read(terminator.Router)
+		if err != nil {
+			router = &network.Router{BaseEntity: models.BaseEntity{Id: terminator.Router}, Name: terminator.Router}
+		}
+
+		restCandidate := &rest_model.RoutePreviewCandidate{
+			Terminator:  ToEntityRef(terminator.Id, terminator, TerminatorLinkFactory),
+			Router:      ToEntityRef(router.Name, router, RouterLinkFactory),
+			InstanceID:  &terminator.InstanceId,
+			Precedence:  &precedence,
+			StaticCost:  &staticCost,
+			DynamicCost: &dynamicCost,
+			PathCost:    int64(candidate.PathCost),
+			Selected:    &selected,
+			SkipReason:  string(candidate.SkipReason),
+		}
+
+		if candidate.Routing != nil {
+			restCandidate.RouteCost = int64(candidate.Routing.RouteCost)
+		}
+
+		if candidate.Err != nil {
+			restCandidate.SkipDetail = candidate.Err.Error()
+		}
+
+		for _, node := range candidate.Path {
+			restCandidate.Path = append(restCandidate.Path, ToEntityRef(node.Name, node, RouterLinkFactory))
+		}
+
+		ret.Candidates = append(ret.Candidates, restCandidate)
+	}
+
+	return ret
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/fabric/rest_server/operations"
 	"github.com/openziti/fabric/rest_server/operations/service"
+	"github.com/openziti/foundation/v2/stringz"
 )
 
 func init() {
@@ -68,6 +69,10 @@ func (r *ServiceRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.ServiceListServiceTerminatorsHandler = service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.ServicePreviewServiceRouteHandler = service.PreviewServiceRouteHandlerFunc(func(params service.PreviewServiceRouteParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.PreviewRoute(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
 }
 
 func (r *ServiceRouter) ListServices(n *network.Network, rc api.RequestContext) {
@@ -101,10 +106,20 @@ func (r *ServiceRouter) Update(n *network.Network, rc api.RequestContext, params
 
 func (r *ServiceRouter) Patch(n *network.Network, rc api.RequestContext, params service.PatchServiceParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return n.Managers.Services.Update(MapPatchServiceToModel(params.ID, params.Service), fields.FilterMaps("tags"))
+		return n.Managers.Services.Update(MapPatchServiceToModel(params.ID, params.Service), fields.FilterMaps("tags", "trafficSplit"))
 	})
 }
 
 func (r *ServiceRouter) listManagementTerminators(n *network.Network, rc api.RequestContext) {
 	ListAssociationWithHandler[*network.Service, *network.Terminator](n, rc, n.Managers.Services, n.Managers.Terminators, TerminatorModelMapper{})
 }
+
+func (r *ServiceRouter) PreviewRoute(n *network.Network, rc api.RequestContext, params service.PreviewServiceRouteParams) {
+	Detail(rc, func(rc api.RequestContext, id string) (interface{}, error) {
+		preview, err := n.PreviewRoute(id, params.SourceRouter, stringz.OrEmpty(params.InstanceID), stringz.OrEmpty(params.ClientID))
+		if err != nil {
+			return nil, err
+		}
+		return MapRoutePreviewToRestModel(n, preview), nil
+	})
+}
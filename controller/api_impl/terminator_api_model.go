@@ -18,6 +18,7 @@ package api_impl
 
 import (
 	"fmt"
+	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/models"
@@ -25,6 +26,7 @@ import (
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/rest_model"
 	"github.com/openziti/foundation/v2/stringz"
+	"time"
 )
 
 const EntityNameTerminator = "terminators"
@@ -44,6 +46,7 @@ func MapCreateTerminatorToModel(terminator *rest_model.TerminatorCreate) *networ
 		InstanceSecret: terminator.InstanceSecret,
 		Precedence:     xt.GetPrecedenceForName(string(terminator.Precedence)),
 		HostId:         terminator.HostID,
+		HealthCheck:    MapHealthCheckToModel(terminator.HealthCheck),
 	}
 
 	if terminator.Cost != nil {
@@ -59,12 +62,15 @@ func MapUpdateTerminatorToModel(id string, terminator *rest_model.TerminatorUpda
 			Tags: TagsOrDefault(terminator.Tags),
 			Id:   id,
 		},
-		Service:    stringz.OrEmpty(terminator.Service),
-		Router:     stringz.OrEmpty(terminator.Router),
-		Binding:    stringz.OrEmpty(terminator.Binding),
-		Address:    stringz.OrEmpty(terminator.Address),
-		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
-		HostId:     terminator.HostID,
+		Service:       stringz.OrEmpty(terminator.Service),
+		Router:        stringz.OrEmpty(terminator.Router),
+		Binding:       stringz.OrEmpty(terminator.Binding),
+		Address:       stringz.OrEmpty(terminator.Address),
+		Precedence:    xt.GetPrecedenceForName(string(terminator.Precedence)),
+		HostId:        terminator.HostID,
+		HealthCheck:   MapHealthCheckToModel(terminator.HealthCheck),
+		Draining:      terminator.Draining,
+		DrainDeadline: MapDrainTimeoutToDeadline(terminator.Draining, terminator.DrainTimeout),
 	}
 
 	if terminator.Cost != nil {
@@ -80,12 +86,15 @@ func MapPatchTerminatorToModel(id string, terminator *rest_model.TerminatorPatch
 			Tags: TagsOrDefault(terminator.Tags),
 			Id:   id,
 		},
-		Service:    terminator.Service,
-		Router:     terminator.Router,
-		Binding:    terminator.Binding,
-		Address:    terminator.Address,
-		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
-		HostId:     terminator.HostID,
+		Service:       terminator.Service,
+		Router:        terminator.Router,
+		Binding:       terminator.Binding,
+		Address:       terminator.Address,
+		Precedence:    xt.GetPrecedenceForName(string(terminator.Precedence)),
+		HostId:        terminator.HostID,
+		HealthCheck:   MapHealthCheckToModel(terminator.HealthCheck),
+		Draining:      terminator.Draining,
+		DrainDeadline: MapDrainTimeoutToDeadline(terminator.Draining, terminator.DrainTimeout),
 	}
 
 	if terminator.Cost != nil {
@@ -137,17 +146,99 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 		HostID:      &terminator.HostId,
 	}
 
-	precedence := terminator.Precedence
+	resultPrecedence := MapPrecedenceToRestModel(terminator.Precedence)
+	ret.Precedence = &resultPrecedence
 
-	resultPrecedence := rest_model.TerminatorPrecedenceDefault
+	if hc := terminator.HealthCheck; hc != nil {
+		ret.HealthCheck = &rest_model.TerminatorHealthCheck{
+			Type:           &hc.Type,
+			Address:        hc.Address,
+			Interval:       hc.Interval.Milliseconds(),
+			Timeout:        hc.Timeout.Milliseconds(),
+			ExpectedStatus: int64(hc.ExpectedStatus),
+		}
+	}
 
-	if precedence.IsRequired() {
-		resultPrecedence = rest_model.TerminatorPrecedenceRequired
-	} else if precedence.IsFailed() {
-		resultPrecedence = rest_model.TerminatorPrecedenceFailed
+	ret.Draining = &terminator.Draining
+	if terminator.DrainDeadline != nil {
+		drainDeadline := strfmt.DateTime(*terminator.DrainDeadline)
+		ret.DrainDeadline = &drainDeadline
 	}
 
-	ret.Precedence = &resultPrecedence
+	ret.LeaseTTL = terminator.LeaseTtl.Milliseconds()
+	if terminator.LeaseExpiresAt != nil {
+		leaseExpiresAt := strfmt.DateTime(*terminator.LeaseExpiresAt)
+		ret.LeaseExpiresAt = &leaseExpiresAt
+	}
+
+	if status := n.GetTerminatorHealthStatus(terminator.Id); status != nil {
+		checkedAt := strfmt.DateTime(status.CheckedAt)
+		ret.HealthStatus = &rest_model.TerminatorHealthStatus{
+			Healthy:   &status.Healthy,
+			Message:   status.Message,
+			CheckedAt: &checkedAt,
+		}
+	}
 
 	return ret, nil
 }
+
+func MapOfflineTerminatorReportToRestModel(n *network.Network, report *network.OfflineTerminatorReport) (*rest_model.OfflineRouterTerminatorReport, error) {
+	action := string(report.Options.Action)
+	threshold := report.Options.Threshold.Milliseconds()
+	ret := &rest_model.OfflineRouterTerminatorReport{
+		Enabled:     &report.Options.Enabled,
+		Action:      &action,
+		Threshold:   &threshold,
+		Terminators: []*rest_model.OfflineRouterTerminator{},
+	}
+
+	for _, offline := range report.Terminators {
+		service, err := n.Managers.Services.Read(offline.Terminator.Service)
+		if err != nil {
+			return nil, err
+		}
+
+		offlineSince := strfmt.DateTime(offline.OfflineSince)
+		ret.Terminators = append(ret.Terminators, &rest_model.OfflineRouterTerminator{
+			Terminator:   ToEntityRef(offline.Terminator.Id, offline.Terminator, TerminatorLinkFactory),
+			Service:      ToEntityRef(service.Name, service, ServiceLinkFactory),
+			Router:       ToEntityRef(offline.Router.Name, offline.Router, RouterLinkFactory),
+			OfflineSince: &offlineSince,
+		})
+	}
+
+	return ret, nil
+}
+
+// MapDrainTimeoutToDeadline converts a drain timeout in milliseconds to the time after which circuits still using
+// the draining terminator will be closed. A zero timeout means circuits are left to finish on their own
+func MapDrainTimeoutToDeadline(draining bool, drainTimeout *int64) *time.Time {
+	if !draining || drainTimeout == nil || *drainTimeout <= 0 {
+		return nil
+	}
+	deadline := time.Now().Add(time.Duration(*drainTimeout) * time.Millisecond)
+	return &deadline
+}
+
+func MapHealthCheckToModel(healthCheck *rest_model.TerminatorHealthCheck) *network.TerminatorHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+	return &network.TerminatorHealthCheck{
+		Type:           stringz.OrEmpty(healthCheck.Type),
+		Address:        healthCheck.Address,
+		Interval:       time.Duration(healthCheck.Interval) * time.Millisecond,
+		Timeout:        time.Duration(healthCheck.Timeout) * time.Millisecond,
+		ExpectedStatus: uint32(healthCheck.ExpectedStatus),
+	}
+}
+
+func MapPrecedenceToRestModel(precedence xt.Precedence) rest_model.TerminatorPrecedence {
+	if precedence.IsRequired() {
+		return rest_model.TerminatorPrecedenceRequired
+	} else if precedence.IsFailed() {
+		return rest_model.TerminatorPrecedenceFailed
+	}
+	return rest_model.TerminatorPrecedenceDefault
+}
@@ -19,8 +19,10 @@ package api_impl
 import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/rest_model"
 	"github.com/openziti/fabric/rest_server/operations"
 	"github.com/openziti/fabric/rest_server/operations/terminator"
 )
@@ -64,6 +66,10 @@ func (r *TerminatorRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper
 	fabricApi.TerminatorPatchTerminatorHandler = terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.TerminatorOfflineRouterTerminatorReportHandler = terminator.OfflineRouterTerminatorReportHandlerFunc(func(params terminator.OfflineRouterTerminatorReportParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.OfflineRouterReport(n, rc) }, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *TerminatorRouter) List(n *network.Network, rc api.RequestContext) {
@@ -97,6 +103,26 @@ func (r *TerminatorRouter) Update(n *network.Network, rc api.RequestContext, par
 
 func (r *TerminatorRouter) Patch(n *network.Network, rc api.RequestContext, params terminator.PatchTerminatorParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return n.Managers.Terminators.Update(MapPatchTerminatorToModel(params.ID, params.Terminator), fields.FilterMaps("tags"))
+		fields = fields.FilterMaps("tags", "healthCheck").RemoveFields("drainTimeout")
+		if fields.IsUpdated(db.FieldTerminatorDraining) {
+			// the deadline is derived from the timeout, and is reset whenever draining is set
+			fields = fields.AddField(db.FieldTerminatorDrainDeadline)
+		}
+		return n.Managers.Terminators.Update(MapPatchTerminatorToModel(params.ID, params.Terminator), fields)
 	})
 }
+
+func (r *TerminatorRouter) OfflineRouterReport(n *network.Network, rc api.RequestContext) {
+	report, err := n.GetOfflineTerminatorReport()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	result, err := MapOfflineTerminatorReportToRestModel(n, report)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	RespondWithOk(rc, result, &rest_model.Meta{})
+}
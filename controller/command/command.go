@@ -43,6 +43,9 @@ type Validatable interface {
 // system is the leader, apply it locally
 type Dispatcher interface {
 	Dispatch(command Command) error
+	// IsLeader returns true if the current node is the leader. Periodic jobs which change the model based on
+	// cluster wide state, such as expiring entities, should only be run on the leader
+	IsLeader() bool
 }
 
 // LocalDispatcher should be used when running a non-clustered system
@@ -50,6 +53,11 @@ type LocalDispatcher struct {
 	EncodeDecodeCommands bool
 }
 
+// IsLeader always returns true, as a non-clustered system is its own leader
+func (self *LocalDispatcher) IsLeader() bool {
+	return true
+}
+
 func (self *LocalDispatcher) Dispatch(command Command) error {
 	defer func() {
 		if p := recover(); p != nil {
@@ -112,6 +112,8 @@ func (self *UpdateEntityCommand[T]) Encode() ([]byte, error) {
 type DeleteEntityCommand struct {
 	Deleter EntityDeleter
 	Id      string
+	// Cause optionally records why the entity is being deleted, for entities which report it on delete events
+	Cause string
 }
 
 func (self *DeleteEntityCommand) Apply() error {
@@ -122,6 +124,7 @@ func (self *DeleteEntityCommand) Encode() ([]byte, error) {
 	return cmd_pb.EncodeProtobuf(&cmd_pb.DeleteEntityCommand{
 		EntityId:   self.Id,
 		EntityType: self.Deleter.GetEntityTypeId(),
+		Cause:      self.Cause,
 	})
 }
 
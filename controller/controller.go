@@ -31,8 +31,12 @@ import (
 	"github.com/openziti/fabric/controller/xctrl"
 	"github.com/openziti/fabric/controller/xmgmt"
 	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_leastconnections"
+	"github.com/openziti/fabric/controller/xt_locality"
 	"github.com/openziti/fabric/controller/xt_random"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
+	"github.com/openziti/fabric/controller/xt_split"
+	"github.com/openziti/fabric/controller/xt_sticky"
 	"github.com/openziti/fabric/controller/xt_weighted"
 	"github.com/openziti/fabric/event"
 	"github.com/openziti/fabric/events"
@@ -165,6 +169,12 @@ func NewController(cfg *Config, versionProvider versions.VersionProvider) (*Cont
 
 	c.eventDispatcher.InitializeNetworkEvents(c.network)
 
+	if journalConfig := c.getEventJournalConfig(); journalConfig != nil {
+		if err := c.eventDispatcher.EnableJournal(journalConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	if cfg.Ctrl.Options.NewListener != nil {
 		c.network.AddRouterPresenceHandler(&OnConnectSettingsHandler{
 			config: cfg,
@@ -286,6 +296,15 @@ func (c *Controller) getEventHandlerConfigs() []*events.EventHandlerConfig {
 	return result
 }
 
+func (c *Controller) getEventJournalConfig() map[interface{}]interface{} {
+	if e, ok := c.config.src["eventJournal"]; ok {
+		if config, ok := e.(map[interface{}]interface{}); ok {
+			return config
+		}
+	}
+	return nil
+}
+
 func (c *Controller) GetCloseNotifyChannel() <-chan struct{} {
 	return c.shutdownC
 }
@@ -342,6 +361,10 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_smartrouting.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_leastconnections.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_locality.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_split.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
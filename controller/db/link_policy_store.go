@@ -0,0 +1,132 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	EntityTypeLinkPolicies            = "linkPolicies"
+	FieldLinkPolicySourceRouters      = "sourceRouters"
+	FieldLinkPolicyDestinationRouters = "destinationRouters"
+	FieldLinkPolicyMatchTags          = "matchTags"
+	FieldLinkPolicyAction             = "action"
+
+	LinkPolicyActionAllow = "allow"
+	LinkPolicyActionDeny  = "deny"
+)
+
+type LinkPolicy struct {
+	boltz.BaseExtEntity
+	Name               string
+	SourceRouters      []string
+	DestinationRouters []string
+	MatchTags          []string
+	Action             string
+}
+
+func (entity *LinkPolicy) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.SourceRouters = bucket.GetStringList(FieldLinkPolicySourceRouters)
+	entity.DestinationRouters = bucket.GetStringList(FieldLinkPolicyDestinationRouters)
+	entity.MatchTags = bucket.GetStringList(FieldLinkPolicyMatchTags)
+	entity.Action = bucket.GetStringWithDefault(FieldLinkPolicyAction, LinkPolicyActionAllow)
+}
+
+func (entity *LinkPolicy) SetValues(ctx *boltz.PersistContext) {
+	if entity.Action == "" {
+		entity.Action = LinkPolicyActionAllow
+	}
+
+	if ctx.ProceedWithSet(FieldLinkPolicyAction) && entity.Action != LinkPolicyActionAllow && entity.Action != LinkPolicyActionDeny {
+		ctx.Bucket.SetError(errorz.NewFieldError("action must be one of allow or deny", FieldLinkPolicyAction, entity.Action))
+		return
+	}
+
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+	ctx.SetStringList(FieldLinkPolicySourceRouters, entity.SourceRouters)
+	ctx.SetStringList(FieldLinkPolicyDestinationRouters, entity.DestinationRouters)
+	ctx.SetStringList(FieldLinkPolicyMatchTags, entity.MatchTags)
+	ctx.SetString(FieldLinkPolicyAction, entity.Action)
+}
+
+func (entity *LinkPolicy) GetEntityType() string {
+	return EntityTypeLinkPolicies
+}
+
+type LinkPolicyStore interface {
+	store
+	GetNameIndex() boltz.ReadIndex
+	LoadOneById(tx *bbolt.Tx, id string) (*LinkPolicy, error)
+}
+
+func newLinkPolicyStore(stores *stores) *linkPolicyStoreImpl {
+	notFoundErrorFactory := func(id string) error {
+		return boltz.NewNotFoundError(boltz.GetSingularEntityType(EntityTypeLinkPolicies), "id", id)
+	}
+
+	store := &linkPolicyStoreImpl{
+		baseStore: baseStore{
+			stores:    stores,
+			BaseStore: boltz.NewBaseStore(EntityTypeLinkPolicies, notFoundErrorFactory, RootBucket),
+		},
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type linkPolicyStoreImpl struct {
+	baseStore
+	indexName boltz.ReadIndex
+}
+
+func (store *linkPolicyStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+
+	symbolName := store.AddSymbol(FieldName, ast.NodeTypeString)
+	store.indexName = store.AddUniqueIndex(symbolName)
+
+	store.AddSetSymbol(FieldLinkPolicySourceRouters, ast.NodeTypeString)
+	store.AddSetSymbol(FieldLinkPolicyDestinationRouters, ast.NodeTypeString)
+	store.AddSetSymbol(FieldLinkPolicyMatchTags, ast.NodeTypeString)
+	store.AddSymbol(FieldLinkPolicyAction, ast.NodeTypeString)
+}
+
+func (store *linkPolicyStoreImpl) initializeLinked() {
+}
+
+func (store *linkPolicyStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *linkPolicyStoreImpl) NewStoreEntity() boltz.Entity {
+	return &LinkPolicy{}
+}
+
+func (store *linkPolicyStoreImpl) LoadOneById(tx *bbolt.Tx, id string) (*LinkPolicy, error) {
+	entity := &LinkPolicy{}
+	if found, err := store.BaseLoadOneById(tx, id, entity); !found || err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
@@ -0,0 +1,78 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/openziti/storage/boltz"
+)
+
+func Test_LinkPolicyStore(t *testing.T) {
+	ctx := NewTestContext(t)
+	defer ctx.Cleanup()
+	ctx.Init()
+
+	t.Run("test create invalid link policies", ctx.testCreateInvalidLinkPolicies)
+	t.Run("test create link policies", ctx.testCreateLinkPolicies)
+}
+
+func (ctx *TestContext) testCreateInvalidLinkPolicies(t *testing.T) {
+	ctx.Impl.NextTest(t)
+	defer ctx.cleanupAll()
+
+	policy := &LinkPolicy{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		Action:        "sometimes",
+	}
+	err := ctx.Create(policy)
+	ctx.EqualError(err, "the value 'sometimes' for 'action' is invalid: action must be one of allow or deny")
+
+	policy.Action = LinkPolicyActionDeny
+	ctx.RequireCreate(policy)
+
+	policy.Id = uuid.New().String()
+	err = ctx.Create(policy)
+	ctx.EqualError(err, fmt.Sprintf("duplicate value '%v' in unique index on linkPolicies store", policy.Name))
+}
+
+func (ctx *TestContext) testCreateLinkPolicies(t *testing.T) {
+	ctx.Impl.NextTest(t)
+	defer ctx.cleanupAll()
+
+	policy := &LinkPolicy{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+	}
+	ctx.RequireCreate(policy)
+	ctx.Equal(LinkPolicyActionAllow, policy.Action)
+	ctx.ValidateBaseline(policy)
+
+	policy = &LinkPolicy{
+		BaseExtEntity:      boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:               uuid.New().String(),
+		SourceRouters:      []string{"#spoke"},
+		DestinationRouters: []string{"#hub", "@" + uuid.New().String()},
+		MatchTags:          []string{"region"},
+		Action:             LinkPolicyActionAllow,
+	}
+	ctx.RequireCreate(policy)
+	ctx.ValidateBaseline(policy)
+}
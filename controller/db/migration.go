@@ -10,7 +10,7 @@ import (
 	"time"
 )
 
-const CurrentDbVersion = 5
+const CurrentDbVersion = 6
 
 func (stores *stores) migrate(step *boltz.MigrationStep) int {
 	if step.CurrentVersion > CurrentDbVersion {
@@ -39,6 +39,10 @@ func (stores *stores) migrate(step *boltz.MigrationStep) int {
 		stores.migrateTerminatorIdentityFields(step)
 	}
 
+	if step.CurrentVersion < 6 {
+		stores.router.InitializeIndexes(step.Ctx.Tx(), step)
+	}
+
 	if step.CurrentVersion <= CurrentDbVersion {
 		return CurrentDbVersion
 	}
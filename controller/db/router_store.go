@@ -20,21 +20,33 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
-	EntityTypeRouters      = "routers"
-	FieldRouterFingerprint = "fingerprint"
-	FieldRouterCost        = "cost"
-	FieldRouterNoTraversal = "noTraversal"
+	EntityTypeRouters                = "routers"
+	FieldRouterFingerprint           = "fingerprint"
+	FieldRouterCost                  = "cost"
+	FieldRouterNoTraversal           = "noTraversal"
+	FieldRouterDraining              = "draining"
+	FieldRoleAttributes              = "roleAttributes"
+	FieldRouterLastDisconnected      = "lastDisconnected"
+	FieldRouterConnectedControllerId = "connectedControllerId"
 )
 
 type Router struct {
 	boltz.BaseExtEntity
-	Name        string
-	Fingerprint *string
-	Cost        uint16
-	NoTraversal bool
+	Name           string
+	Fingerprint    *string
+	Cost           uint16
+	NoTraversal    bool
+	Draining       bool
+	RoleAttributes []string
+	// LastDisconnected is when the router disconnected from the controller. It's cleared when the router connects
+	LastDisconnected *time.Time
+	// ConnectedControllerId is the id of a controller which the router is connected to. It's empty if the router
+	// isn't connected to any controller
+	ConnectedControllerId string
 }
 
 func (entity *Router) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
@@ -43,6 +55,10 @@ func (entity *Router) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.Fingerprint = bucket.GetString(FieldRouterFingerprint)
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldRouterCost, 0))
 	entity.NoTraversal = bucket.GetBoolWithDefault(FieldRouterNoTraversal, false)
+	entity.Draining = bucket.GetBoolWithDefault(FieldRouterDraining, false)
+	entity.RoleAttributes = bucket.GetStringList(FieldRoleAttributes)
+	entity.LastDisconnected = bucket.GetTime(FieldRouterLastDisconnected)
+	entity.ConnectedControllerId = bucket.GetStringWithDefault(FieldRouterConnectedControllerId, "")
 }
 
 func (entity *Router) SetValues(ctx *boltz.PersistContext) {
@@ -51,6 +67,15 @@ func (entity *Router) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetStringP(FieldRouterFingerprint, entity.Fingerprint)
 	ctx.SetInt32(FieldRouterCost, int32(entity.Cost))
 	ctx.SetBool(FieldRouterNoTraversal, entity.NoTraversal)
+	ctx.SetBool(FieldRouterDraining, entity.Draining)
+	ctx.SetStringList(FieldRoleAttributes, entity.RoleAttributes)
+	// only cleared when explicitly requested, so that full updates from the REST api leave the recorded time alone
+	if entity.LastDisconnected != nil || (ctx.FieldChecker != nil && ctx.FieldChecker.IsUpdated(FieldRouterLastDisconnected)) {
+		ctx.SetTimeP(FieldRouterLastDisconnected, entity.LastDisconnected)
+	}
+	if entity.ConnectedControllerId != "" || (ctx.FieldChecker != nil && ctx.FieldChecker.IsUpdated(FieldRouterConnectedControllerId)) {
+		ctx.SetString(FieldRouterConnectedControllerId, entity.ConnectedControllerId)
+	}
 }
 
 func (entity *Router) GetEntityType() string {
@@ -60,6 +85,8 @@ func (entity *Router) GetEntityType() string {
 type RouterStore interface {
 	boltz.CrudStore
 	GetNameIndex() boltz.ReadIndex
+	GetRoleAttributesIndex() boltz.SetReadIndex
+	GetRouterIdsWithRoleAttribute(tx *bbolt.Tx, roleAttribute string) []string
 	LoadOneById(tx *bbolt.Tx, id string) (*Router, error)
 	LoadOneByName(tx *bbolt.Tx, id string) (*Router, error)
 }
@@ -81,8 +108,9 @@ func newRouterStore(stores *stores) *routerStoreImpl {
 
 type routerStoreImpl struct {
 	baseStore
-	indexName         boltz.ReadIndex
-	terminatorsSymbol boltz.EntitySetSymbol
+	indexName           boltz.ReadIndex
+	indexRoleAttributes boltz.SetReadIndex
+	terminatorsSymbol   boltz.EntitySetSymbol
 }
 
 func (store *routerStoreImpl) initializeLocal() {
@@ -92,6 +120,8 @@ func (store *routerStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldRouterFingerprint, ast.NodeTypeString)
+	store.AddSymbol(FieldRouterDraining, ast.NodeTypeBool)
+	store.indexRoleAttributes = store.AddSetIndex(store.AddSetSymbol(FieldRoleAttributes, ast.NodeTypeString))
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -102,6 +132,19 @@ func (store *routerStoreImpl) GetNameIndex() boltz.ReadIndex {
 	return store.indexName
 }
 
+func (store *routerStoreImpl) GetRoleAttributesIndex() boltz.SetReadIndex {
+	return store.indexRoleAttributes
+}
+
+// GetRouterIdsWithRoleAttribute returns the ids of all routers which have the given role attribute
+func (store *routerStoreImpl) GetRouterIdsWithRoleAttribute(tx *bbolt.Tx, roleAttribute string) []string {
+	var result []string
+	store.indexRoleAttributes.Read(tx, []byte(roleAttribute), func(val []byte) {
+		result = append(result, string(val))
+	})
+	return result
+}
+
 func (store *routerStoreImpl) NewStoreEntity() boltz.Entity {
 	return &Router{}
 }
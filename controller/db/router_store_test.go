@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
 )
@@ -38,6 +39,9 @@ func Test_RouterStore(t *testing.T) {
 	t.Run("test load/query routers", ctx.testLoadQueryRouters)
 	t.Run("test update routers", ctx.testUpdateRouters)
 	t.Run("test delete routers", ctx.testDeleteRouters)
+	t.Run("test router role attributes", ctx.testRouterRoleAttributes)
+	t.Run("test router last disconnected", ctx.testRouterLastDisconnected)
+	t.Run("test router connected controller", ctx.testRouterConnectedController)
 }
 
 func (ctx *TestContext) testCreateInvalidRouters(t *testing.T) {
@@ -178,3 +182,121 @@ func (ctx *TestContext) testDeleteRouters(t *testing.T) {
 	ctx.RequireDelete(entities.router1)
 	ctx.RequireDelete(entities.router2)
 }
+
+func (ctx *TestContext) testRouterRoleAttributes(t *testing.T) {
+	ctx.Impl.NextTest(t)
+	defer ctx.cleanupAll()
+
+	router1 := &Router{
+		BaseExtEntity:  boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:           uuid.New().String(),
+		RoleAttributes: []string{"edge", "region-eu"},
+	}
+	ctx.RequireCreate(router1)
+	ctx.ValidateBaseline(router1)
+
+	router2 := &Router{
+		BaseExtEntity:  boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:           uuid.New().String(),
+		RoleAttributes: []string{"region-eu", "transit"},
+	}
+	ctx.RequireCreate(router2)
+	ctx.ValidateBaseline(router2)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ids := ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "region-eu")
+		ctx.ElementsMatch([]string{router1.Id, router2.Id}, ids)
+
+		ids = ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "edge")
+		ctx.Equal([]string{router1.Id}, ids)
+
+		ids, _, err := ctx.stores.Router.QueryIds(tx, `anyOf(roleAttributes) = "transit"`)
+		ctx.NoError(err)
+		ctx.Equal([]string{router2.Id}, ids)
+		return nil
+	})
+	ctx.NoError(err)
+
+	router1.RoleAttributes = []string{"transit"}
+	ctx.RequireUpdate(router1)
+	ctx.ValidateUpdated(router1)
+
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ctx.Equal([]string{router2.Id}, ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "region-eu"))
+		ctx.Empty(ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "edge"))
+		ctx.ElementsMatch([]string{router1.Id, router2.Id}, ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "transit"))
+		return nil
+	})
+	ctx.NoError(err)
+
+	ctx.RequireDelete(router2)
+	err = ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ctx.Empty(ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "region-eu"))
+		ctx.Equal([]string{router1.Id}, ctx.stores.Router.GetRouterIdsWithRoleAttribute(tx, "transit"))
+		return nil
+	})
+	ctx.NoError(err)
+}
+
+func (ctx *TestContext) testRouterLastDisconnected(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	router := ctx.requireNewRouter()
+
+	lastDisconnected := time.Now().Add(-time.Hour).UTC()
+	router.LastDisconnected = &lastDisconnected
+	ctx.RequirePatch(router, fields.UpdatedFieldsMap{FieldRouterLastDisconnected: struct{}{}})
+
+	loaded := &Router{}
+	loaded.Id = router.Id
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.LastDisconnected)
+	ctx.True(lastDisconnected.Equal(*loaded.LastDisconnected))
+
+	// full updates which don't know about the disconnect time shouldn't clear it
+	loaded.LastDisconnected = nil
+	loaded.Name = uuid.New().String()
+	ctx.RequireUpdate(loaded)
+
+	loaded = &Router{}
+	loaded.Id = router.Id
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.LastDisconnected)
+
+	loaded.LastDisconnected = nil
+	ctx.RequirePatch(loaded, fields.UpdatedFieldsMap{FieldRouterLastDisconnected: struct{}{}})
+
+	loaded = &Router{}
+	loaded.Id = router.Id
+	ctx.RequireReload(loaded)
+	ctx.Nil(loaded.LastDisconnected)
+}
+
+func (ctx *TestContext) testRouterConnectedController(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	router := ctx.requireNewRouter()
+	router.ConnectedControllerId = "ctrl1"
+	ctx.RequirePatch(router, fields.UpdatedFieldsMap{FieldRouterConnectedControllerId: struct{}{}})
+
+	loaded := &Router{}
+	loaded.Id = router.Id
+	ctx.RequireReload(loaded)
+	ctx.Equal("ctrl1", loaded.ConnectedControllerId)
+
+	// full updates which don't know about the connected controller shouldn't clear it
+	loaded.ConnectedControllerId = ""
+	loaded.Name = uuid.New().String()
+	ctx.RequireUpdate(loaded)
+
+	ctx.RequireReload(loaded)
+	ctx.Equal("ctrl1", loaded.ConnectedControllerId)
+
+	loaded.ConnectedControllerId = ""
+	ctx.RequirePatch(loaded, fields.UpdatedFieldsMap{FieldRouterConnectedControllerId: struct{}{}})
+
+	ctx.RequireReload(loaded)
+	ctx.Equal("", loaded.ConnectedControllerId)
+}
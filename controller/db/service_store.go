@@ -17,8 +17,10 @@
 package db
 
 import (
+	"fmt"
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -27,23 +29,80 @@ import (
 const (
 	EntityTypeServices             = "services"
 	FieldServiceTerminatorStrategy = "terminatorStrategy"
+	FieldServiceAllowedRouters     = "allowedRouters"
+	FieldServiceDeniedRouters      = "deniedRouters"
+	FieldServiceMaxLinkCount       = "maxLinkCount"
+	FieldServiceWaypointRouters    = "waypointRouters"
+	FieldServiceMaxCircuits        = "maxCircuits"
+	FieldServiceMaxClientCircuits  = "maxCircuitsPerClient"
+	FieldServiceCircuitRateLimit   = "circuitRateLimit"
+	FieldServiceCircuitRateBurst   = "circuitRateBurst"
+	FieldServicePinnedPath         = "pinnedPath"
+	FieldServicePinnedPathFallback = "pinnedPathFallback"
+	FieldServiceTrafficSplit       = "trafficSplit"
+
+	FieldTrafficSplitStickyClients = "stickyClients"
+	FieldTrafficSplitGroups        = "groups"
+	FieldTrafficSplitGroupName     = "name"
+	FieldTrafficSplitGroupWeight   = "weight"
+	FieldTrafficSplitGroupTermTags = "terminatorTags"
 )
 
 type Service struct {
 	boltz.BaseExtEntity
-	Name               string
-	TerminatorStrategy string
+	Name                 string
+	TerminatorStrategy   string
+	AllowedRouters       []string
+	DeniedRouters        []string
+	MaxLinkCount         uint32
+	WaypointRouters      []string
+	MaxCircuits          uint32
+	MaxCircuitsPerClient uint32
+	CircuitRateLimit     uint32
+	CircuitRateBurst     uint32
+	PinnedPath           []string
+	PinnedPathFallback   bool
+	TrafficSplit         *xt.TrafficSplit
 }
 
 func (entity *Service) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.LoadBaseValues(bucket)
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
+	entity.AllowedRouters = bucket.GetStringList(FieldServiceAllowedRouters)
+	entity.DeniedRouters = bucket.GetStringList(FieldServiceDeniedRouters)
+	entity.MaxLinkCount = uint32(bucket.GetInt64WithDefault(FieldServiceMaxLinkCount, 0))
+	entity.WaypointRouters = bucket.GetStringList(FieldServiceWaypointRouters)
+	entity.MaxCircuits = uint32(bucket.GetInt64WithDefault(FieldServiceMaxCircuits, 0))
+	entity.MaxCircuitsPerClient = uint32(bucket.GetInt64WithDefault(FieldServiceMaxClientCircuits, 0))
+	entity.CircuitRateLimit = uint32(bucket.GetInt64WithDefault(FieldServiceCircuitRateLimit, 0))
+	entity.CircuitRateBurst = uint32(bucket.GetInt64WithDefault(FieldServiceCircuitRateBurst, 0))
+	entity.PinnedPath = getOrderedStringList(bucket, FieldServicePinnedPath)
+	entity.PinnedPathFallback = bucket.GetBoolWithDefault(FieldServicePinnedPathFallback, false)
+	entity.TrafficSplit = loadTrafficSplit(bucket)
 }
 
 func (entity *Service) SetValues(ctx *boltz.PersistContext) {
 	entity.SetBaseValues(ctx)
 	ctx.SetString(FieldName, entity.Name)
+	ctx.SetStringList(FieldServiceAllowedRouters, entity.AllowedRouters)
+	ctx.SetStringList(FieldServiceDeniedRouters, entity.DeniedRouters)
+	ctx.SetInt64(FieldServiceMaxLinkCount, int64(entity.MaxLinkCount))
+	ctx.SetStringList(FieldServiceWaypointRouters, entity.WaypointRouters)
+	ctx.SetInt64(FieldServiceMaxCircuits, int64(entity.MaxCircuits))
+	ctx.SetInt64(FieldServiceMaxClientCircuits, int64(entity.MaxCircuitsPerClient))
+	ctx.SetInt64(FieldServiceCircuitRateLimit, int64(entity.CircuitRateLimit))
+	ctx.SetInt64(FieldServiceCircuitRateBurst, int64(entity.CircuitRateBurst))
+	setOrderedStringList(ctx, FieldServicePinnedPath, entity.PinnedPath)
+	ctx.SetBool(FieldServicePinnedPathFallback, entity.PinnedPathFallback)
+
+	if ctx.ProceedWithSet(FieldServiceTrafficSplit) {
+		if err := validateTrafficSplit(entity.TrafficSplit); err != nil {
+			ctx.Bucket.SetError(err)
+			return
+		}
+		storeTrafficSplit(ctx.Bucket, entity.TrafficSplit)
+	}
 
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
@@ -106,6 +165,15 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceAllowedRouters, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceDeniedRouters, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMaxLinkCount, ast.NodeTypeInt64)
+	store.AddSetSymbol(FieldServiceWaypointRouters, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMaxCircuits, ast.NodeTypeInt64)
+	store.AddSymbol(FieldServiceMaxClientCircuits, ast.NodeTypeInt64)
+	store.AddSymbol(FieldServiceCircuitRateLimit, ast.NodeTypeInt64)
+	store.AddSymbol(FieldServiceCircuitRateBurst, ast.NodeTypeInt64)
+	store.AddSymbol(FieldServicePinnedPathFallback, ast.NodeTypeBool)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -157,3 +225,106 @@ func (store *serviceStoreImpl) getTerminators(tx *bbolt.Tx, serviceId string) ([
 	}
 	return terminators, nil
 }
+
+func validateTrafficSplit(split *xt.TrafficSplit) error {
+	if split == nil {
+		return nil
+	}
+
+	field := FieldServiceTrafficSplit + "." + FieldTrafficSplitGroups
+	if len(split.Groups) == 0 {
+		return errorz.NewFieldError("traffic split must have at least one group", field, len(split.Groups))
+	}
+
+	names := map[string]struct{}{}
+	for _, group := range split.Groups {
+		if group.Name == "" {
+			return errorz.NewFieldError("traffic split group name is required", field, group.Name)
+		}
+		if _, found := names[group.Name]; found {
+			return errorz.NewFieldError("traffic split group names must be unique", field, group.Name)
+		}
+		names[group.Name] = struct{}{}
+	}
+	return nil
+}
+
+// storeTrafficSplit stores each traffic split group in a bucket keyed by its zero padded index, so groups are loaded
+// in the order they were given
+func storeTrafficSplit(bucket *boltz.TypedBucket, split *xt.TrafficSplit) {
+	_ = bucket.DeleteBucket([]byte(FieldServiceTrafficSplit))
+	if split == nil {
+		return
+	}
+
+	splitBucket := bucket.GetOrCreateBucket(FieldServiceTrafficSplit)
+	splitBucket.SetBool(FieldTrafficSplitStickyClients, split.StickyClients, nil)
+	groupsBucket := splitBucket.GetOrCreateBucket(FieldTrafficSplitGroups)
+	for idx, group := range split.Groups {
+		groupBucket := groupsBucket.GetOrCreateBucket(fmt.Sprintf("%06d", idx))
+		groupBucket.SetString(FieldTrafficSplitGroupName, group.Name, nil)
+		groupBucket.SetInt64(FieldTrafficSplitGroupWeight, int64(group.Weight), nil)
+		tags := map[string]interface{}{}
+		for k, v := range group.TerminatorTags {
+			tags[k] = v
+		}
+		groupBucket.PutMap(FieldTrafficSplitGroupTermTags, tags, nil, false)
+	}
+}
+
+func loadTrafficSplit(bucket *boltz.TypedBucket) *xt.TrafficSplit {
+	splitBucket := bucket.GetBucket(FieldServiceTrafficSplit)
+	if splitBucket == nil {
+		return nil
+	}
+
+	split := &xt.TrafficSplit{
+		StickyClients: splitBucket.GetBoolWithDefault(FieldTrafficSplitStickyClients, false),
+	}
+
+	if groupsBucket := splitBucket.GetBucket(FieldTrafficSplitGroups); groupsBucket != nil {
+		cursor := groupsBucket.Cursor()
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			groupBucket := groupsBucket.GetBucket(string(key))
+			if groupBucket == nil {
+				continue
+			}
+			group := &xt.TrafficSplitGroup{
+				Name:           groupBucket.GetStringWithDefault(FieldTrafficSplitGroupName, ""),
+				Weight:         uint32(groupBucket.GetInt64WithDefault(FieldTrafficSplitGroupWeight, 0)),
+				TerminatorTags: map[string]string{},
+			}
+			for k, v := range groupBucket.GetMap(FieldTrafficSplitGroupTermTags) {
+				if s, ok := v.(string); ok {
+					group.TerminatorTags[k] = s
+				}
+			}
+			split.Groups = append(split.Groups, group)
+		}
+	}
+
+	return split
+}
+
+// getOrderedStringList loads a string list stored with setOrderedStringList. Unlike string lists stored as sets, the
+// order of the values is preserved.
+func getOrderedStringList(bucket *boltz.TypedBucket, field string) []string {
+	if bucket.GetBucket(field) == nil {
+		return nil
+	}
+	var result []string
+	for _, val := range bucket.GetList(field) {
+		if s, ok := val.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func setOrderedStringList(ctx *boltz.PersistContext, field string, value []string) {
+	list := make([]interface{}, 0, len(value))
+	for _, val := range value {
+		list = append(list, val)
+	}
+	ctx.Bucket.PutList(field, list, ctx.FieldChecker)
+}
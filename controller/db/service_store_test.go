@@ -19,6 +19,7 @@ package db
 import (
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/openziti/fabric/controller/xt"
 	"testing"
 	"time"
 
@@ -61,6 +62,15 @@ func (ctx *TestContext) testCreateInvalidServices(t *testing.T) {
 	service.TerminatorStrategy = uuid.New().String()
 	err = ctx.Create(service)
 	ctx.EqualError(err, fmt.Sprintf("terminatorStrategy with name %v not found", service.TerminatorStrategy))
+
+	service.TerminatorStrategy = ""
+	service.TrafficSplit = &xt.TrafficSplit{}
+	err = ctx.Create(service)
+	ctx.EqualError(err, "the value '0' for 'trafficSplit.groups' is invalid: traffic split must have at least one group")
+
+	service.TrafficSplit.Groups = []*xt.TrafficSplitGroup{{Name: "stable", Weight: 95}, {Name: "stable", Weight: 5}}
+	err = ctx.Create(service)
+	ctx.EqualError(err, "the value 'stable' for 'trafficSplit.groups' is invalid: traffic split group names must be unique")
 }
 
 func (ctx *TestContext) testCreateServices(t *testing.T) {
@@ -73,6 +83,62 @@ func (ctx *TestContext) testCreateServices(t *testing.T) {
 	}
 	ctx.RequireCreate(service)
 	ctx.ValidateBaseline(service)
+
+	service = &Service{
+		BaseExtEntity:   boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:            uuid.New().String(),
+		AllowedRouters:  []string{"#region=us", "@" + uuid.New().String()},
+		DeniedRouters:   []string{uuid.New().String()},
+		MaxLinkCount:    3,
+		WaypointRouters: []string{uuid.New().String()},
+	}
+	ctx.RequireCreate(service)
+	ctx.ValidateBaseline(service)
+
+	service = &Service{
+		BaseExtEntity:        boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:                 uuid.New().String(),
+		MaxCircuits:          1000,
+		MaxCircuitsPerClient: 10,
+		CircuitRateLimit:     50,
+		CircuitRateBurst:     100,
+	}
+	ctx.RequireCreate(service)
+	ctx.ValidateBaseline(service)
+
+	service = &Service{
+		BaseExtEntity:      boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:               uuid.New().String(),
+		PinnedPath:         []string{uuid.New().String(), uuid.New().String()},
+		PinnedPathFallback: true,
+	}
+	ctx.RequireCreate(service)
+	ctx.ValidateBaseline(service)
+
+	service = &Service{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		TrafficSplit: &xt.TrafficSplit{
+			StickyClients: true,
+			Groups: []*xt.TrafficSplitGroup{
+				{Name: "stable", Weight: 95, TerminatorTags: map[string]string{"version": "v1"}},
+				{Name: "canary", Weight: 5, TerminatorTags: map[string]string{"version": "v2", "canary": "true"}},
+				{Name: "b", Weight: 0, TerminatorTags: map[string]string{}},
+				{Name: "a", Weight: 0, TerminatorTags: map[string]string{}},
+			},
+		},
+	}
+	ctx.RequireCreate(service)
+	ctx.ValidateBaseline(service)
+
+	service.TrafficSplit.Groups[1].Weight = 50
+	service.TrafficSplit.Groups = service.TrafficSplit.Groups[:2]
+	ctx.RequireUpdate(service)
+	ctx.ValidateUpdated(service)
+
+	service.TrafficSplit = nil
+	ctx.RequireUpdate(service)
+	ctx.ValidateUpdated(service)
 }
 
 type serviceTestEntities struct {
@@ -28,6 +28,7 @@ type Stores struct {
 	Terminator TerminatorStore
 	Router     RouterStore
 	Service    ServiceStore
+	LinkPolicy LinkPolicyStore
 	storeMap   map[string]boltz.CrudStore
 	lock       sync.Mutex
 	checkables []Checkable
@@ -105,6 +106,7 @@ type stores struct {
 	terminator *terminatorStoreImpl
 	router     *routerStoreImpl
 	service    *serviceStoreImpl
+	linkPolicy *linkPolicyStoreImpl
 }
 
 func InitStores(db boltz.Db) (*Stores, error) {
@@ -113,11 +115,13 @@ func InitStores(db boltz.Db) (*Stores, error) {
 	internalStores.terminator = newTerminatorStore(internalStores)
 	internalStores.router = newRouterStore(internalStores)
 	internalStores.service = newServiceStore(internalStores)
+	internalStores.linkPolicy = newLinkPolicyStore(internalStores)
 
 	stores := &Stores{
 		Terminator: internalStores.terminator,
 		Router:     internalStores.router,
 		Service:    internalStores.service,
+		LinkPolicy: internalStores.linkPolicy,
 	}
 
 	stores.buildStoreMap()
@@ -125,10 +129,12 @@ func InitStores(db boltz.Db) (*Stores, error) {
 	internalStores.terminator.initializeLocal()
 	internalStores.router.initializeLocal()
 	internalStores.service.initializeLocal()
+	internalStores.linkPolicy.initializeLocal()
 
 	internalStores.terminator.initializeLinked()
 	internalStores.router.initializeLinked()
 	internalStores.service.initializeLinked()
+	internalStores.linkPolicy.initializeLinked()
 
 	mm := boltz.NewMigratorManager(db)
 	if err := mm.Migrate("fabric", CurrentDbVersion, internalStores.migrate); err != nil {
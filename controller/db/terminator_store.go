@@ -18,8 +18,13 @@ package db
 
 import (
 	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/sequence"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
@@ -27,19 +32,75 @@ import (
 )
 
 const (
-	EntityTypeTerminators         = "terminators"
-	FieldTerminatorService        = "service"
-	FieldTerminatorRouter         = "router"
-	FieldTerminatorBinding        = "binding"
-	FieldTerminatorAddress        = "address"
-	FieldTerminatorInstanceId     = "instanceId"
-	FieldTerminatorInstanceSecret = "instanceSecret"
-	FieldTerminatorCost           = "cost"
-	FieldTerminatorPrecedence     = "precedence"
-	FieldServerPeerData           = "peerData"
-	FieldTerminatorHostId         = "hostId"
+	EntityTypeTerminators            = "terminators"
+	FieldTerminatorService           = "service"
+	FieldTerminatorRouter            = "router"
+	FieldTerminatorBinding           = "binding"
+	FieldTerminatorAddress           = "address"
+	FieldTerminatorInstanceId        = "instanceId"
+	FieldTerminatorInstanceSecret    = "instanceSecret"
+	FieldTerminatorCost              = "cost"
+	FieldTerminatorPrecedence        = "precedence"
+	FieldServerPeerData              = "peerData"
+	FieldTerminatorHostId            = "hostId"
+	FieldTerminatorHealthCheck       = "healthCheck"
+	FieldTerminatorDraining          = "draining"
+	FieldTerminatorDrainDeadline     = "drainDeadline"
+	FieldTerminatorLeaseTtl          = "leaseTtl"
+	FieldTerminatorLeaseExpiresAt    = "leaseExpiresAt"
+	FieldTerminatorDeleteCause       = "deleteCause"
+	FieldTerminatorFailedBy          = "failedBy"
+	FieldTerminatorRestorePrecedence = "restorePrecedence"
+
+	FieldHealthCheckType           = "type"
+	FieldHealthCheckAddress        = "address"
+	FieldHealthCheckInterval       = "interval"
+	FieldHealthCheckTimeout        = "timeout"
+	FieldHealthCheckExpectedStatus = "expectedStatus"
 )
 
+// TerminatorHealthCheck configures a health check which the router hosting the terminator runs on an interval
+type TerminatorHealthCheck struct {
+	Type           string
+	Address        string
+	Interval       time.Duration
+	Timeout        time.Duration
+	ExpectedStatus uint32
+}
+
+func (self *TerminatorHealthCheck) validate() error {
+	if !xt.IsValidHealthCheckType(self.Type) {
+		return errorz.NewFieldError("health check type must be one of tcp, http or binding", FieldTerminatorHealthCheck+"."+FieldHealthCheckType, self.Type)
+	}
+
+	if self.Type == xt.HealthCheckTypeHttp && !strings.HasPrefix(self.Address, "http://") && !strings.HasPrefix(self.Address, "https://") {
+		return errorz.NewFieldError("http health checks require an http or https url", FieldTerminatorHealthCheck+"."+FieldHealthCheckAddress, self.Address)
+	}
+
+	if self.Interval == 0 {
+		self.Interval = xt.DefaultHealthCheckInterval
+	}
+
+	if self.Interval < xt.MinHealthCheckInterval {
+		return errorz.NewFieldError(fmt.Sprintf("health check interval must be at least %v", xt.MinHealthCheckInterval),
+			FieldTerminatorHealthCheck+"."+FieldHealthCheckInterval, self.Interval.Milliseconds())
+	}
+
+	if self.Timeout == 0 {
+		self.Timeout = xt.DefaultHealthCheckTimeout
+	}
+
+	if self.Timeout > self.Interval {
+		self.Timeout = self.Interval
+	}
+
+	if self.Type == xt.HealthCheckTypeHttp && self.ExpectedStatus == 0 {
+		self.ExpectedStatus = xt.DefaultHealthCheckExpectedStatus
+	}
+
+	return nil
+}
+
 type Terminator struct {
 	boltz.BaseExtEntity
 	Service        string
@@ -52,6 +113,20 @@ type Terminator struct {
 	Precedence     string
 	PeerData       xt.PeerData
 	HostId         string
+	HealthCheck    *TerminatorHealthCheck
+	Draining       bool
+	DrainDeadline  *time.Time
+	LeaseTtl       time.Duration
+	// LeaseExpiresAt is when the lease expires, unless it's renewed. It's only updated once at least half the ttl
+	// has passed, to limit writes
+	LeaseExpiresAt *time.Time
+	// DeleteCause is recorded just before the terminator is deleted, so that delete event listeners can report why
+	// it was deleted
+	DeleteCause string
+	// FailedBy records what gave the terminator failed precedence, if it was failed automatically, and
+	// RestorePrecedence is the precedence it had before. Both are cleared once the precedence is no longer failed
+	FailedBy          string
+	RestorePrecedence string
 }
 
 func (entity *Terminator) GetCost() uint16 {
@@ -105,6 +180,13 @@ func (entity *Terminator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldTerminatorCost, 0))
 	entity.Precedence = bucket.GetStringWithDefault(FieldTerminatorPrecedence, xt.Precedences.Default.String())
 	entity.HostId = bucket.GetStringWithDefault(FieldTerminatorHostId, "")
+	entity.Draining = bucket.GetBoolWithDefault(FieldTerminatorDraining, false)
+	entity.DrainDeadline = bucket.GetTime(FieldTerminatorDrainDeadline)
+	entity.LeaseTtl = time.Duration(bucket.GetInt64WithDefault(FieldTerminatorLeaseTtl, 0)) * time.Millisecond
+	entity.LeaseExpiresAt = bucket.GetTime(FieldTerminatorLeaseExpiresAt)
+	entity.DeleteCause = bucket.GetStringWithDefault(FieldTerminatorDeleteCause, "")
+	entity.FailedBy = bucket.GetStringWithDefault(FieldTerminatorFailedBy, "")
+	entity.RestorePrecedence = bucket.GetStringWithDefault(FieldTerminatorRestorePrecedence, "")
 	data := bucket.GetBucket(FieldServerPeerData)
 	if data != nil {
 		entity.PeerData = make(map[uint32][]byte)
@@ -113,6 +195,17 @@ func (entity *Terminator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 			entity.PeerData[binary.LittleEndian.Uint32(k)] = v
 		}
 	}
+
+	entity.HealthCheck = nil
+	if healthCheckBucket := bucket.GetBucket(FieldTerminatorHealthCheck); healthCheckBucket != nil {
+		entity.HealthCheck = &TerminatorHealthCheck{
+			Type:           healthCheckBucket.GetStringWithDefault(FieldHealthCheckType, ""),
+			Address:        healthCheckBucket.GetStringWithDefault(FieldHealthCheckAddress, ""),
+			Interval:       time.Duration(healthCheckBucket.GetInt64WithDefault(FieldHealthCheckInterval, 0)) * time.Millisecond,
+			Timeout:        time.Duration(healthCheckBucket.GetInt64WithDefault(FieldHealthCheckTimeout, 0)) * time.Millisecond,
+			ExpectedStatus: uint32(healthCheckBucket.GetInt64WithDefault(FieldHealthCheckExpectedStatus, 0)),
+		}
+	}
 }
 
 func (entity *Terminator) SetValues(ctx *boltz.PersistContext) {
@@ -128,21 +221,48 @@ func (entity *Terminator) SetValues(ctx *boltz.PersistContext) {
 		return
 	}
 
-	if ctx.IsCreate { // don't allow service, identity or secret to be changed
+	if ctx.IsCreate { // don't allow service, identity, secret or lease ttl to be changed
 		ctx.SetRequiredString(FieldTerminatorService, entity.Service)
 		ctx.SetString(FieldTerminatorInstanceId, entity.InstanceId)
+		if entity.LeaseTtl > 0 {
+			ctx.SetInt64(FieldTerminatorLeaseTtl, entity.LeaseTtl.Milliseconds())
+		}
 		if entity.InstanceSecret != nil {
 			ctx.Bucket.PutValue([]byte(FieldTerminatorInstanceSecret), entity.InstanceSecret)
 		}
 	}
 
+	// only cleared when explicitly requested, so that full updates from the REST api leave the lease alone
+	if entity.LeaseExpiresAt != nil || (ctx.FieldChecker != nil && ctx.FieldChecker.IsUpdated(FieldTerminatorLeaseExpiresAt)) {
+		ctx.SetTimeP(FieldTerminatorLeaseExpiresAt, entity.LeaseExpiresAt)
+	}
+
 	ctx.SetRequiredString(FieldTerminatorRouter, entity.Router)
 	ctx.SetRequiredString(FieldTerminatorBinding, entity.Binding)
 	ctx.SetRequiredString(FieldTerminatorAddress, entity.Address)
 	ctx.SetInt32(FieldTerminatorCost, int32(entity.Cost))
 	ctx.SetRequiredString(FieldTerminatorPrecedence, entity.Precedence)
+	if ctx.ProceedWithSet(FieldTerminatorPrecedence) && entity.Precedence != xt.Precedences.Failed.String() {
+		// only failed terminators have a precedence to restore
+		entity.FailedBy = ""
+		entity.RestorePrecedence = ""
+		ctx.Bucket.SetNil(FieldTerminatorFailedBy)
+		ctx.Bucket.SetNil(FieldTerminatorRestorePrecedence)
+	} else {
+		ctx.SetString(FieldTerminatorFailedBy, entity.FailedBy)
+		ctx.SetString(FieldTerminatorRestorePrecedence, entity.RestorePrecedence)
+	}
 	ctx.SetString(FieldTerminatorHostId, entity.HostId)
 
+	ctx.SetBool(FieldTerminatorDraining, entity.Draining)
+	if ctx.ProceedWithSet(FieldTerminatorDraining) && !entity.Draining {
+		// a terminator which isn't draining can't have a drain deadline
+		entity.DrainDeadline = nil
+		ctx.Bucket.SetNil(FieldTerminatorDrainDeadline)
+	} else {
+		ctx.SetTimeP(FieldTerminatorDrainDeadline, entity.DrainDeadline)
+	}
+
 	if ctx.ProceedWithSet(FieldServerPeerData) {
 		_ = ctx.Bucket.DeleteBucket([]byte(FieldServerPeerData))
 		if entity.PeerData != nil {
@@ -155,6 +275,23 @@ func (entity *Terminator) SetValues(ctx *boltz.PersistContext) {
 		}
 	}
 
+	if ctx.ProceedWithSet(FieldTerminatorHealthCheck) {
+		_ = ctx.Bucket.DeleteBucket([]byte(FieldTerminatorHealthCheck))
+		if entity.HealthCheck != nil {
+			if err := entity.HealthCheck.validate(); err != nil {
+				ctx.Bucket.SetError(err)
+				return
+			}
+			healthCheckBucket := ctx.Bucket.GetOrCreateBucket(FieldTerminatorHealthCheck)
+			healthCheckBucket.SetString(FieldHealthCheckType, entity.HealthCheck.Type, nil)
+			healthCheckBucket.SetString(FieldHealthCheckAddress, entity.HealthCheck.Address, nil)
+			healthCheckBucket.SetInt64(FieldHealthCheckInterval, entity.HealthCheck.Interval.Milliseconds(), nil)
+			healthCheckBucket.SetInt64(FieldHealthCheckTimeout, entity.HealthCheck.Timeout.Milliseconds(), nil)
+			healthCheckBucket.SetInt64(FieldHealthCheckExpectedStatus, int64(entity.HealthCheck.ExpectedStatus), nil)
+			ctx.Bucket.SetError(healthCheckBucket.GetError())
+		}
+	}
+
 	if ctx.Bucket.HasError() {
 		return
 	}
@@ -192,6 +329,7 @@ type TerminatorStore interface {
 	boltz.CrudStore
 	LoadOneById(tx *bbolt.Tx, id string) (*Terminator, error)
 	GetTerminatorsInIdentityGroup(tx *bbolt.Tx, terminatorId string) ([]*Terminator, error)
+	DeleteWithCause(ctx boltz.MutateContext, id string, cause string) error
 }
 
 func newTerminatorStore(stores *stores) *terminatorStoreImpl {
@@ -228,6 +366,9 @@ func (store *terminatorStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldTerminatorAddress, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorInstanceId, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorHostId, ast.NodeTypeString)
+	store.AddSymbol(FieldTerminatorDraining, ast.NodeTypeBool)
+	store.AddSymbol(FieldTerminatorLeaseTtl, ast.NodeTypeInt64)
+	store.AddSymbol(FieldTerminatorFailedBy, ast.NodeTypeString)
 
 	store.serviceSymbol = store.AddFkSymbol(FieldTerminatorService, store.stores.service)
 	store.routerSymbol = store.AddFkSymbol(FieldTerminatorRouter, store.stores.router)
@@ -288,6 +429,18 @@ func (store *terminatorStoreImpl) DeleteById(ctx boltz.MutateContext, id string)
 	return store.baseStore.DeleteById(ctx, id)
 }
 
+// DeleteWithCause deletes the terminator, recording the cause on the entity passed to delete event listeners
+func (store *terminatorStoreImpl) DeleteWithCause(ctx boltz.MutateContext, id string, cause string) error {
+	if cause != "" {
+		if bucket := store.GetEntityBucket(ctx.Tx(), []byte(id)); bucket != nil {
+			if bucket.SetString(FieldTerminatorDeleteCause, cause, nil); bucket.HasError() {
+				return bucket.GetError()
+			}
+		}
+	}
+	return store.DeleteById(ctx, id)
+}
+
 func (store *terminatorStoreImpl) GetTerminatorsInIdentityGroup(tx *bbolt.Tx, terminatorId string) ([]*Terminator, error) {
 	terminator, err := store.LoadOneById(tx, terminatorId)
 	if err != nil {
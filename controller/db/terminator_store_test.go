@@ -40,6 +40,10 @@ func Test_TerminatorStore(t *testing.T) {
 	t.Run("test update terminators", ctx.testUpdateTerminators)
 	t.Run("test delete terminators", ctx.testDeleteTerminators)
 	t.Run("test patch terminators", ctx.testPatchTerminator)
+	t.Run("test terminator health checks", ctx.testTerminatorHealthCheck)
+	t.Run("test terminator draining", ctx.testTerminatorDraining)
+	t.Run("test terminator lease ttl", ctx.testTerminatorLeaseTtl)
+	t.Run("test terminator restore precedence", ctx.testTerminatorRestorePrecedence)
 }
 
 func (ctx *TestContext) testCreateInvalidTerminators(t *testing.T) {
@@ -285,6 +289,177 @@ func (ctx *TestContext) testPatchTerminator(t *testing.T) {
 	ctx.ValidateUpdated(terminator)
 }
 
+func (ctx *TestContext) testTerminatorHealthCheck(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	service := ctx.requireNewService()
+	router := ctx.requireNewRouter()
+
+	terminator := &Terminator{}
+	terminator.Service = service.Id
+	terminator.Router = router.Id
+	terminator.Binding = uuid.New().String()
+	terminator.Address = uuid.New().String()
+	terminator.HealthCheck = &TerminatorHealthCheck{
+		Type: "ping",
+	}
+	err := ctx.Create(terminator)
+	ctx.EqualError(err, "the value 'ping' for 'healthCheck.type' is invalid: health check type must be one of tcp, http or binding")
+
+	terminator.HealthCheck.Type = xt.HealthCheckTypeHttp
+	terminator.HealthCheck.Address = "localhost:8080"
+	err = ctx.Create(terminator)
+	ctx.EqualError(err, "the value 'localhost:8080' for 'healthCheck.address' is invalid: http health checks require an http or https url")
+
+	terminator.HealthCheck.Address = "http://localhost:8080/health"
+	terminator.HealthCheck.Interval = 100 * time.Millisecond
+	err = ctx.Create(terminator)
+	ctx.EqualError(err, "the value '100' for 'healthCheck.interval' is invalid: health check interval must be at least 1s")
+
+	terminator.HealthCheck.Interval = 0
+	ctx.RequireCreate(terminator)
+
+	loaded := &Terminator{}
+	loaded.Id = terminator.Id
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.HealthCheck)
+	ctx.Equal(xt.HealthCheckTypeHttp, loaded.HealthCheck.Type)
+	ctx.Equal("http://localhost:8080/health", loaded.HealthCheck.Address)
+	ctx.Equal(xt.DefaultHealthCheckInterval, loaded.HealthCheck.Interval)
+	ctx.Equal(xt.DefaultHealthCheckTimeout, loaded.HealthCheck.Timeout)
+	ctx.Equal(uint32(xt.DefaultHealthCheckExpectedStatus), loaded.HealthCheck.ExpectedStatus)
+
+	terminator.HealthCheck = &TerminatorHealthCheck{
+		Type:     xt.HealthCheckTypeTcp,
+		Interval: 2 * time.Second,
+		Timeout:  10 * time.Second,
+	}
+	ctx.RequirePatch(terminator, fields.UpdatedFieldsMap{FieldTerminatorHealthCheck: struct{}{}})
+
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.HealthCheck)
+	ctx.Equal(xt.HealthCheckTypeTcp, loaded.HealthCheck.Type)
+	ctx.Equal("", loaded.HealthCheck.Address)
+	ctx.Equal(2*time.Second, loaded.HealthCheck.Interval)
+	ctx.Equal(2*time.Second, loaded.HealthCheck.Timeout)
+	ctx.Equal(uint32(0), loaded.HealthCheck.ExpectedStatus)
+
+	terminator.HealthCheck = nil
+	ctx.RequirePatch(terminator, fields.UpdatedFieldsMap{FieldTerminatorHealthCheck: struct{}{}})
+
+	loaded = &Terminator{}
+	loaded.Id = terminator.Id
+	ctx.RequireReload(loaded)
+	ctx.Nil(loaded.HealthCheck)
+}
+
+func (ctx *TestContext) testTerminatorDraining(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	service := ctx.requireNewService()
+	router := ctx.requireNewRouter()
+
+	terminator := &Terminator{}
+	terminator.Service = service.Id
+	terminator.Router = router.Id
+	terminator.Binding = uuid.New().String()
+	terminator.Address = uuid.New().String()
+	ctx.RequireCreate(terminator)
+
+	deadline := time.Now().Add(time.Minute).UTC()
+	terminator.Draining = true
+	terminator.DrainDeadline = &deadline
+	ctx.RequirePatch(terminator, fields.UpdatedFieldsMap{
+		FieldTerminatorDraining:      struct{}{},
+		FieldTerminatorDrainDeadline: struct{}{},
+	})
+
+	loaded := &Terminator{}
+	loaded.Id = terminator.Id
+	ctx.RequireReload(loaded)
+	ctx.True(loaded.Draining)
+	ctx.NotNil(loaded.DrainDeadline)
+	ctx.True(deadline.Equal(*loaded.DrainDeadline))
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ids, _, err := ctx.stores.Terminator.QueryIds(tx, "draining = true")
+		ctx.NoError(err)
+		ctx.Equal([]string{terminator.Id}, ids)
+		return nil
+	})
+	ctx.NoError(err)
+
+	terminator.Draining = false
+	ctx.RequirePatch(terminator, fields.UpdatedFieldsMap{FieldTerminatorDraining: struct{}{}})
+
+	loaded = &Terminator{}
+	loaded.Id = terminator.Id
+	ctx.RequireReload(loaded)
+	ctx.False(loaded.Draining)
+	ctx.Nil(loaded.DrainDeadline)
+}
+
+func (ctx *TestContext) testTerminatorLeaseTtl(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	service := ctx.requireNewService()
+	router := ctx.requireNewRouter()
+
+	newTerminator := func(leaseTtl time.Duration) *Terminator {
+		terminator := &Terminator{}
+		terminator.Service = service.Id
+		terminator.Router = router.Id
+		terminator.Binding = uuid.New().String()
+		terminator.Address = uuid.New().String()
+		terminator.LeaseTtl = leaseTtl
+		ctx.RequireCreate(terminator)
+		return terminator
+	}
+
+	leased := newTerminator(30 * time.Second)
+	newTerminator(0)
+
+	loaded := &Terminator{}
+	loaded.Id = leased.Id
+	ctx.RequireReload(loaded)
+	ctx.Equal(30*time.Second, loaded.LeaseTtl)
+
+	// the lease ttl can only be set at creation
+	leased.LeaseTtl = time.Minute
+	ctx.RequireUpdate(leased)
+	ctx.RequireReload(loaded)
+	ctx.Equal(30*time.Second, loaded.LeaseTtl)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ids, _, err := ctx.stores.Terminator.QueryIds(tx, "leaseTtl > 0")
+		ctx.NoError(err)
+		ctx.Equal([]string{leased.Id}, ids)
+		return nil
+	})
+	ctx.NoError(err)
+
+	// the lease expiry is only cleared when explicitly requested
+	expiresAt := time.Now().Add(time.Minute)
+	leased.LeaseExpiresAt = &expiresAt
+	ctx.RequireUpdate(leased)
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.LeaseExpiresAt)
+	ctx.True(expiresAt.Equal(*loaded.LeaseExpiresAt))
+
+	loaded.LeaseExpiresAt = nil
+	ctx.RequireUpdate(loaded)
+	ctx.RequireReload(loaded)
+	ctx.NotNil(loaded.LeaseExpiresAt)
+
+	loaded.LeaseExpiresAt = nil
+	ctx.RequirePatch(loaded, fields.UpdatedFieldsMap{FieldTerminatorLeaseExpiresAt: struct{}{}})
+	ctx.RequireReload(loaded)
+	ctx.Nil(loaded.LeaseExpiresAt)
+}
+
 type testStrategyFactory struct{}
 
 func (t testStrategyFactory) GetStrategyName() string {
@@ -307,3 +482,47 @@ func (t testStrategy) HandleTerminatorChange(xt.StrategyChangeEvent) error {
 
 func (t testStrategy) NotifyEvent(xt.TerminatorEvent) {
 }
+
+func (ctx *TestContext) testTerminatorRestorePrecedence(t *testing.T) {
+	ctx.NextTest(t)
+	defer ctx.cleanupAll()
+
+	service := ctx.requireNewService()
+	router := ctx.requireNewRouter()
+
+	terminator := &Terminator{}
+	terminator.Service = service.Id
+	terminator.Router = router.Id
+	terminator.Binding = uuid.New().String()
+	terminator.Address = uuid.New().String()
+	terminator.Precedence = xt.Precedences.Required.String()
+	ctx.RequireCreate(terminator)
+
+	terminator.Precedence = xt.Precedences.Failed.String()
+	terminator.FailedBy = "healthCheck"
+	terminator.RestorePrecedence = xt.Precedences.Required.String()
+	ctx.RequirePatch(terminator, fields.UpdatedFieldsMap{
+		FieldTerminatorPrecedence:        struct{}{},
+		FieldTerminatorFailedBy:          struct{}{},
+		FieldTerminatorRestorePrecedence: struct{}{},
+	})
+
+	loaded := &Terminator{}
+	loaded.Id = terminator.Id
+	ctx.RequireReload(loaded)
+	ctx.Equal("healthCheck", loaded.FailedBy)
+	ctx.Equal(xt.Precedences.Required.String(), loaded.RestorePrecedence)
+
+	// changing other fields leaves the restore precedence in place
+	loaded.Cost = 10
+	ctx.RequirePatch(loaded, fields.UpdatedFieldsMap{FieldTerminatorCost: struct{}{}})
+	ctx.RequireReload(loaded)
+	ctx.Equal("healthCheck", loaded.FailedBy)
+
+	// once the terminator isn't failed, there's nothing to restore
+	loaded.Precedence = xt.Precedences.Default.String()
+	ctx.RequirePatch(loaded, fields.UpdatedFieldsMap{FieldTerminatorPrecedence: struct{}{}})
+	ctx.RequireReload(loaded)
+	ctx.Equal("", loaded.FailedBy)
+	ctx.Equal("", loaded.RestorePrecedence)
+}
@@ -50,9 +50,12 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newCircuitRequestHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newRouteResultHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCircuitConfirmationHandler(self.network, self.router))
+	binding.AddTypedReceiveHandler(newRouterCircuitsHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCreateTerminatorHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newRemoveTerminatorHandler(self.network))
 	binding.AddTypedReceiveHandler(newUpdateTerminatorHandler(self.network))
+	binding.AddTypedReceiveHandler(newTerminatorHealthStatusHandler(self.network, self.router))
+	binding.AddTypedReceiveHandler(newTerminatorLeaseRenewalsHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newLinkConnectedHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newRouterLinkHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newVerifyLinkHandler(self.router, self.network))
@@ -73,9 +76,13 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 
 	roundTripHistogram := self.network.GetMetricsRegistry().Histogram("ctrl.latency:" + self.router.Id)
 	queueTimeHistogram := self.network.GetMetricsRegistry().Histogram("ctrl.queue_time:" + self.router.Id)
+	routeLatencyHistogram := self.network.GetMetricsRegistry().Histogram(network.RouteLatencyMetric + self.router.Id)
+	routeDialTimeHistogram := self.network.GetMetricsRegistry().Histogram(network.RouteDialTimeMetric + self.router.Id)
 	binding.AddCloseHandler(channel.CloseHandlerF(func(ch channel.Channel) {
 		roundTripHistogram.Dispose()
 		queueTimeHistogram.Dispose()
+		routeLatencyHistogram.Dispose()
+		routeDialTimeHistogram.Dispose()
 	}))
 
 	if doHeartbeat {
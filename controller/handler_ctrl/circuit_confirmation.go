@@ -46,6 +46,8 @@ func (self *circuitConfirmationHandler) HandleReceive(msg *channel.Message, _ ch
 		for _, circuitId := range confirm.CircuitIds {
 			if circuit, found := self.n.GetCircuit(circuitId); found && circuit.HasRouter(self.r.Id) {
 				log.WithField("circuitId", circuitId).Debug("circuit found, ignoring")
+			} else if self.n.IsCircuitPendingRecovery(circuitId) {
+				log.WithField("circuitId", circuitId).Debug("circuit pending recovery, ignoring")
 			} else {
 				go self.sendUnroute(circuitId)
 			}
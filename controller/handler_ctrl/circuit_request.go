@@ -132,6 +132,10 @@ func (self *circuitParams) GetCircuitTags(xt.CostedTerminator) map[string]string
 	}
 }
 
+func (self *circuitParams) GetRequestTags() map[string]string {
+	return self.GetCircuitTags(nil)
+}
+
 func (self *circuitParams) GetLogContext() logcontext.Context {
 	return self.ctx
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"google.golang.org/protobuf/proto"
 	"math"
+	"time"
 )
 
 type createTerminatorHandler struct {
@@ -59,6 +60,12 @@ func (h *createTerminatorHandler) handleCreateTerminator(msg *channel.Message, c
 		return
 	}
 
+	leaseTtl := time.Duration(request.LeaseTtl)
+	if leaseTtl < 0 || (leaseTtl > 0 && leaseTtl < network.MinTerminatorLeaseTtl) {
+		handler_common.SendFailure(msg, ch, fmt.Sprintf("invalid lease ttl %v. lease ttl must be at least %v", leaseTtl, network.MinTerminatorLeaseTtl))
+		return
+	}
+
 	terminator := &network.Terminator{
 		Service:        request.ServiceId,
 		Router:         h.router.Id,
@@ -69,6 +76,7 @@ func (h *createTerminatorHandler) handleCreateTerminator(msg *channel.Message, c
 		PeerData:       request.PeerData,
 		Precedence:     request.GetXtPrecedence(),
 		Cost:           uint16(request.Cost),
+		LeaseTtl:       leaseTtl,
 	}
 
 	if err := h.network.Terminators.Create(terminator); err == nil {
@@ -60,7 +60,7 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 			circuitId := string(msg.Body)
 			peerData := xt.PeerData{}
 			for k, v := range msg.Headers {
-				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultErrorCodeHeader) {
+				if k > 0 && (k < ctrl_msg.RouteResultSuccessHeader || k > ctrl_msg.RouteResultDialDurationHeader) {
 					peerData[uint32(k)] = v
 				}
 			}
@@ -78,6 +78,10 @@ func (self *routeResultHandler) handleRouteResult(msg *channel.Message) {
 				rs.ErrorCode = &errCode
 			}
 
+			if dialDuration, hasDialDuration := ctrl_msg.GetDialDuration(msg); hasDialDuration {
+				rs.DialDuration = &dialDuration
+			}
+
 			routing := self.network.RouteResult(rs)
 			if !routing && attempt != network.SmartRerouteAttempt {
 				go self.notRoutingCircuit(circuitId)
@@ -0,0 +1,47 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+type terminatorHealthStatusHandler struct {
+	n *network.Network
+	r *network.Router
+}
+
+func newTerminatorHealthStatusHandler(n *network.Network, r *network.Router) *terminatorHealthStatusHandler {
+	return &terminatorHealthStatusHandler{n, r}
+}
+
+func (self *terminatorHealthStatusHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_TerminatorHealthStatusType)
+}
+
+func (self *terminatorHealthStatusHandler) HandleReceive(msg *channel.Message, _ channel.Channel) {
+	status := &ctrl_pb.TerminatorHealthStatus{}
+	if err := proto.Unmarshal(msg.Body, status); err == nil {
+		go self.n.HandleTerminatorHealthStatus(self.r, status)
+	} else {
+		logrus.WithField("routerId", self.r.Id).WithError(err).Error("error unmarshalling terminator health status")
+	}
+}
@@ -34,6 +34,8 @@ func NewBindHandler(network *network.Network, xmgmts []xmgmt.Xmgmt) channel.Bind
 
 func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newInspectHandler(bindHandler.network))
+	binding.AddTypedReceiveHandler(newRoutePreviewHandler(bindHandler.network))
+	binding.AddTypedReceiveHandler(newRerouteCircuitHandler(bindHandler.network))
 
 	streamMetricHandler := newStreamMetricsHandler(bindHandler.network)
 	binding.AddTypedReceiveHandler(streamMetricHandler)
@@ -43,6 +45,10 @@ func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(streamCircuitsHandler)
 	binding.AddCloseHandler(streamCircuitsHandler)
 
+	streamEventJournalHandler := newStreamEventJournalHandler(bindHandler.network)
+	binding.AddTypedReceiveHandler(streamEventJournalHandler)
+	binding.AddCloseHandler(streamEventJournalHandler)
+
 	streamTracesHandler := newStreamTracesHandler(bindHandler.network)
 	binding.AddTypedReceiveHandler(streamTracesHandler)
 	binding.AddCloseHandler(streamTracesHandler)
@@ -0,0 +1,70 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/pb/mgmt_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type rerouteCircuitHandler struct {
+	network *network.Network
+}
+
+func newRerouteCircuitHandler(network *network.Network) *rerouteCircuitHandler {
+	return &rerouteCircuitHandler{network: network}
+}
+
+func (*rerouteCircuitHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_RerouteCircuitRequestType)
+}
+
+func (handler *rerouteCircuitHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	go func() {
+		response := &mgmt_pb.RerouteCircuitResponse{}
+		request := &mgmt_pb.RerouteCircuitRequest{}
+		if err := proto.Unmarshal(msg.Body, request); err != nil {
+			response.Error = err.Error()
+		} else if circuit, err := handler.network.PinCircuitPath(request.CircuitId, request.RouterIds, request.Pin); err != nil {
+			response.Error = err.Error()
+		} else {
+			response.Success = true
+			response.Pinned = circuit.Pinned.Get()
+			for _, r := range circuit.Path.Nodes {
+				response.PathRouterIds = append(response.PathRouterIds, r.Id)
+			}
+			for _, l := range circuit.Path.Links {
+				response.PathLinkIds = append(response.PathLinkIds, l.Id)
+			}
+		}
+
+		body, err := proto.Marshal(response)
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error serializing RerouteCircuitResponse (%s)", err)
+			return
+		}
+
+		responseMsg := channel.NewMessage(int32(mgmt_pb.ContentType_RerouteCircuitResponseType), body)
+		responseMsg.ReplyTo(msg)
+		if err := ch.Send(responseMsg); err != nil {
+			pfxlog.Logger().Errorf("unexpected error sending RerouteCircuitResponse (%s)", err)
+		}
+	}()
+}
@@ -0,0 +1,114 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/pb/mgmt_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type routePreviewHandler struct {
+	network *network.Network
+}
+
+func newRoutePreviewHandler(network *network.Network) *routePreviewHandler {
+	return &routePreviewHandler{network: network}
+}
+
+func (*routePreviewHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_RoutePreviewRequestType)
+}
+
+func (handler *routePreviewHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	go func() {
+		response := &mgmt_pb.RoutePreviewResponse{}
+		request := &mgmt_pb.RoutePreviewRequest{}
+		if err := proto.Unmarshal(msg.Body, request); err != nil {
+			response.Error = err.Error()
+		} else if preview, err := handler.network.PreviewRoute(request.ServiceId, request.SourceRouterId, request.InstanceId, request.ClientId); err != nil {
+			response.Error = err.Error()
+		} else {
+			response.Success = true
+			handler.fillResponse(response, preview)
+		}
+
+		body, err := proto.Marshal(response)
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error serializing RoutePreviewResponse (%s)", err)
+			return
+		}
+
+		responseMsg := channel.NewMessage(int32(mgmt_pb.ContentType_RoutePreviewResponseType), body)
+		responseMsg.ReplyTo(msg)
+		if err := ch.Send(responseMsg); err != nil {
+			pfxlog.Logger().Errorf("unexpected error sending RoutePreviewResponse (%s)", err)
+		}
+	}()
+}
+
+func (handler *routePreviewHandler) fillResponse(response *mgmt_pb.RoutePreviewResponse, preview *network.RoutePreview) {
+	response.ServiceId = preview.Service.Id
+	response.SourceRouterId = preview.SourceRouter.Id
+	response.InstanceId = preview.InstanceId
+	response.Strategy = preview.Strategy
+
+	if preview.Err != nil {
+		response.SelectionError = preview.Err.Error()
+	}
+
+	if preview.Selected != nil {
+		response.SelectedTerminatorId = preview.Selected.Terminator.Id
+	}
+
+	if preview.Path != nil {
+		for _, r := range preview.Path.Nodes {
+			response.PathRouterIds = append(response.PathRouterIds, r.Id)
+		}
+		for _, l := range preview.Path.Links {
+			response.PathLinkIds = append(response.PathLinkIds, l.Id)
+		}
+	}
+
+	for _, candidate := range preview.Candidates {
+		terminator := candidate.Terminator
+		c := &mgmt_pb.RoutePreviewResponse_Candidate{
+			TerminatorId: terminator.Id,
+			RouterId:     terminator.Router,
+			InstanceId:   terminator.InstanceId,
+			Precedence:   terminator.Precedence.String(),
+			StaticCost:   uint32(terminator.Cost),
+			DynamicCost:  uint32(candidate.DynamicCost),
+			PathCost:     candidate.PathCost,
+			Selected:     candidate == preview.Selected,
+			SkipReason:   string(candidate.SkipReason),
+		}
+		if candidate.Routing != nil {
+			c.Precedence = candidate.Routing.GetPrecedence().String()
+			c.RouteCost = candidate.Routing.RouteCost
+		}
+		if candidate.Err != nil {
+			c.SkipDetail = candidate.Err.Error()
+		}
+		for _, r := range candidate.Path {
+			c.PathRouterIds = append(c.PathRouterIds, r.Id)
+		}
+		response.Candidates = append(response.Candidates, c)
+	}
+}
@@ -62,6 +62,8 @@ func (handler *CircuitsStreamHandler) AcceptCircuitEvent(e *event.CircuitEvent)
 		eventType = mgmt_pb.StreamCircuitEventType_CircuitDeleted
 	} else if e.EventType == event.CircuitFailed {
 		eventType = mgmt_pb.StreamCircuitEventType_CircuitFailed
+	} else if e.EventType == event.CircuitPresent {
+		eventType = mgmt_pb.StreamCircuitEventType_CircuitPresent
 	}
 
 	var cts *int64
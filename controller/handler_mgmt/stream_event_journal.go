@@ -0,0 +1,88 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/fabric/handler_common"
+	"github.com/openziti/fabric/pb/mgmt_pb"
+	"github.com/openziti/foundation/v2/stringz"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+)
+
+type streamEventJournalHandler struct {
+	network      *network.Network
+	lock         sync.Mutex
+	unsubscribes []func()
+}
+
+func newStreamEventJournalHandler(network *network.Network) *streamEventJournalHandler {
+	return &streamEventJournalHandler{network: network}
+}
+
+func (*streamEventJournalHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_StreamEventJournalRequestType)
+}
+
+func (handler *streamEventJournalHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	request := &mgmt_pb.StreamEventJournalRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+
+	journal := handler.network.GetEventDispatcher().GetJournal()
+	if journal == nil {
+		handler_common.SendFailure(msg, ch, "event journal is not enabled")
+		return
+	}
+
+	log.WithField("fromSequence", request.FromSequence).Info("streaming event journal")
+
+	unsubscribe := journal.Subscribe(request.FromSequence, func(entry *event.JournalEntry) error {
+		if len(request.Namespaces) > 0 && !stringz.Contains(request.Namespaces, entry.Namespace) {
+			return nil
+		}
+		return protobufs.MarshalTyped(&mgmt_pb.StreamEventJournalEvent{
+			Sequence:  entry.Sequence,
+			Namespace: entry.Namespace,
+			Timestamp: timestamppb.New(entry.Timestamp),
+			Data:      entry.Data,
+		}).Send(ch)
+	})
+
+	handler.lock.Lock()
+	handler.unsubscribes = append(handler.unsubscribes, unsubscribe)
+	handler.lock.Unlock()
+}
+
+func (handler *streamEventJournalHandler) HandleClose(channel.Channel) {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	for _, unsubscribe := range handler.unsubscribes {
+		unsubscribe()
+	}
+	handler.unsubscribes = nil
+}
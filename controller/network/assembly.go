@@ -31,7 +31,11 @@ func (network *Network) assemble() {
 	if network.Routers.connectedCount() > 1 {
 		log.Tracef("assembling with [%d] routers", network.Routers.connectedCount())
 
-		missingLinks, err := network.linkController.missingLinks(network.Routers.allConnected(), network.options.PendingLinkTimeout)
+		var missingLinks []*Link
+		policies, err := network.LinkPolicies.getPolicySet()
+		if err == nil {
+			missingLinks, err = network.linkController.missingLinks(network.Routers.allConnected(), network.options.PendingLinkTimeout, policies)
+		}
 		if err == nil {
 			for _, missingLink := range missingLinks {
 				network.linkController.add(missingLink)
@@ -60,6 +64,7 @@ func (network *Network) assemble() {
 					network.NotifyLinkEvent(missingLink, event.LinkDialed)
 				}
 			}
+			network.removeDisallowedLinks(policies)
 		} else {
 			log.WithField("err", err).Error("missing link enumeration failed")
 		}
@@ -80,6 +85,7 @@ func (network *Network) NotifyLinkEvent(link *Link, eventType event.LinkEventTyp
 		Cost:        link.GetStaticCost(),
 		DialAddress: link.DialAddress,
 	}
+	network.setLinkEventFlapDamping(link, linkEvent)
 	network.eventDispatcher.AcceptLinkEvent(linkEvent)
 }
 
@@ -95,6 +101,7 @@ func (network *Network) NotifyLinkConnected(link *Link, msg *ctrl_pb.LinkConnect
 		Cost:        link.GetStaticCost(),
 		DialAddress: link.DialAddress,
 	}
+	network.setLinkEventFlapDamping(link, linkEvent)
 
 	for _, conn := range msg.Conns {
 		linkEvent.Connections = append(linkEvent.Connections, &event.LinkConnection{
@@ -107,6 +114,39 @@ func (network *Network) NotifyLinkConnected(link *Link, msg *ctrl_pb.LinkConnect
 	network.eventDispatcher.AcceptLinkEvent(linkEvent)
 }
 
+// GetLinkFlapDampingOptions returns the flap damping configuration in use for links
+func (network *Network) GetLinkFlapDampingOptions() *LinkFlapDampingOptions {
+	return network.linkController.flapDamping
+}
+
+func (network *Network) setLinkEventFlapDamping(link *Link, linkEvent *event.LinkEvent) {
+	if options := network.GetLinkFlapDampingOptions(); options.Enabled {
+		linkEvent.FlapPenalty = link.GetFlapPenalty()
+		linkEvent.FlapSuppressed = link.IsFlapSuppressed()
+		linkEvent.FlapSuppressThreshold = options.SuppressThreshold
+		linkEvent.FlapReuseThreshold = options.ReuseThreshold
+	}
+}
+
+// updateLinkFlapDamping applies decayed flap penalties to all links and emits events for links which have become
+// suppressed or usable again
+func (network *Network) updateLinkFlapDamping() {
+	for _, link := range network.linkController.updateFlapDamping(time.Now()) {
+		network.notifyLinkFlapStateChanged(link)
+	}
+}
+
+func (network *Network) notifyLinkFlapStateChanged(link *Link) {
+	log := pfxlog.Logger().WithField("linkId", link.Id).WithField("flapPenalty", link.GetFlapPenalty())
+	if link.IsFlapSuppressed() {
+		log.Warn("link suppressed due to flapping")
+		network.NotifyLinkEvent(link, event.LinkFlapSuppressed)
+	} else {
+		log.Info("link no longer suppressed for flapping")
+		network.NotifyLinkEvent(link, event.LinkFlapReused)
+	}
+}
+
 func (network *Network) NotifyLinkIdEvent(linkId string, eventType event.LinkEventType) {
 	linkEvent := &event.LinkEvent{
 		Namespace: event.LinkEventsNs,
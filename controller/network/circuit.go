@@ -20,9 +20,11 @@ import (
 	"github.com/openziti/fabric/controller/idgen"
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/logcontext"
+	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/openziti/identity"
 	"github.com/orcaman/concurrent-map/v2"
+	"sync/atomic"
 	"time"
 )
 
@@ -32,21 +34,35 @@ type Circuit struct {
 	Service    *Service
 	Terminator xt.CostedTerminator
 	Path       *Path
-	Tags       map[string]string
-	Rerouting  concurrenz.AtomicBoolean
-	PeerData   xt.PeerData
-	CreatedAt  time.Time
+	// StandbyPath, if set, is pre-installed on its routers and is disjoint from Path
+	StandbyPath *Path
+	Tags        map[string]string
+	Rerouting   concurrenz.AtomicBoolean
+	// Pinned circuits were placed on an explicit path by an operator and are exempt from smart rerouting
+	Pinned    concurrenz.AtomicBoolean
+	PeerData  xt.PeerData
+	CreatedAt time.Time
+	rerouted  int64
 }
 
 func (self *Circuit) cost() int64 {
-	var cost int64
-	for _, l := range self.Path.Links {
-		cost += l.GetCost()
-	}
-	for _, r := range self.Path.Nodes {
-		cost += int64(r.Cost)
+	return self.Path.cost()
+}
+
+// markRerouted records that the circuit path was changed. A circuit which is moved is no longer on its pinned path,
+// so it's also unpinned.
+func (self *Circuit) markRerouted() {
+	atomic.StoreInt64(&self.rerouted, time.Now().UnixNano())
+	self.Pinned.Set(false)
+}
+
+// sinceLastReroute returns the time since the circuit was last rerouted, and false if it has never been rerouted
+func (self *Circuit) sinceLastReroute() (time.Duration, bool) {
+	rerouted := atomic.LoadInt64(&self.rerouted)
+	if rerouted == 0 {
+		return 0, false
 	}
-	return cost
+	return time.Since(time.Unix(0, rerouted)), true
 }
 
 func (self *Circuit) HasRouter(routerId string) bool {
@@ -61,6 +77,31 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	return false
 }
 
+// routers returns the routers on the circuit path and on the standby path, if there is one
+func (self *Circuit) routers() []*Router {
+	result := append([]*Router{}, self.Path.Nodes...)
+	if self.StandbyPath != nil {
+		for _, r := range self.StandbyPath.Nodes {
+			if !self.HasRouter(r.Id) {
+				result = append(result, r)
+			}
+		}
+	}
+	return result
+}
+
+// addCircuitInfo adds the circuit details to the route messages. Routers report them back when reconnecting, so
+// the circuit can be rebuilt if the controller has lost track of it.
+func (self *Circuit) addCircuitInfo(rms []*ctrl_pb.Route) {
+	for _, msg := range rms {
+		msg.ServiceId = self.Service.Id
+		msg.TerminatorId = self.Terminator.GetId()
+		msg.ClientId = self.ClientId
+		msg.Tags = self.Tags
+		msg.CreatedAt = self.CreatedAt.UnixMilli()
+	}
+}
+
 type circuitController struct {
 	circuits    cmap.ConcurrentMap[*Circuit]
 	idGenerator idgen.Generator
@@ -96,8 +137,11 @@ func (self *circuitController) all() []*Circuit {
 	return circuits
 }
 
-func (self *circuitController) remove(circuit *Circuit) {
-	self.circuits.Remove(circuit.Id)
+// remove removes the circuit, returning true if it was still present
+func (self *circuitController) remove(circuit *Circuit) bool {
+	return self.circuits.RemoveCb(circuit.Id, func(key string, v *Circuit, exists bool) bool {
+		return exists && v == circuit
+	})
 }
 
 type CreateCircuitParams interface {
@@ -108,3 +152,56 @@ type CreateCircuitParams interface {
 	GetLogContext() logcontext.Context
 	GetDeadline() time.Time
 }
+
+// RequestTagsProvider may be implemented by CreateCircuitParams which can supply circuit tags before a terminator has
+// been selected. The tags are made available to terminator strategies.
+type RequestTagsProvider interface {
+	GetRequestTags() map[string]string
+}
+
+func getRequestTags(params CreateCircuitParams) map[string]string {
+	if provider, ok := params.(RequestTagsProvider); ok {
+		return provider.GetRequestTags()
+	}
+	return nil
+}
+
+// circuitStrategyParams exposes the parts of a circuit request which terminator strategies may use
+type circuitStrategyParams struct {
+	clientId     string
+	tags         map[string]string
+	sourceRouter *Router
+	routers      *RouterManager
+	trafficSplit *xt.TrafficSplit
+}
+
+func (self *circuitStrategyParams) GetClientId() string {
+	return self.clientId
+}
+
+func (self *circuitStrategyParams) GetCircuitTags() map[string]string {
+	return self.tags
+}
+
+func (self *circuitStrategyParams) GetTrafficSplit() *xt.TrafficSplit {
+	return self.trafficSplit
+}
+
+func (self *circuitStrategyParams) GetSourceRouterId() string {
+	if self.sourceRouter == nil {
+		return ""
+	}
+	return self.sourceRouter.Id
+}
+
+func (self *circuitStrategyParams) GetRouterLocality(routerId string) xt.Locality {
+	if self.sourceRouter != nil && self.sourceRouter.Id == routerId {
+		return self.sourceRouter.GetLocality()
+	}
+	if self.routers != nil {
+		if r := self.routers.getConnected(routerId); r != nil {
+			return r.GetLocality()
+		}
+	}
+	return xt.Locality{}
+}
@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// circuitAdmission enforces the per-service circuit limits. Slots are reserved before a circuit is created and are
+// held by the circuit until it's removed, so that concurrent circuit creates can't exceed the limits.
+type circuitAdmission struct {
+	lock     sync.Mutex
+	services map[string]*serviceAdmission
+}
+
+type serviceAdmission struct {
+	circuits int64
+	clients  map[string]int64
+	tokens   float64
+	refilled time.Time
+}
+
+func newCircuitAdmission() *circuitAdmission {
+	return &circuitAdmission{
+		services: map[string]*serviceAdmission{},
+	}
+}
+
+func (self *circuitAdmission) getServiceAdmission(serviceId string) *serviceAdmission {
+	result, found := self.services[serviceId]
+	if !found {
+		result = &serviceAdmission{
+			clients: map[string]int64{},
+			tokens:  -1,
+		}
+		self.services[serviceId] = result
+	}
+	return result
+}
+
+// reserve checks the service limits for a new circuit from the given client. If the circuit is admitted a slot is
+// reserved, which must be given back using release if the circuit isn't created, or once the circuit is removed.
+func (self *circuitAdmission) reserve(svc *Service, clientId string, now time.Time) CircuitError {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	admission := self.getServiceAdmission(svc.Id)
+
+	if svc.MaxCircuits > 0 && admission.circuits >= int64(svc.MaxCircuits) {
+		self.cleanup(svc.Id, admission)
+		return newCircuitErrorf(CircuitFailureAdmissionLimit, "service %v is at its limit of %v circuits", svc.Id, svc.MaxCircuits)
+	}
+
+	if svc.MaxCircuitsPerClient > 0 && admission.clients[clientId] >= int64(svc.MaxCircuitsPerClient) {
+		self.cleanup(svc.Id, admission)
+		return newCircuitErrorf(CircuitFailureAdmissionLimit, "client %v is at its limit of %v circuits for service %v",
+			clientId, svc.MaxCircuitsPerClient, svc.Id)
+	}
+
+	if svc.CircuitRateLimit > 0 && !admission.takeToken(svc, now) {
+		self.cleanup(svc.Id, admission)
+		return newCircuitErrorf(CircuitFailureAdmissionLimit, "service %v exceeded its circuit rate limit of %v per second",
+			svc.Id, svc.CircuitRateLimit)
+	}
+
+	admission.circuits++
+	admission.clients[clientId]++
+	return nil
+}
+
+// track records a circuit which was created without going through admission, such as a recovered circuit
+func (self *circuitAdmission) track(serviceId, clientId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	admission := self.getServiceAdmission(serviceId)
+	admission.circuits++
+	admission.clients[clientId]++
+}
+
+func (self *circuitAdmission) release(serviceId, clientId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if admission, found := self.services[serviceId]; found {
+		if admission.circuits > 0 {
+			admission.circuits--
+		}
+		if count := admission.clients[clientId]; count > 1 {
+			admission.clients[clientId] = count - 1
+		} else {
+			delete(admission.clients, clientId)
+		}
+		self.cleanup(serviceId, admission)
+	}
+}
+
+// cleanup drops the service state once it has no circuits, unless it's holding token bucket state for a rate limit
+func (self *circuitAdmission) cleanup(serviceId string, admission *serviceAdmission) {
+	if admission.circuits == 0 && admission.tokens < 0 {
+		delete(self.services, serviceId)
+	}
+}
+
+func (self *circuitAdmission) getCircuitCount(serviceId string) int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if admission, found := self.services[serviceId]; found {
+		return admission.circuits
+	}
+	return 0
+}
+
+func (self *circuitAdmission) getClientCircuitCount(serviceId, clientId string) int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if admission, found := self.services[serviceId]; found {
+		return admission.clients[clientId]
+	}
+	return 0
+}
+
+// takeToken implements a token bucket which refills at CircuitRateLimit tokens per second and holds up to
+// CircuitRateBurst tokens. If no burst is configured, the bucket holds one second worth of tokens.
+func (self *serviceAdmission) takeToken(svc *Service, now time.Time) bool {
+	capacity := float64(svc.CircuitRateBurst)
+	if capacity == 0 {
+		capacity = float64(svc.CircuitRateLimit)
+	}
+
+	if self.tokens < 0 {
+		self.tokens = capacity
+	} else if elapsed := now.Sub(self.refilled); elapsed > 0 {
+		self.tokens = math.Min(capacity, self.tokens+elapsed.Seconds()*float64(svc.CircuitRateLimit))
+	}
+	self.refilled = now
+
+	if self.tokens < 1 {
+		return false
+	}
+	self.tokens--
+	return true
+}
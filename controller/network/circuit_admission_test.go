@@ -0,0 +1,96 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitAdmissionLimits(t *testing.T) {
+	req := require.New(t)
+	admission := newCircuitAdmission()
+	now := time.Now()
+
+	svc := &Service{
+		BaseEntity:           models.BaseEntity{Id: "svc"},
+		MaxCircuits:          3,
+		MaxCircuitsPerClient: 2,
+	}
+
+	req.NoError(admission.reserve(svc, "client1", now))
+	req.NoError(admission.reserve(svc, "client1", now))
+
+	err := admission.reserve(svc, "client1", now)
+	req.Error(err)
+	req.Equal(CircuitFailureAdmissionLimit, err.Cause())
+
+	req.NoError(admission.reserve(svc, "client2", now))
+	req.Equal(int64(3), admission.getCircuitCount(svc.Id))
+
+	err = admission.reserve(svc, "client3", now)
+	req.Error(err)
+	req.Equal(CircuitFailureAdmissionLimit, err.Cause())
+
+	admission.release(svc.Id, "client1")
+	req.Equal(int64(1), admission.getClientCircuitCount(svc.Id, "client1"))
+	req.NoError(admission.reserve(svc, "client3", now))
+
+	for _, clientId := range []string{"client1", "client2", "client3"} {
+		admission.release(svc.Id, clientId)
+	}
+	req.Equal(int64(0), admission.getCircuitCount(svc.Id))
+	req.Empty(admission.services)
+}
+
+func TestCircuitAdmissionRateLimit(t *testing.T) {
+	req := require.New(t)
+	admission := newCircuitAdmission()
+	now := time.Now()
+
+	svc := &Service{
+		BaseEntity:       models.BaseEntity{Id: "svc"},
+		CircuitRateLimit: 2,
+		CircuitRateBurst: 4,
+	}
+
+	for i := 0; i < 4; i++ {
+		req.NoError(admission.reserve(svc, "client", now))
+	}
+
+	err := admission.reserve(svc, "client", now)
+	req.Error(err)
+	req.Equal(CircuitFailureAdmissionLimit, err.Cause())
+
+	// a rejected circuit doesn't hold a slot
+	req.Equal(int64(4), admission.getCircuitCount(svc.Id))
+
+	// half a second refills one token
+	now = now.Add(500 * time.Millisecond)
+	req.NoError(admission.reserve(svc, "client", now))
+	req.Error(admission.reserve(svc, "client", now))
+
+	// the bucket never holds more than the burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 4; i++ {
+		req.NoError(admission.reserve(svc, "client", now))
+	}
+	req.Error(admission.reserve(svc, "client", now))
+}
@@ -27,20 +27,72 @@ func (network *Network) fillCircuitPath(e *event.CircuitEvent, path *Path) {
 	if path == nil {
 		return
 	}
+	fillEventCircuitPath(&e.Path, path)
+	e.LinkCount = len(path.Links)
+}
+
+func fillEventCircuitPath(eventPath *event.CircuitPath, path *Path) {
 	for _, r := range path.Nodes {
-		e.Path.Nodes = append(e.Path.Nodes, r.Id)
+		eventPath.Nodes = append(eventPath.Nodes, r.Id)
 	}
 	for _, l := range path.Links {
-		e.Path.Links = append(e.Path.Links, l.Id)
+		eventPath.Links = append(eventPath.Links, l.Id)
 	}
-	e.Path.IngressId = path.IngressId
-	e.Path.EgressId = path.EgressId
-	e.Path.TerminatorLocalAddr = path.TerminatorLocalAddr
-	e.Path.TerminatorRemoteAddr = path.TerminatorRemoteAddr
-	e.LinkCount = len(path.Links)
+	eventPath.IngressId = path.IngressId
+	eventPath.EgressId = path.EgressId
+	eventPath.TerminatorLocalAddr = path.TerminatorLocalAddr
+	eventPath.TerminatorRemoteAddr = path.TerminatorRemoteAddr
 }
 
+// RerouteReason identifies what caused a circuit to be moved to a new path
+type RerouteReason string
+
+const (
+	RerouteReasonSmart           RerouteReason = "smart"
+	RerouteReasonLinkFault       RerouteReason = "link-fault"
+	RerouteReasonForwardingFault RerouteReason = "forwarding-fault"
+	RerouteReasonManual          RerouteReason = "manual"
+)
+
 func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *Circuit, creationTimespan *time.Duration) {
+	network.eventDispatcher.AcceptCircuitEvent(network.newCircuitEvent(eventType, circuit, creationTimespan))
+}
+
+// CircuitCreatedEvent emits a created event for the circuit, including how long each router took to respond to the
+// route requests sent while creating it
+func (network *Network) CircuitCreatedEvent(circuit *Circuit, creationTimespan time.Duration, timings []*RouteTiming) {
+	circuitEvent := network.newCircuitEvent(event.CircuitCreated, circuit, &creationTimespan)
+	circuitEvent.RouteTimings = toEventRouteTimings(timings)
+	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+}
+
+func toEventRouteTimings(timings []*RouteTiming) []*event.CircuitRouteTiming {
+	var result []*event.CircuitRouteTiming
+	for _, timing := range timings {
+		result = append(result, &event.CircuitRouteTiming{
+			RouterId:      timing.RouterId,
+			Attempt:       timing.Attempt,
+			Success:       timing.Success,
+			RouteDuration: timing.RouteDuration,
+			DialDuration:  timing.DialDuration,
+		})
+	}
+	return result
+}
+
+// CircuitRerouteEvent emits a path updated event for the circuit, recording the path it was moved off of and why
+func (network *Network) CircuitRerouteEvent(circuit *Circuit, oldPath *Path, reason RerouteReason) {
+	circuitEvent := network.newCircuitEvent(event.CircuitUpdated, circuit, nil)
+	circuitEvent.Reroute = &event.CircuitReroute{
+		Reason:  string(reason),
+		OldCost: oldPath.cost(),
+		NewCost: circuit.Path.cost(),
+	}
+	fillEventCircuitPath(&circuitEvent.Reroute.OldPath, oldPath)
+	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+}
+
+func (network *Network) newCircuitEvent(eventType event.CircuitEventType, circuit *Circuit, creationTimespan *time.Duration) *event.CircuitEvent {
 	var cost *uint32
 	if eventType == event.CircuitCreated {
 		c := circuit.Terminator.GetRouteCost()
@@ -61,7 +113,11 @@ func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *
 		Cost:             cost,
 	}
 	network.fillCircuitPath(circuitEvent, circuit.Path)
-	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+	if circuit.StandbyPath != nil {
+		circuitEvent.StandbyPath = &event.CircuitPath{}
+		fillEventCircuitPath(circuitEvent.StandbyPath, circuit.StandbyPath)
+	}
+	return circuitEvent
 }
 
 type CircuitFailureCause string
@@ -80,6 +136,7 @@ const (
 	CircuitFailureRouterErrMisconfiguredTerminator CircuitFailureCause = "ROUTER_ERR_MISCONFIGURED_TERMINATOR"
 	CircuitFailureRouterErrDialTimedOut            CircuitFailureCause = "ROUTER_ERR_DIAL_TIMED_OUT"
 	CircuitFailureRouterErrDialConnRefused         CircuitFailureCause = "ROUTER_ERR_CONN_REFUSED"
+	CircuitFailureAdmissionLimit                   CircuitFailureCause = "ADMISSION_LIMIT"
 )
 
 type CircuitError interface {
@@ -114,7 +171,7 @@ func newCircuitErrWrap(cause CircuitFailureCause, err error) CircuitError {
 	}
 }
 
-func (network *Network) CircuitFailedEvent(circuitId string, clientId string, serviceId string, instanceId string, startTime time.Time, path *Path, t xt.CostedTerminator, cause CircuitFailureCause) {
+func (network *Network) CircuitFailedEvent(circuitId string, clientId string, serviceId string, instanceId string, startTime time.Time, path *Path, t xt.CostedTerminator, cause CircuitFailureCause, timings []*RouteTiming) {
 	var failureCause *string
 	if strCause := string(cause); strCause != "" {
 		failureCause = &strCause
@@ -145,6 +202,7 @@ func (network *Network) CircuitFailedEvent(circuitId string, clientId string, se
 		CreationTimespan: &elapsed,
 		Cost:             cost,
 		FailureCause:     failureCause,
+		RouteTimings:     toEventRouteTimings(timings),
 	}
 	network.fillCircuitPath(circuitEvent, path)
 	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
@@ -0,0 +1,260 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// circuitRecoveryTimeout is how long circuit reports are held while waiting for the rest of the routers on the
+// circuit path to report. Circuits which can't be rebuilt in that time are unrouted.
+const circuitRecoveryTimeout = time.Minute
+
+type pendingCircuit struct {
+	firstReported time.Time
+	reports       map[string]*ctrl_pb.RouterCircuits_RouterCircuit
+}
+
+// circuitRecovery tracks circuits reported by routers which the controller doesn't know about, for example
+// after a controller restart. Once every router on the path of a circuit has reported, the circuit is rebuilt.
+type circuitRecovery struct {
+	lock    sync.Mutex
+	pending map[string]*pendingCircuit
+}
+
+func newCircuitRecovery() *circuitRecovery {
+	return &circuitRecovery{
+		pending: map[string]*pendingCircuit{},
+	}
+}
+
+func (self *circuitRecovery) isPending(circuitId string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	_, found := self.pending[circuitId]
+	return found
+}
+
+// RouterReportedCircuits handles the forward tables reported by a router when it reconnects. Circuits which
+// the controller already knows about are ignored.
+func (network *Network) RouterReportedCircuits(r *Router, report *ctrl_pb.RouterCircuits) {
+	log := pfxlog.Logger().WithField("routerId", r.Id)
+
+	recovery := network.circuitRecovery
+	recovery.lock.Lock()
+	for _, reported := range report.Circuits {
+		if _, found := network.circuitController.get(reported.CircuitId); found {
+			continue
+		}
+		pending, found := recovery.pending[reported.CircuitId]
+		if !found {
+			pending = &pendingCircuit{
+				firstReported: time.Now(),
+				reports:       map[string]*ctrl_pb.RouterCircuits_RouterCircuit{},
+			}
+			recovery.pending[reported.CircuitId] = pending
+		}
+		pending.reports[r.Id] = reported
+		log.WithField("circuitId", reported.CircuitId).Debug("router reported unknown circuit")
+	}
+	recovery.lock.Unlock()
+
+	network.recoverCircuits()
+}
+
+// IsCircuitPendingRecovery returns true if the circuit has been reported by a router, but hasn't been rebuilt yet
+func (network *Network) IsCircuitPendingRecovery(circuitId string) bool {
+	return network.circuitRecovery.isPending(circuitId)
+}
+
+// recoverCircuits attempts to rebuild all pending circuits. Circuits which have been pending for longer than
+// the recovery timeout are unrouted from the routers which reported them.
+func (network *Network) recoverCircuits() {
+	var recovered []*Circuit
+	expired := map[string][]string{}
+
+	recovery := network.circuitRecovery
+	recovery.lock.Lock()
+	for circuitId, pending := range recovery.pending {
+		if _, found := network.circuitController.get(circuitId); found {
+			delete(recovery.pending, circuitId)
+			continue
+		}
+
+		circuit, err := network.rebuildCircuit(circuitId, pending.reports)
+		if err == nil {
+			delete(recovery.pending, circuitId)
+			network.circuitController.add(circuit)
+			network.circuitAdmission.track(circuit.Service.Id, circuit.ClientId)
+			recovered = append(recovered, circuit)
+		} else if time.Since(pending.firstReported) > circuitRecoveryTimeout {
+			pfxlog.Logger().WithField("circuitId", circuitId).WithError(err).Warn("unable to recover circuit, unrouting")
+			delete(recovery.pending, circuitId)
+			for routerId := range pending.reports {
+				expired[circuitId] = append(expired[circuitId], routerId)
+			}
+		}
+	}
+	recovery.lock.Unlock()
+
+	for _, circuit := range recovered {
+		log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+		if strategy, err := network.strategyRegistry.GetStrategy(circuit.Service.TerminatorStrategy); strategy != nil {
+			strategy.NotifyEvent(xt.NewDialSucceeded(circuit.Terminator))
+		} else if err != nil {
+			log.WithError(err).Warnf("failed to notify strategy %v of recovered circuit", circuit.Service.TerminatorStrategy)
+		}
+		network.updateStandbyPath(circuit)
+		network.CircuitEvent(event.CircuitPresent, circuit, nil)
+		log.WithField("path", circuit.Path).Info("recovered circuit")
+	}
+
+	for circuitId, routerIds := range expired {
+		for _, routerId := range routerIds {
+			if r := network.Routers.getConnected(routerId); r != nil {
+				if err := sendUnroute(r, circuitId, true); err != nil {
+					pfxlog.Logger().WithField("circuitId", circuitId).WithField("routerId", routerId).
+						WithError(err).Error("error sending unroute for unrecoverable circuit")
+				}
+			}
+		}
+	}
+}
+
+// rebuildCircuit reconstructs a circuit from the forward tables reported by the routers on its path. The path
+// is found by starting at the ingress address and following the forwards from router to router, across the
+// reported links, until the egress address is reached.
+func (network *Network) rebuildCircuit(circuitId string, reports map[string]*ctrl_pb.RouterCircuits_RouterCircuit) (*Circuit, error) {
+	var ingressRouterId, egressRouterId string
+	var info *ctrl_pb.RouterCircuits_RouterCircuit
+	for routerId, report := range reports {
+		if report.IngressId != "" {
+			ingressRouterId = routerId
+		}
+		if report.EgressId != "" {
+			egressRouterId = routerId
+		}
+		if info == nil && report.ServiceId != "" {
+			info = report
+		}
+	}
+
+	if ingressRouterId == "" {
+		return nil, errors.Errorf("ingress router for circuit %v has not reported", circuitId)
+	}
+	if egressRouterId == "" {
+		return nil, errors.Errorf("egress router for circuit %v has not reported", circuitId)
+	}
+	if info == nil {
+		return nil, errors.Errorf("no router reported service details for circuit %v", circuitId)
+	}
+
+	svc, err := network.Services.Read(info.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	terminator, err := network.Terminators.Read(info.TerminatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	path := &Path{
+		IngressId: reports[ingressRouterId].IngressId,
+		EgressId:  reports[egressRouterId].EgressId,
+	}
+
+	routerId := ingressRouterId
+	srcAddr := path.IngressId
+	for {
+		r := network.Routers.getConnected(routerId)
+		if r == nil {
+			return nil, errors.Errorf("router %v on path of circuit %v is not connected", routerId, circuitId)
+		}
+		path.Nodes = append(path.Nodes, r)
+
+		dstAddr, found := reportedForward(reports[routerId], srcAddr)
+		if !found {
+			return nil, errors.Errorf("router %v has no forward for %v on circuit %v", routerId, srcAddr, circuitId)
+		}
+
+		if routerId == egressRouterId && dstAddr == path.EgressId {
+			break
+		}
+
+		if len(path.Links) >= len(reports) {
+			return nil, errors.Errorf("forwards for circuit %v don't form a path", circuitId)
+		}
+
+		link, found := network.linkController.get(dstAddr)
+		if !found {
+			return nil, errors.Errorf("link %v on path of circuit %v is not known", dstAddr, circuitId)
+		}
+		path.Links = append(path.Links, link)
+
+		next := link.Dst
+		if next == r {
+			next = link.Src
+		} else if link.Src != r {
+			return nil, errors.Errorf("link %v does not connect to router %v on circuit %v", link.Id, routerId, circuitId)
+		}
+
+		if _, found = reports[next.Id]; !found {
+			return nil, errors.Errorf("router %v on path of circuit %v has not reported", next.Id, circuitId)
+		}
+
+		routerId = next.Id
+		srcAddr = link.Id
+	}
+
+	createdAt := time.Now()
+	if info.CreatedAt != 0 {
+		createdAt = time.UnixMilli(info.CreatedAt)
+	}
+
+	circuit := &Circuit{
+		Id:        circuitId,
+		ClientId:  info.ClientId,
+		Service:   svc,
+		Path:      path,
+		Tags:      info.Tags,
+		CreatedAt: createdAt,
+	}
+
+	unbiasedCost := uint32(terminator.Cost) + uint32(xt.GlobalCosts().GetDynamicCost(terminator.Id)) + uint32(circuit.cost())
+	circuit.Terminator = &RoutingTerminator{
+		Terminator: terminator,
+		RouteCost:  terminator.Precedence.GetBiasedCost(unbiasedCost),
+	}
+
+	return circuit, nil
+}
+
+func reportedForward(report *ctrl_pb.RouterCircuits_RouterCircuit, srcAddr string) (string, bool) {
+	for _, forward := range report.Forwards {
+		if forward.SrcAddress == srcAddr {
+			return forward.DstAddress, true
+		}
+	}
+	return "", false
+}
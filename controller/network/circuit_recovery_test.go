@@ -0,0 +1,101 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouterReportedCircuits(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+
+	l0 := newPathTestLink(network, "l0", r0, r1)
+	l1 := newPathTestLink(network, "l1", r2, r1)
+
+	svc := entityHelper.addTestService("svc")
+	terminator := entityHelper.addTestTerminator(svc.Id, r2.Id, "", false)
+
+	forward := func(src, dst string) *ctrl_pb.RouterCircuits_Forward {
+		return &ctrl_pb.RouterCircuits_Forward{SrcAddress: src, DstAddress: dst}
+	}
+
+	ingressReport := &ctrl_pb.RouterCircuits_RouterCircuit{
+		CircuitId:    "c0",
+		Forwards:     []*ctrl_pb.RouterCircuits_Forward{forward("ingress", l0.Id), forward(l0.Id, "ingress")},
+		IngressId:    "ingress",
+		ServiceId:    svc.Id,
+		TerminatorId: terminator.Id,
+		ClientId:     "client",
+		Tags:         map[string]string{"foo": "bar"},
+	}
+
+	transitReport := &ctrl_pb.RouterCircuits_RouterCircuit{
+		CircuitId: "c0",
+		Forwards:  []*ctrl_pb.RouterCircuits_Forward{forward(l0.Id, l1.Id), forward(l1.Id, l0.Id)},
+	}
+
+	egressReport := &ctrl_pb.RouterCircuits_RouterCircuit{
+		CircuitId: "c0",
+		Forwards:  []*ctrl_pb.RouterCircuits_Forward{forward("egress", l1.Id), forward(l1.Id, "egress")},
+		EgressId:  "egress",
+	}
+
+	network.RouterReportedCircuits(r0, &ctrl_pb.RouterCircuits{Circuits: []*ctrl_pb.RouterCircuits_RouterCircuit{ingressReport}})
+	network.RouterReportedCircuits(r2, &ctrl_pb.RouterCircuits{Circuits: []*ctrl_pb.RouterCircuits_RouterCircuit{egressReport}})
+
+	// the transit router hasn't reported yet, so the circuit can't be rebuilt
+	_, found := network.GetCircuit("c0")
+	req.False(found)
+	req.True(network.IsCircuitPendingRecovery("c0"))
+
+	network.RouterReportedCircuits(r1, &ctrl_pb.RouterCircuits{Circuits: []*ctrl_pb.RouterCircuits_RouterCircuit{transitReport}})
+
+	circuit, found := network.GetCircuit("c0")
+	req.True(found)
+	req.False(network.IsCircuitPendingRecovery("c0"))
+
+	req.Equal(svc.Id, circuit.Service.Id)
+	req.Equal(terminator.Id, circuit.Terminator.GetId())
+	req.Equal("client", circuit.ClientId)
+	req.Equal("bar", circuit.Tags["foo"])
+	req.Equal("ingress", circuit.Path.IngressId)
+	req.Equal("egress", circuit.Path.EgressId)
+	req.Equal([]*Router{r0, r1, r2}, circuit.Path.Nodes)
+	req.Equal([]*Link{l0, l1}, circuit.Path.Links)
+
+	// reports for circuits which are already known are ignored
+	network.RouterReportedCircuits(r1, &ctrl_pb.RouterCircuits{Circuits: []*ctrl_pb.RouterCircuits_RouterCircuit{transitReport}})
+	req.False(network.IsCircuitPendingRecovery("c0"))
+}
@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/pkg/errors"
+)
+
+type DisjointPathMode string
+
+const (
+	// DisjointPathModeNone disables disjoint path calculation
+	DisjointPathModeNone DisjointPathMode = "none"
+	// DisjointPathModeLink requires paths to not share any router to router hops
+	DisjointPathModeLink DisjointPathMode = "link"
+	// DisjointPathModeNode requires paths to not share any router to router hops or intermediate routers
+	DisjointPathModeNode DisjointPathMode = "node"
+)
+
+func ParseDisjointPathMode(val string) (DisjointPathMode, error) {
+	switch DisjointPathMode(val) {
+	case DisjointPathModeNone, DisjointPathModeLink, DisjointPathModeNode:
+		return DisjointPathMode(val), nil
+	}
+	return "", errors.Errorf("invalid disjoint path mode '%v', must be one of %v, %v or %v",
+		val, DisjointPathModeNone, DisjointPathModeLink, DisjointPathModeNode)
+}
+
+type routerPair struct {
+	a, b *Router
+}
+
+// disjointPathFinder finds successive least expensive paths, where each path found is disjoint from all
+// the paths which were added to the finder before it.
+type disjointPathFinder struct {
+	network     *Network
+	mode        DisjointPathMode
+	constraints *pathConstraints
+	usedRouters map[*Router]bool
+	usedHops    map[routerPair]bool
+}
+
+func (network *Network) newDisjointPathFinder(mode DisjointPathMode, constraints *pathConstraints) *disjointPathFinder {
+	return &disjointPathFinder{
+		network:     network,
+		mode:        mode,
+		constraints: constraints,
+		usedRouters: map[*Router]bool{},
+		usedHops:    map[routerPair]bool{},
+	}
+}
+
+func (self *disjointPathFinder) add(path []*Router) {
+	for i := 1; i < len(path)-1; i++ {
+		self.usedRouters[path[i]] = true
+	}
+	for i := 0; i < len(path)-1; i++ {
+		self.usedHops[routerPair{a: path[i], b: path[i+1]}] = true
+		self.usedHops[routerPair{a: path[i+1], b: path[i]}] = true
+	}
+}
+
+func (self *disjointPathFinder) next(srcR, dstR *Router) ([]*Router, int64, error) {
+	if srcR == dstR {
+		return nil, 0, errors.Errorf("no disjoint path available for single router path on %v", srcR.Id)
+	}
+
+	if self.constraints != nil && len(self.constraints.waypoints) > 0 {
+		return nil, 0, errors.New("disjoint paths are not supported for services with waypoint routers")
+	}
+
+	filter := func(r *Router) bool {
+		if self.mode == DisjointPathModeNode && self.usedRouters[r] {
+			return false
+		}
+		return self.constraints == nil || self.constraints.allows(r)
+	}
+
+	hops := func(from, to *Router) bool {
+		return !self.usedHops[routerPair{a: from, b: to}]
+	}
+
+	if self.constraints != nil && self.constraints.maxLinks > 0 {
+		return self.network.shortestPathWithMaxLinks(srcR, dstR, filter, hops, self.constraints.maxLinks)
+	}
+	return self.network.shortestPathFiltered(srcR, dstR, filter, hops)
+}
+
+// nextK returns up to k more paths from srcR to dstR, in order of increasing cost. An error is only returned if
+// no path could be found at all.
+func (self *disjointPathFinder) nextK(srcR, dstR *Router, k int) ([][]*Router, error) {
+	var result [][]*Router
+	for len(result) < k {
+		nodes, _, err := self.next(srcR, dstR)
+		if err != nil {
+			if len(result) == 0 {
+				return nil, err
+			}
+			break
+		}
+		self.add(nodes)
+		result = append(result, nodes)
+	}
+	return result, nil
+}
+
+// DisjointPaths returns up to k paths from srcR to dstR, in order of increasing cost. Each path is the least
+// expensive path which is disjoint, according to the given mode, from all the paths returned before it.
+func (network *Network) DisjointPaths(srcR, dstR *Router, k int, mode DisjointPathMode) ([]*Path, error) {
+	if mode == DisjointPathModeNone {
+		return nil, errors.New("disjoint path mode must be link or node")
+	}
+
+	found, err := network.newDisjointPathFinder(mode, nil).nextK(srcR, dstR, k)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Path
+	for _, nodes := range found {
+		path := &Path{Nodes: nodes}
+		if err = network.setLinks(path); err != nil {
+			return nil, err
+		}
+		result = append(result, path)
+	}
+	return result, nil
+}
+
+// standbyPathsForService returns up to the configured number of standby path candidates, in order of increasing
+// cost. Each candidate is disjoint from the given path and from the candidates before it, according to the
+// configured standby path mode, and satisfies the routing policy of the given service.
+func (network *Network) standbyPathsForService(svc *Service, primary *Path) ([]*Path, error) {
+	mode := network.options.StandbyPathMode
+	if mode == "" || mode == DisjointPathModeNone {
+		return nil, errors.New("standby paths are not enabled")
+	}
+
+	k := network.options.StandbyPathCandidates
+	if k < 1 {
+		k = 1
+	}
+
+	srcR := primary.Nodes[0]
+	dstR := primary.Nodes[len(primary.Nodes)-1]
+
+	finder := network.newDisjointPathFinder(mode, newPathConstraints(svc))
+	finder.add(primary.Nodes)
+	found, err := finder.nextK(srcR, dstR, k)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Path
+	for _, nodes := range found {
+		path := &Path{
+			Nodes:                nodes,
+			IngressId:            primary.IngressId,
+			EgressId:             primary.EgressId,
+			TerminatorLocalAddr:  primary.TerminatorLocalAddr,
+			TerminatorRemoteAddr: primary.TerminatorRemoteAddr,
+		}
+		if err = network.setLinks(path); err != nil {
+			return nil, err
+		}
+		result = append(result, path)
+	}
+	return result, nil
+}
+
+// isPathUsable returns true if all the links in the path are still known and usable
+func (network *Network) isPathUsable(path *Path) bool {
+	for _, l := range path.Links {
+		if !network.linkController.has(l) || !l.IsUsable() {
+			return false
+		}
+	}
+	return true
+}
@@ -36,8 +36,15 @@ type Link struct {
 	SrcLatency  int64
 	DstLatency  int64
 	Cost        int64
+	CostTags    []string
+	FlapPenalty int64
 	usable      concurrenz.AtomicBoolean
 	lock        sync.Mutex
+	suppressed  bool
+
+	costAdjustment *linkCostAdjustment
+	flapHistory    *linkFlapHistory
+	topology       *topologyVersion
 }
 
 func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration) *Link {
@@ -77,9 +84,61 @@ func (link *Link) addState(s *LinkState) {
 		link.state = make([]*LinkState, 0)
 	}
 	link.state = append([]*LinkState{s}, link.state...)
+	if link.flapHistory != nil {
+		link.flapHistory.stateChanged(s.Mode, time.Now())
+		link.applyFlapDamping(time.Now())
+	}
+	link.recalculateUsable()
+}
+
+// setFlapHistory attaches the flap history shared by links with the same endpoints and protocol and records the
+// current link state in it
+func (link *Link) setFlapHistory(history *linkFlapHistory) {
+	link.lock.Lock()
+	defer link.lock.Unlock()
+	link.flapHistory = history
+	if len(link.state) > 0 {
+		history.stateChanged(link.state[0].Mode, time.Now())
+	}
+	link.applyFlapDamping(time.Now())
 	link.recalculateUsable()
 }
 
+// updateFlapDamping applies the decayed flap penalty to the link, returning true if the suppressed state changed
+func (link *Link) updateFlapDamping(now time.Time) bool {
+	link.lock.Lock()
+	defer link.lock.Unlock()
+	if link.flapHistory == nil {
+		return false
+	}
+	wasSuppressed := link.suppressed
+	link.applyFlapDamping(now)
+	link.recalculateUsable()
+	return wasSuppressed != link.suppressed
+}
+
+func (link *Link) applyFlapDamping(now time.Time) {
+	if link.flapHistory == nil {
+		return
+	}
+	penalty, suppressed := link.flapHistory.current(now)
+	link.suppressed = suppressed
+	if atomic.SwapInt64(&link.FlapPenalty, penalty) != penalty {
+		link.recalculateCost()
+	}
+}
+
+func (link *Link) GetFlapPenalty() int64 {
+	return atomic.LoadInt64(&link.FlapPenalty)
+}
+
+// IsFlapSuppressed returns true if the link has flapped often enough recently that it shouldn't be used for paths
+func (link *Link) IsFlapSuppressed() bool {
+	link.lock.Lock()
+	defer link.lock.Unlock()
+	return link.suppressed
+}
+
 func (link *Link) SetDown(down bool) {
 	link.lock.Lock()
 	defer link.lock.Unlock()
@@ -94,12 +153,10 @@ func (link *Link) IsDown() bool {
 }
 
 func (link *Link) recalculateUsable() {
-	if link.down {
-		link.usable.Set(false)
-	} else if len(link.state) < 1 || link.state[0].Mode != Connected {
-		link.usable.Set(false)
-	} else {
-		link.usable.Set(true)
+	usable := !link.down && !link.suppressed && len(link.state) > 0 && link.state[0].Mode == Connected
+	if link.usable.Get() != usable {
+		link.usable.Set(usable)
+		link.topology.changed()
 	}
 }
 
@@ -134,9 +191,28 @@ func (link *Link) SetDstLatency(latency int64) {
 	link.recalculateCost()
 }
 
+// setCostTags records the cost tags advertised by the listener this link was dialed to and applies any
+// matching cost tag policies. Should be called before the link is made visible to other goroutines.
+func (link *Link) setCostTags(tags []string, policies map[string]*LinkCostTagPolicy) {
+	link.CostTags = tags
+	link.costAdjustment = getLinkCostAdjustment(tags, policies)
+	link.recalculateCost()
+}
+
+// IsExcludedByCostTags returns true if one of the link's cost tags maps to an excluding policy
+func (link *Link) IsExcludedByCostTags() bool {
+	return link.costAdjustment != nil && link.costAdjustment.excluded
+}
+
 func (link *Link) recalculateCost() {
 	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000
-	atomic.StoreInt64(&link.Cost, cost)
+	if link.costAdjustment != nil {
+		cost = link.costAdjustment.apply(cost)
+	}
+	cost += link.GetFlapPenalty()
+	if atomic.SwapInt64(&link.Cost, cost) != cost {
+		link.topology.changed()
+	}
 }
 
 func (link *Link) GetCost() int64 {
@@ -26,28 +26,57 @@ import (
 )
 
 type linkController struct {
-	linkTable      *linkTable
-	idGenerator    idgen.Generator
-	lock           sync.Mutex
-	initialLatency time.Duration
+	linkTable       *linkTable
+	idGenerator     idgen.Generator
+	lock            sync.Mutex
+	initialLatency  time.Duration
+	costTagPolicies map[string]*LinkCostTagPolicy
+	flapDamping     *LinkFlapDampingOptions
+	flapHistories   cmap.ConcurrentMap[*linkFlapHistory]
+	// disallowedRemovals holds the links which are being removed because link policies don't allow them
+	disallowedRemovals cmap.ConcurrentMap[*Link]
+	topology           *topologyVersion
 }
 
 func newLinkController(options *Options) *linkController {
 	initialLatency := DefaultNetworkOptionsInitialLinkLatency
+	var costTagPolicies map[string]*LinkCostTagPolicy
+	flapDamping := DefaultLinkFlapDampingOptions()
 	if options != nil {
 		initialLatency = options.InitialLinkLatency
+		costTagPolicies = options.LinkCostTags
+		if options.LinkFlapDamping != nil {
+			flapDamping = options.LinkFlapDamping
+		}
 	}
 	return &linkController{
-		linkTable:      newLinkTable(),
-		idGenerator:    idgen.NewGenerator(),
-		initialLatency: initialLatency,
+		linkTable:          newLinkTable(),
+		idGenerator:        idgen.NewGenerator(),
+		initialLatency:     initialLatency,
+		costTagPolicies:    costTagPolicies,
+		flapDamping:        flapDamping,
+		flapHistories:      cmap.New[*linkFlapHistory](),
+		disallowedRemovals: cmap.New[*Link](),
+		topology:           &topologyVersion{},
 	}
 }
 
 func (linkController *linkController) add(link *Link) {
+	link.topology = linkController.topology
+	if linkController.flapDamping.Enabled {
+		history := linkController.flapHistories.Upsert(linkFlapHistoryKey(link), nil,
+			func(exist bool, valueInMap *linkFlapHistory, _ *linkFlapHistory) *linkFlapHistory {
+				if exist {
+					return valueInMap
+				}
+				return newLinkFlapHistory(linkController.flapDamping, time.Now())
+			})
+		link.setFlapHistory(history)
+	}
 	linkController.linkTable.add(link)
 	link.Src.routerLinks.Add(link, link.Dst)
 	link.Dst.routerLinks.Add(link, link.Src)
+	linkController.topology.changed()
 }
 
 func (linkController *linkController) has(link *Link) bool {
@@ -65,6 +94,9 @@ func (linkController *linkController) routerReportedLink(linkId, linkProtocol, d
 	link := newLink(linkId, linkProtocol, dialAddress, linkController.initialLatency)
 	link.Src = src
 	link.Dst = dst
+	if listener := dst.getLinkListener(linkProtocol, dialAddress); listener != nil {
+		link.setCostTags(listener.CostTags(), linkController.costTagPolicies)
+	}
 	link.addState(newLinkState(Connected))
 	linkController.add(link)
 	return link, true
@@ -82,6 +114,7 @@ func (linkController *linkController) remove(link *Link) {
 	linkController.linkTable.remove(link)
 	link.Src.routerLinks.Remove(link, link.Dst)
 	link.Dst.routerLinks.Remove(link, link.Src)
+	linkController.topology.changed()
 }
 
 func (linkController *linkController) connectedNeighborsOfRouter(router *Router) []*Router {
@@ -113,7 +146,7 @@ func (linkController *linkController) leastExpensiveLink(a, b *Router) (*Link, b
 	linksByRouter := a.routerLinks.GetLinksByRouter()
 	links := linksByRouter[b.Id]
 	for _, link := range links {
-		if link.IsUsable() {
+		if link.IsUsable() && !link.IsExcludedByCostTags() {
 			linkCost := link.GetCost()
 			if link.Dst == b {
 				if linkCost < cost {
@@ -136,7 +169,9 @@ func (linkController *linkController) leastExpensiveLink(a, b *Router) (*Link, b
 	return nil, false
 }
 
-func (linkController *linkController) missingLinks(routers []*Router, pendingTimeout time.Duration) ([]*Link, error) {
+// missingLinks returns the links which should be dialed between the given routers. Only router pairs allowed by the
+// link policies are considered.
+func (linkController *linkController) missingLinks(routers []*Router, pendingTimeout time.Duration, policies *linkPolicySet) ([]*Link, error) {
 	// When there's a flood of router connects at startup we can see the same link
 	// as missing multiple times as the new link will be marked as PENDING until it's
 	// connected. Give ourselves a little window to make the connection before we
@@ -146,7 +181,7 @@ func (linkController *linkController) missingLinks(routers []*Router, pendingTim
 	missingLinks := make([]*Link, 0)
 	for _, srcR := range routers {
 		for _, dstR := range routers {
-			if srcR != dstR && len(dstR.Listeners) > 0 {
+			if srcR != dstR && len(dstR.Listeners) > 0 && policies.allows(srcR, dstR) {
 				for _, listener := range dstR.Listeners {
 					if !linkController.hasLink(srcR, dstR, listener.Protocol(), pendingLimit) {
 						id, err := idgen.NewUUIDString()
@@ -156,6 +191,7 @@ func (linkController *linkController) missingLinks(routers []*Router, pendingTim
 						link := newLink(id, listener.Protocol(), listener.AdvertiseAddress(), linkController.initialLatency)
 						link.Src = srcR
 						link.Dst = dstR
+						link.setCostTags(listener.CostTags(), linkController.costTagPolicies)
 						missingLinks = append(missingLinks, link)
 					}
 				}
@@ -166,6 +202,16 @@ func (linkController *linkController) missingLinks(routers []*Router, pendingTim
 	return missingLinks, nil
 }
 
+// disallowedLinks returns the links which the policies don't permit in either direction
+func (linkController *linkController) disallowedLinks(policies *linkPolicySet) []*Link {
+	if policies == nil || len(policies.policies) == 0 {
+		return nil
+	}
+	return linkController.linkTable.matching(func(link *Link) bool {
+		return !policies.allows(link.Src, link.Dst) && !policies.allows(link.Dst, link.Src)
+	})
+}
+
 func (linkController *linkController) clearExpiredPending(pendingTimeout time.Duration) {
 	pendingLimit := info.NowInMilliseconds() - pendingTimeout.Milliseconds()
 
@@ -196,6 +242,29 @@ func (linkController *linkController) hasDirectedLink(a, b *Router, linkProtocol
 	return false
 }
 
+// updateFlapDamping applies decayed flap penalties to all links, returning the links whose suppressed state changed.
+// Histories which have fully decayed and aren't in use by any link are discarded.
+func (linkController *linkController) updateFlapDamping(now time.Time) []*Link {
+	var changed []*Link
+	inUse := map[string]struct{}{}
+	for _, link := range linkController.all() {
+		if link.updateFlapDamping(now) {
+			changed = append(changed, link)
+		}
+		inUse[linkFlapHistoryKey(link)] = struct{}{}
+	}
+
+	for key, history := range linkController.flapHistories.Items() {
+		if _, found := inUse[key]; !found && history.isExpired(now) {
+			linkController.flapHistories.RemoveCb(key, func(key string, v *linkFlapHistory, exists bool) bool {
+				return exists && v == history
+			})
+		}
+	}
+
+	return changed
+}
+
 func (linkController *linkController) linksInMode(mode LinkMode) []*Link {
 	return linkController.linkTable.allInMode(mode)
 }
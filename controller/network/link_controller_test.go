@@ -18,6 +18,7 @@ package network
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -75,3 +76,141 @@ func TestNeighbors(t *testing.T) {
 func newTestLink(id string, linkProtocol string) *Link {
 	return newLink(id, linkProtocol, "tcp:localhost:1234", 0)
 }
+
+func TestLinkCostTags(t *testing.T) {
+	options := DefaultOptions()
+	options.LinkCostTags = map[string]*LinkCostTagPolicy{
+		"metered":   {Multiplier: 2, Additive: 100},
+		"satellite": {Exclude: true},
+	}
+	linkController := newLinkController(options)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+
+	newTaggedLink := func(id string, tags ...string) *Link {
+		link := newTestLink(id, "tls")
+		link.Src = r0
+		link.Dst = r1
+		link.SetStaticCost(10)
+		link.setCostTags(tags, options.LinkCostTags)
+		link.addState(newLinkState(Connected))
+		linkController.add(link)
+		return link
+	}
+
+	metered := newTaggedLink("metered", "metered")
+	assert.Equal(t, int64(120), metered.GetCost())
+	assert.False(t, metered.IsExcludedByCostTags())
+
+	satellite := newTaggedLink("satellite", "satellite", "unknown")
+	assert.Equal(t, int64(10), satellite.GetCost())
+	assert.True(t, satellite.IsExcludedByCostTags())
+
+	// the satellite link is cheaper, but excluded, so the metered link should be selected
+	link, found := linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, metered, link)
+
+	plain := newTaggedLink("plain")
+	assert.Equal(t, int64(10), plain.GetCost())
+
+	link, found = linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, plain, link)
+}
+
+func TestLinkFlapDamping(t *testing.T) {
+	options := DefaultOptions()
+	options.LinkFlapDamping.Enabled = true
+	options.LinkFlapDamping.HalfLife = time.Minute
+	linkController := newLinkController(options)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+
+	newFlapLink := func(id string) *Link {
+		link := newTestLink(id, "tls")
+		link.Src = r0
+		link.Dst = r1
+		link.SetStaticCost(10)
+		link.addState(newLinkState(Connected))
+		linkController.add(link)
+		return link
+	}
+
+	// the first connection isn't a flap
+	link := newFlapLink("l0")
+	assert.Equal(t, int64(0), link.GetFlapPenalty())
+	assert.Equal(t, int64(10), link.GetCost())
+	assert.True(t, link.IsUsable())
+
+	// failed links are replaced by links with new ids, penalties should carry over
+	link.addState(newLinkState(Failed))
+	linkController.remove(link)
+	link = newFlapLink("l1")
+	assert.InDelta(t, DefaultLinkFlapDampingPenalty, link.GetFlapPenalty(), 1)
+	assert.InDelta(t, 10+DefaultLinkFlapDampingPenalty, link.GetCost(), 1)
+	assert.False(t, link.IsFlapSuppressed())
+	assert.True(t, link.IsUsable())
+
+	// a link which goes from failed back to connected also counts as a flap
+	link.addState(newLinkState(Failed))
+	link.addState(newLinkState(Connected))
+	assert.InDelta(t, 2*DefaultLinkFlapDampingPenalty, link.GetFlapPenalty(), 1)
+	assert.False(t, link.IsFlapSuppressed())
+
+	link.addState(newLinkState(Failed))
+	link.addState(newLinkState(Connected))
+	assert.True(t, link.IsFlapSuppressed())
+	assert.False(t, link.IsUsable())
+
+	_, found := linkController.leastExpensiveLink(r0, r1)
+	assert.False(t, found)
+
+	// after one half-life the penalty is still above the reuse threshold
+	changed := linkController.updateFlapDamping(time.Now().Add(time.Minute))
+	assert.Equal(t, 0, len(changed))
+	assert.True(t, link.IsFlapSuppressed())
+
+	// after three half-lives the penalty has decayed below the reuse threshold
+	changed = linkController.updateFlapDamping(time.Now().Add(3 * time.Minute))
+	assert.Equal(t, []*Link{link}, changed)
+	assert.False(t, link.IsFlapSuppressed())
+	assert.True(t, link.IsUsable())
+	assert.Less(t, link.GetFlapPenalty(), int64(DefaultLinkFlapDampingReuseThreshold))
+
+	selected, found := linkController.leastExpensiveLink(r0, r1)
+	assert.True(t, found)
+	assert.Equal(t, link, selected)
+}
+
+func TestLoadLinkFlapDampingOptions(t *testing.T) {
+	options, err := LoadOptions(map[interface{}]interface{}{})
+	assert.NoError(t, err)
+	assert.False(t, options.LinkFlapDamping.Enabled)
+
+	options, err = LoadOptions(map[interface{}]interface{}{
+		"linkFlapDamping": map[interface{}]interface{}{
+			"enabled":           true,
+			"penalty":           500,
+			"suppressThreshold": 1500,
+			"reuseThreshold":    300,
+			"halfLife":          "5m",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, options.LinkFlapDamping.Enabled)
+	assert.Equal(t, int64(500), options.LinkFlapDamping.Penalty)
+	assert.Equal(t, int64(1500), options.LinkFlapDamping.SuppressThreshold)
+	assert.Equal(t, int64(300), options.LinkFlapDamping.ReuseThreshold)
+	assert.Equal(t, 5*time.Minute, options.LinkFlapDamping.HalfLife)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"linkFlapDamping": map[interface{}]interface{}{
+			"suppressThreshold": 500,
+			"reuseThreshold":    600,
+		},
+	})
+	assert.Error(t, err)
+}
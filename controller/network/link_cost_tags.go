@@ -0,0 +1,109 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+// LinkCostTagPolicy defines how links dialed to a listener advertising the given cost tag are treated
+// during path selection. The multiplier is applied to the link's base cost (static cost plus latencies),
+// after which the additive cost is added. Excluded links are never used for paths.
+type LinkCostTagPolicy struct {
+	Exclude    bool
+	Multiplier float64
+	Additive   int64
+}
+
+// linkCostAdjustment is the combined effect of all the cost tag policies matching a link's cost tags
+type linkCostAdjustment struct {
+	multiplier float64
+	additive   int64
+	excluded   bool
+}
+
+func (self *linkCostAdjustment) apply(cost int64) int64 {
+	return int64(float64(cost)*self.multiplier) + self.additive
+}
+
+// getLinkCostAdjustment combines the policies for the given tags. Multipliers compound, additive costs
+// are summed and any excluding policy excludes the link. Returns nil if no policy applies.
+func getLinkCostAdjustment(tags []string, policies map[string]*LinkCostTagPolicy) *linkCostAdjustment {
+	var result *linkCostAdjustment
+	for _, tag := range tags {
+		policy, found := policies[tag]
+		if !found {
+			continue
+		}
+		if result == nil {
+			result = &linkCostAdjustment{multiplier: 1}
+		}
+		if policy.Multiplier > 0 {
+			result.multiplier *= policy.Multiplier
+		}
+		result.additive += policy.Additive
+		result.excluded = result.excluded || policy.Exclude
+	}
+	return result
+}
+
+func loadLinkCostTagPolicies(src map[interface{}]interface{}) (map[string]*LinkCostTagPolicy, error) {
+	result := map[string]*LinkCostTagPolicy{}
+	for k, v := range src {
+		tag := fmt.Sprintf("%v", k)
+		submap, ok := v.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid value for 'linkCostTags.%v', must be a map", tag)
+		}
+
+		policy := &LinkCostTagPolicy{Multiplier: 1}
+
+		if value, found := submap["exclude"]; found {
+			if exclude, ok := value.(bool); ok {
+				policy.Exclude = exclude
+			} else {
+				return nil, errors.Errorf("invalid value for 'linkCostTags.%v.exclude'", tag)
+			}
+		}
+
+		if value, found := submap["multiplier"]; found {
+			switch multiplier := value.(type) {
+			case int:
+				policy.Multiplier = float64(multiplier)
+			case float64:
+				policy.Multiplier = multiplier
+			default:
+				return nil, errors.Errorf("invalid value for 'linkCostTags.%v.multiplier'", tag)
+			}
+			if policy.Multiplier <= 0 {
+				return nil, errors.Errorf("invalid value for 'linkCostTags.%v.multiplier', must be greater than zero", tag)
+			}
+		}
+
+		if value, found := submap["additive"]; found {
+			if additive, ok := value.(int); ok {
+				policy.Additive = int64(additive)
+			} else {
+				return nil, errors.Errorf("invalid value for 'linkCostTags.%v.additive'", tag)
+			}
+		}
+
+		result[tag] = policy
+	}
+	return result, nil
+}
@@ -0,0 +1,179 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultLinkFlapDampingPenalty           = 1000
+	DefaultLinkFlapDampingSuppressThreshold = 2000
+	DefaultLinkFlapDampingReuseThreshold    = 750
+	DefaultLinkFlapDampingMaxPenalty        = 8000
+	DefaultLinkFlapDampingHalfLife          = 15 * time.Minute
+)
+
+// LinkFlapDampingOptions configures BGP style flap damping for links. Each time a link between two routers goes from
+// failed to connected it accrues Penalty, which decays exponentially with the given HalfLife. The current penalty is
+// added to the link cost. Once the penalty exceeds SuppressThreshold the link is no longer used for paths, until the
+// penalty decays below ReuseThreshold. Damping is disabled unless explicitly enabled, since router restarts count as
+// flaps on all of the router's links.
+type LinkFlapDampingOptions struct {
+	Enabled           bool
+	Penalty           int64
+	SuppressThreshold int64
+	ReuseThreshold    int64
+	MaxPenalty        int64
+	HalfLife          time.Duration
+}
+
+func DefaultLinkFlapDampingOptions() *LinkFlapDampingOptions {
+	return &LinkFlapDampingOptions{
+		Penalty:           DefaultLinkFlapDampingPenalty,
+		SuppressThreshold: DefaultLinkFlapDampingSuppressThreshold,
+		ReuseThreshold:    DefaultLinkFlapDampingReuseThreshold,
+		MaxPenalty:        DefaultLinkFlapDampingMaxPenalty,
+		HalfLife:          DefaultLinkFlapDampingHalfLife,
+	}
+}
+
+func loadLinkFlapDampingOptions(src map[interface{}]interface{}) (*LinkFlapDampingOptions, error) {
+	options := DefaultLinkFlapDampingOptions()
+
+	if value, found := src["enabled"]; found {
+		if enabled, ok := value.(bool); ok {
+			options.Enabled = enabled
+		} else {
+			return nil, errors.New("invalid value for 'linkFlapDamping.enabled'")
+		}
+	}
+
+	for key, target := range map[string]*int64{
+		"penalty":           &options.Penalty,
+		"suppressThreshold": &options.SuppressThreshold,
+		"reuseThreshold":    &options.ReuseThreshold,
+		"maxPenalty":        &options.MaxPenalty,
+	} {
+		if value, found := src[key]; found {
+			if val, ok := value.(int); ok && val >= 0 {
+				*target = int64(val)
+			} else {
+				return nil, errors.Errorf("invalid value for 'linkFlapDamping.%v', must be a non-negative integer", key)
+			}
+		}
+	}
+
+	if value, found := src["halfLife"]; found {
+		if sval, ok := value.(string); ok {
+			val, err := time.ParseDuration(sval)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for 'linkFlapDamping.halfLife'")
+			}
+			options.HalfLife = val
+		} else {
+			return nil, errors.New("invalid value for 'linkFlapDamping.halfLife'")
+		}
+	}
+
+	if options.HalfLife <= 0 {
+		return nil, errors.New("invalid value for 'linkFlapDamping.halfLife', must be greater than zero")
+	}
+
+	if options.ReuseThreshold >= options.SuppressThreshold {
+		return nil, errors.New("invalid value for 'linkFlapDamping.reuseThreshold', must be less than suppressThreshold")
+	}
+
+	if options.MaxPenalty < options.SuppressThreshold {
+		return nil, errors.New("invalid value for 'linkFlapDamping.maxPenalty', must not be less than suppressThreshold")
+	}
+
+	return options, nil
+}
+
+// linkFlapHistory tracks flap penalties for all links with the same source, destination and protocol. Links which
+// fail are replaced by new links with new ids, so the history can't be kept on the link itself.
+type linkFlapHistory struct {
+	lock       sync.Mutex
+	options    *LinkFlapDampingOptions
+	penalty    float64
+	updated    time.Time
+	changed    time.Time
+	suppressed bool
+	failed     bool
+}
+
+func newLinkFlapHistory(options *LinkFlapDampingOptions, now time.Time) *linkFlapHistory {
+	return &linkFlapHistory{
+		options: options,
+		updated: now,
+		changed: now,
+	}
+}
+
+func linkFlapHistoryKey(link *Link) string {
+	return fmt.Sprintf("%v|%v|%v", link.Src.Id, link.Dst.Id, link.Protocol)
+}
+
+func (self *linkFlapHistory) decay(now time.Time) {
+	if elapsed := now.Sub(self.updated); elapsed > 0 {
+		self.penalty *= math.Exp2(-float64(elapsed) / float64(self.options.HalfLife))
+		self.updated = now
+	}
+	if self.suppressed && self.penalty < float64(self.options.ReuseThreshold) {
+		self.suppressed = false
+	}
+}
+
+// stateChanged records a link state transition. A transition to connected after a failure counts as a flap
+func (self *linkFlapHistory) stateChanged(mode LinkMode, now time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.decay(now)
+	self.changed = now
+
+	if mode == Failed {
+		self.failed = true
+	} else if mode == Connected && self.failed {
+		self.failed = false
+		self.penalty = math.Min(self.penalty+float64(self.options.Penalty), float64(self.options.MaxPenalty))
+		if self.penalty > float64(self.options.SuppressThreshold) {
+			self.suppressed = true
+		}
+	}
+}
+
+// current returns the decayed penalty and whether links sharing this history are currently suppressed
+func (self *linkFlapHistory) current(now time.Time) (int64, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.decay(now)
+	return int64(self.penalty), self.suppressed
+}
+
+// isExpired returns true if the history has decayed away and hasn't seen a state change for at least a half-life
+func (self *linkFlapHistory) isExpired(now time.Time) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.decay(now)
+	return self.penalty < 1 && !self.suppressed && now.Sub(self.changed) > self.options.HalfLife
+}
@@ -0,0 +1,356 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/command"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// LinkPolicy controls which routers the controller asks to dial links to each other. An allow policy lets routers
+// matching SourceRouters dial routers matching DestinationRouters. A deny policy prevents links between matching
+// routers in either direction, and takes precedence over allow policies. If MatchTags is set, the policy only applies
+// to router pairs which have the same values for all the given tags. If no link policies exist, all routers are
+// linked to each other. Existing links which the policies no longer allow are removed.
+type LinkPolicy struct {
+	models.BaseEntity
+	Name               string
+	SourceRouters      []string
+	DestinationRouters []string
+	MatchTags          []string
+	Action             string
+}
+
+func (self *LinkPolicy) GetName() string {
+	return self.Name
+}
+
+func (entity *LinkPolicy) toBolt() boltz.Entity {
+	return &db.LinkPolicy{
+		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:               entity.Name,
+		SourceRouters:      entity.SourceRouters,
+		DestinationRouters: entity.DestinationRouters,
+		MatchTags:          entity.MatchTags,
+		Action:             entity.Action,
+	}
+}
+
+// matches returns true if the policy applies to links dialed from src to dst
+func (entity *LinkPolicy) matches(src, dst *Router) bool {
+	if !routerMatchesPolicySelectors(src, entity.SourceRouters) || !routerMatchesPolicySelectors(dst, entity.DestinationRouters) {
+		return false
+	}
+
+	for _, tag := range entity.MatchTags {
+		srcValue, found := src.Tags[tag]
+		if !found {
+			return false
+		}
+		dstValue, found := dst.Tags[tag]
+		if !found || fmt.Sprintf("%v", srcValue) != fmt.Sprintf("%v", dstValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// routerMatchesPolicySelectors returns true if the router matches any of the selectors. An empty selector list
+// matches all routers
+func routerMatchesPolicySelectors(router *Router, selectors []string) bool {
+	return len(selectors) == 0 || RouterMatchesAnySelector(router, selectors)
+}
+
+// linkPolicySet evaluates a set of link policies
+type linkPolicySet struct {
+	policies []*LinkPolicy
+}
+
+// allows returns true if src should dial a link to dst
+func (self *linkPolicySet) allows(src, dst *Router) bool {
+	if self == nil || len(self.policies) == 0 {
+		return true
+	}
+	return len(self.matching(src, dst)) > 0
+}
+
+// matching returns the allow policies which permit src to dial dst. If any deny policy matches the routers, in
+// either direction, no policies are returned
+func (self *linkPolicySet) matching(src, dst *Router) []*LinkPolicy {
+	var result []*LinkPolicy
+	for _, policy := range self.policies {
+		if policy.Action == db.LinkPolicyActionDeny {
+			if policy.matches(src, dst) || policy.matches(dst, src) {
+				return nil
+			}
+		} else if policy.matches(src, dst) {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+func newLinkPolicyManager(managers *Managers) *LinkPolicyManager {
+	result := &LinkPolicyManager{
+		baseEntityManager: newBaseEntityManager(managers, managers.stores.LinkPolicy, func() *LinkPolicy {
+			return &LinkPolicy{}
+		}),
+		store: managers.stores.LinkPolicy,
+	}
+	result.populateEntity = result.populateLinkPolicy
+
+	invalidateF := func(i ...interface{}) {
+		result.invalidatePolicySet()
+	}
+	managers.stores.LinkPolicy.AddListener(boltz.EventCreate, invalidateF)
+	managers.stores.LinkPolicy.AddListener(boltz.EventUpdate, invalidateF)
+	managers.stores.LinkPolicy.AddListener(boltz.EventDelete, invalidateF)
+
+	return result
+}
+
+type LinkPolicyManager struct {
+	baseEntityManager[*LinkPolicy]
+	store db.LinkPolicyStore
+
+	policySetLock    sync.Mutex
+	policySet        *linkPolicySet
+	policySetVersion uint64
+}
+
+func (self *LinkPolicyManager) Create(entity *LinkPolicy) error {
+	return DispatchCreate[*LinkPolicy](self, entity)
+}
+
+func (self *LinkPolicyManager) ApplyCreate(cmd *command.CreateEntityCommand[*LinkPolicy]) error {
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		ctx := boltz.NewMutateContext(tx)
+		if err := self.ValidateNameOnCreate(ctx, cmd.Entity); err != nil {
+			return err
+		}
+		return self.store.Create(ctx, cmd.Entity.toBolt())
+	})
+}
+
+func (self *LinkPolicyManager) Update(entity *LinkPolicy, updatedFields fields.UpdatedFields) error {
+	return DispatchUpdate[*LinkPolicy](self, entity, updatedFields)
+}
+
+func (self *LinkPolicyManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*LinkPolicy]) error {
+	return self.updateGeneral(cmd.Entity, cmd.UpdatedFields)
+}
+
+func (self *LinkPolicyManager) Read(id string) (*LinkPolicy, error) {
+	return self.BaseLoad(id)
+}
+
+func (self *LinkPolicyManager) populateLinkPolicy(entity *LinkPolicy, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltLinkPolicy, ok := boltEntity.(*db.LinkPolicy)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model link policy", reflect.TypeOf(boltEntity))
+	}
+	entity.Name = boltLinkPolicy.Name
+	entity.SourceRouters = boltLinkPolicy.SourceRouters
+	entity.DestinationRouters = boltLinkPolicy.DestinationRouters
+	entity.MatchTags = boltLinkPolicy.MatchTags
+	entity.Action = boltLinkPolicy.Action
+	entity.FillCommon(boltLinkPolicy)
+	return nil
+}
+
+// getPolicySet returns the stored link policies. The policies are cached until a link policy is created, updated
+// or deleted. The returned set is shared and must not be modified.
+func (self *LinkPolicyManager) getPolicySet() (*linkPolicySet, error) {
+	self.policySetLock.Lock()
+	policySet, version := self.policySet, self.policySetVersion
+	self.policySetLock.Unlock()
+
+	if policySet != nil {
+		return policySet, nil
+	}
+
+	result, err := self.BaseList("true limit none")
+	if err != nil {
+		return nil, err
+	}
+	policySet = &linkPolicySet{policies: result.Entities}
+
+	// don't cache the set if the policies changed while they were being loaded
+	self.policySetLock.Lock()
+	if version == self.policySetVersion {
+		self.policySet = policySet
+	}
+	self.policySetLock.Unlock()
+
+	return policySet, nil
+}
+
+func (self *LinkPolicyManager) invalidatePolicySet() {
+	self.policySetLock.Lock()
+	defer self.policySetLock.Unlock()
+	self.policySet = nil
+	self.policySetVersion++
+}
+
+func (self *LinkPolicyManager) Marshall(entity *LinkPolicy) ([]byte, error) {
+	tags, err := cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &cmd_pb.LinkPolicy{
+		Id:                 entity.Id,
+		Name:               entity.Name,
+		SourceRouters:      entity.SourceRouters,
+		DestinationRouters: entity.DestinationRouters,
+		MatchTags:          entity.MatchTags,
+		Action:             entity.Action,
+		Tags:               tags,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *LinkPolicyManager) Unmarshall(bytes []byte) (*LinkPolicy, error) {
+	msg := &cmd_pb.LinkPolicy{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &LinkPolicy{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: cmd_pb.DecodeTags(msg.Tags),
+		},
+		Name:               msg.Name,
+		SourceRouters:      msg.SourceRouters,
+		DestinationRouters: msg.DestinationRouters,
+		MatchTags:          msg.MatchTags,
+		Action:             msg.Action,
+	}, nil
+}
+
+// removeDisallowedLinks removes links which the current link policies no longer permit in either direction, so that
+// new deny policies, or allow policies which no longer match, also apply to existing links. Links which are still
+// being removed from an earlier pass are skipped.
+func (network *Network) removeDisallowedLinks(policies *linkPolicySet) {
+	removals := network.linkController.disallowedRemovals
+	for _, link := range network.linkController.disallowedLinks(policies) {
+		if !removals.SetIfAbsent(link.Id, link) {
+			continue
+		}
+
+		pfxlog.Logger().WithField("linkId", link.Id).
+			WithField("srcRouterId", link.Src.Id).
+			WithField("dstRouterId", link.Dst.Id).
+			Info("link not allowed by link policies, removing")
+
+		// removal notifies the network loop, which assembly runs on, so it's done asynchronously
+		go func(link *Link) {
+			defer removals.Remove(link.Id)
+			network.RemoveLink(link.Id)
+		}(link)
+	}
+}
+
+// LinkPolicyPreview is a link which a set of link policies would have the controller dial
+type LinkPolicyPreview struct {
+	Src      *Router
+	Dst      *Router
+	Policies []*LinkPolicy
+	Linked   bool
+}
+
+// PreviewLinkPolicies returns the links which the given link policies would produce between all known routers. If
+// policies is nil, the stored link policies are used. Router listeners are only known for connected routers, so
+// they aren't taken into account. Linked is set if the routers already have a usable link between them.
+func (network *Network) PreviewLinkPolicies(policies []*LinkPolicy) ([]*LinkPolicyPreview, error) {
+	policySet := &linkPolicySet{policies: policies}
+	if policies == nil {
+		var err error
+		if policySet, err = network.LinkPolicies.getPolicySet(); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, policy := range policies {
+			if policy.Action == "" {
+				policy.Action = db.LinkPolicyActionAllow
+			}
+			if policy.Action != db.LinkPolicyActionAllow && policy.Action != db.LinkPolicyActionDeny {
+				return nil, errorz.NewFieldError("action must be one of allow or deny", db.FieldLinkPolicyAction, policy.Action)
+			}
+		}
+	}
+
+	routerList, err := network.Routers.BaseList("true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	routers := make([]*Router, 0, len(routerList.Entities))
+	for _, r := range routerList.Entities {
+		if connected := network.Routers.getConnected(r.Id); connected != nil {
+			r = connected
+		}
+		routers = append(routers, r)
+	}
+	sort.Slice(routers, func(i, j int) bool {
+		return routers[i].Id < routers[j].Id
+	})
+
+	var result []*LinkPolicyPreview
+	for _, src := range routers {
+		for _, dst := range routers {
+			if src == dst {
+				continue
+			}
+
+			preview := &LinkPolicyPreview{
+				Src: src,
+				Dst: dst,
+			}
+
+			if len(policySet.policies) > 0 {
+				if preview.Policies = policySet.matching(src, dst); len(preview.Policies) == 0 {
+					continue
+				}
+			}
+
+			if network.Routers.IsConnected(src.Id) && network.Routers.IsConnected(dst.Id) {
+				_, preview.Linked = network.linkController.leastExpensiveLink(src, dst)
+			}
+			result = append(result, preview)
+		}
+	}
+
+	return result, nil
+}
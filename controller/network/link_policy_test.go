@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkPolicies(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r0.Tags = map[string]interface{}{"hub": true, "region": "us"}
+	r1 := entityHelper.addTestRouter()
+	r1.Tags = map[string]interface{}{"hub": true, "region": "eu"}
+	r2 := entityHelper.addTestRouter()
+	r2.Tags = map[string]interface{}{"region": "us"}
+	r3 := entityHelper.addTestRouter()
+	r3.Tags = map[string]interface{}{"region": "eu"}
+
+	routers := []*Router{r0, r1, r2, r3}
+
+	dialedLinks := func() []string {
+		policies, err := network.LinkPolicies.getPolicySet()
+		req.NoError(err)
+		links, err := network.linkController.missingLinks(routers, time.Minute, policies)
+		req.NoError(err)
+		var result []string
+		for _, link := range links {
+			result = append(result, link.Src.Id+"->"+link.Dst.Id)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	// the policy set cache is invalidated by store events, which are delivered asynchronously
+	requireDialedLinks := func(expected []string) {
+		req.Eventually(func() bool {
+			return reflect.DeepEqual(expected, dialedLinks())
+		}, time.Second, 10*time.Millisecond, "expected dialed links %v, got %v", expected, dialedLinks())
+	}
+
+	// with no policies, every router dials every other router
+	req.Equal(12, len(dialedLinks()))
+
+	// spokes dial the hub in their region
+	req.NoError(network.LinkPolicies.Create(&LinkPolicy{
+		Name:               "spokes",
+		SourceRouters:      []string{"#region"},
+		DestinationRouters: []string{"#hub"},
+		MatchTags:          []string{"region"},
+	}))
+	requireDialedLinks([]string{r2.Id + "->" + r0.Id, r3.Id + "->" + r1.Id})
+
+	// hubs are meshed
+	req.NoError(network.LinkPolicies.Create(&LinkPolicy{
+		Name:               "hubs",
+		SourceRouters:      []string{"#hub=true"},
+		DestinationRouters: []string{"#hub=true"},
+	}))
+	requireDialedLinks([]string{r0.Id + "->" + r1.Id, r1.Id + "->" + r0.Id, r2.Id + "->" + r0.Id, r3.Id + "->" + r1.Id})
+
+	// deny policies apply in both directions
+	req.NoError(network.LinkPolicies.Create(&LinkPolicy{
+		Name:               "never",
+		SourceRouters:      []string{"@" + r1.Id},
+		DestinationRouters: []string{"@" + r3.Id},
+		Action:             db.LinkPolicyActionDeny,
+	}))
+	requireDialedLinks([]string{r0.Id + "->" + r1.Id, r1.Id + "->" + r0.Id, r2.Id + "->" + r0.Id})
+
+	// preview the stored policies
+	preview, err := network.PreviewLinkPolicies(nil)
+	req.NoError(err)
+	req.Equal(3, len(preview))
+	for _, link := range preview {
+		req.Equal(1, len(link.Policies))
+		req.False(link.Linked)
+		if link.Src == r2 {
+			req.Equal("spokes", link.Policies[0].Name)
+		} else {
+			req.Equal("hubs", link.Policies[0].Name)
+		}
+	}
+
+	newPathTestLink(network, "l0", r2, r0)
+	preview, err = network.PreviewLinkPolicies(nil)
+	req.NoError(err)
+	for _, link := range preview {
+		req.Equal(link.Src == r2, link.Linked)
+	}
+
+	// existing links which the policies don't allow are removed
+	denied := newPathTestLink(network, "l1", r3, r1)
+	policies, err := network.LinkPolicies.getPolicySet()
+	req.NoError(err)
+	req.Equal([]*Link{denied}, network.linkController.disallowedLinks(policies))
+
+	// links already being removed aren't removed again
+	network.linkController.disallowedRemovals.Set(denied.Id, denied)
+	network.removeDisallowedLinks(policies)
+	req.True(network.linkController.has(denied))
+
+	network.linkController.disallowedRemovals.Remove(denied.Id)
+	network.removeDisallowedLinks(policies)
+	req.Eventually(func() bool {
+		return !network.linkController.has(denied) && network.linkController.disallowedRemovals.IsEmpty()
+	}, time.Second, 10*time.Millisecond)
+
+	// an empty policy set previews the full mesh
+	preview, err = network.PreviewLinkPolicies([]*LinkPolicy{})
+	req.NoError(err)
+	req.Equal(12, len(preview))
+
+	preview, err = network.PreviewLinkPolicies([]*LinkPolicy{{Name: "eu", MatchTags: []string{"region"}}})
+	req.NoError(err)
+	req.Equal(4, len(preview))
+
+	_, err = network.PreviewLinkPolicies([]*LinkPolicy{{Name: "invalid", Action: "sometimes"}})
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+}
@@ -38,16 +38,17 @@ const (
 )
 
 type Managers struct {
-	network     *Network
-	db          boltz.Db
-	stores      *db.Stores
-	Terminators *TerminatorManager
-	Routers     *RouterManager
-	Services    *ServiceManager
-	Inspections *InspectionsManager
-	Command     *CommandManager
-	Dispatcher  command.Dispatcher
-	Registry    ioc.Registry
+	network      *Network
+	db           boltz.Db
+	stores       *db.Stores
+	Terminators  *TerminatorManager
+	Routers      *RouterManager
+	Services     *ServiceManager
+	LinkPolicies *LinkPolicyManager
+	Inspections  *InspectionsManager
+	Command      *CommandManager
+	Dispatcher   command.Dispatcher
+	Registry     ioc.Registry
 }
 
 func (self *Managers) getDb() boltz.Db {
@@ -138,6 +139,7 @@ func RegisterDeleteDecoder(managers *Managers, deleter command.EntityDeleter) {
 		return &command.DeleteEntityCommand{
 			Deleter: deleter,
 			Id:      cmd.EntityId,
+			Cause:   cmd.Cause,
 		}, nil
 	}))
 }
@@ -160,6 +162,7 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	result.Terminators = newTerminatorManager(result)
 	result.Routers = newRouterManager(result)
 	result.Services = newServiceManager(result)
+	result.LinkPolicies = newLinkPolicyManager(result)
 	result.Inspections = NewInspectionsManager(network)
 	if result.Dispatcher == nil {
 		devVersion := versions.MustParseSemVer("0.0.0")
@@ -173,6 +176,7 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	RegisterManagerDecoder[*Service](result, result.Services)
 	RegisterManagerDecoder[*Router](result, result.Routers)
 	RegisterManagerDecoder[*Terminator](result, result.Terminators)
+	RegisterManagerDecoder[*LinkPolicy](result, result.LinkPolicies)
 
 	return result
 }
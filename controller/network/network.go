@@ -63,36 +63,51 @@ type Config interface {
 
 type Network struct {
 	*Managers
-	nodeId                 string
-	options                *Options
-	routerChanged          chan *Router
-	linkController         *linkController
-	linkChanged            chan *Link
-	forwardingFaults       chan *ForwardingFaultReport
-	circuitController      *circuitController
-	routeSenderController  *routeSenderController
-	sequence               *sequence.Sequence
-	eventDispatcher        event.Dispatcher
-	traceController        trace.Controller
-	routerPresenceHandlers []RouterPresenceHandler
-	capabilities           []string
-	closeNotify            <-chan struct{}
-	lock                   sync.Mutex
-	strategyRegistry       xt.Registry
-	lastSnapshot           time.Time
-	metricsRegistry        metrics.Registry
-	VersionProvider        versions.VersionProvider
-
-	serviceEventMetrics          metrics.UsageRegistry
-	serviceDialSuccessCounter    metrics.IntervalCounter
-	serviceDialFailCounter       metrics.IntervalCounter
-	serviceDialTimeoutCounter    metrics.IntervalCounter
-	serviceDialOtherErrorCounter metrics.IntervalCounter
+	nodeId                   string
+	options                  *Options
+	routerChanged            chan *Router
+	linkController           *linkController
+	linkChanged              chan *Link
+	forwardingFaults         chan *ForwardingFaultReport
+	circuitController        *circuitController
+	routeSenderController    *routeSenderController
+	sequence                 *sequence.Sequence
+	eventDispatcher          event.Dispatcher
+	traceController          trace.Controller
+	routerPresenceHandlers   []RouterPresenceHandler
+	terminatorHealthHandlers []TerminatorHealthHandler
+	terminatorDrainHandlers  []TerminatorDrainHandler
+	capabilities             []string
+	closeNotify              <-chan struct{}
+	lock                     sync.Mutex
+	strategyRegistry         xt.Registry
+	lastSnapshot             time.Time
+	startTime                time.Time
+	pathCache                *pathCache
+	circuitRecovery          *circuitRecovery
+	circuitAdmission         *circuitAdmission
+	routerDrains             *routerDrains
+	terminatorHealth         *terminatorHealth
+	terminatorDrains         *terminatorDrains
+	terminatorLeases         *terminatorLeases
+	offlineTerminators       offlineTerminators
+	metricsRegistry          metrics.Registry
+	VersionProvider          versions.VersionProvider
+
+	serviceEventMetrics              metrics.UsageRegistry
+	serviceDialSuccessCounter        metrics.IntervalCounter
+	serviceDialFailCounter           metrics.IntervalCounter
+	serviceDialTimeoutCounter        metrics.IntervalCounter
+	serviceDialOtherErrorCounter     metrics.IntervalCounter
+	serviceDialAdmissionLimitCounter metrics.IntervalCounter
 
 	serviceTerminatorTimeoutCounter           metrics.IntervalCounter
 	serviceTerminatorConnectionRefusedCounter metrics.IntervalCounter
 	serviceInvalidTerminatorCounter           metrics.IntervalCounter
 	serviceMisconfiguredTerminatorCounter     metrics.IntervalCounter
+
+	serviceSplitGroupDialSuccessCounter metrics.IntervalCounter
+	serviceSplitGroupDialFailCounter    metrics.IntervalCounter
 }
 
 func NewNetwork(config Config) (*Network, error) {
@@ -111,6 +126,12 @@ func NewNetwork(config Config) (*Network, error) {
 		linkChanged:           make(chan *Link, 16),
 		forwardingFaults:      make(chan *ForwardingFaultReport, 16),
 		circuitController:     newCircuitController(),
+		circuitRecovery:       newCircuitRecovery(),
+		circuitAdmission:      newCircuitAdmission(),
+		routerDrains:          newRouterDrains(),
+		terminatorHealth:      newTerminatorHealth(),
+		terminatorDrains:      newTerminatorDrains(),
+		terminatorLeases:      newTerminatorLeases(),
 		routeSenderController: newRouteSenderController(),
 		sequence:              sequence.NewSequence(),
 		eventDispatcher:       config.GetEventDispatcher(),
@@ -118,23 +139,30 @@ func NewNetwork(config Config) (*Network, error) {
 		closeNotify:           config.GetCloseNotify(),
 		strategyRegistry:      xt.GlobalRegistry(),
 		lastSnapshot:          time.Now().Add(-time.Hour),
+		startTime:             time.Now(),
 		metricsRegistry:       config.GetMetricsRegistry(),
 		VersionProvider:       config.GetVersionProvider(),
 
-		serviceEventMetrics:          serviceEventMetrics,
-		serviceDialSuccessCounter:    serviceEventMetrics.IntervalCounter("service.dial.success", time.Minute),
-		serviceDialFailCounter:       serviceEventMetrics.IntervalCounter("service.dial.fail", time.Minute),
-		serviceDialTimeoutCounter:    serviceEventMetrics.IntervalCounter("service.dial.timeout", time.Minute),
-		serviceDialOtherErrorCounter: serviceEventMetrics.IntervalCounter("service.dial.error_other", time.Minute),
+		serviceEventMetrics:              serviceEventMetrics,
+		serviceDialSuccessCounter:        serviceEventMetrics.IntervalCounter("service.dial.success", time.Minute),
+		serviceDialFailCounter:           serviceEventMetrics.IntervalCounter("service.dial.fail", time.Minute),
+		serviceDialTimeoutCounter:        serviceEventMetrics.IntervalCounter("service.dial.timeout", time.Minute),
+		serviceDialOtherErrorCounter:     serviceEventMetrics.IntervalCounter("service.dial.error_other", time.Minute),
+		serviceDialAdmissionLimitCounter: serviceEventMetrics.IntervalCounter("service.dial.admission_limit", time.Minute),
 
 		serviceTerminatorTimeoutCounter:           serviceEventMetrics.IntervalCounter("service.dial.terminator.timeout", time.Minute),
 		serviceTerminatorConnectionRefusedCounter: serviceEventMetrics.IntervalCounter("service.dial.terminator.connection_refused", time.Minute),
 		serviceInvalidTerminatorCounter:           serviceEventMetrics.IntervalCounter("service.dial.terminator.invalid", time.Minute),
 		serviceMisconfiguredTerminatorCounter:     serviceEventMetrics.IntervalCounter("service.dial.terminator.misconfigured", time.Minute),
+
+		serviceSplitGroupDialSuccessCounter: serviceEventMetrics.IntervalCounter("service.dial.split_group.success", time.Minute),
+		serviceSplitGroupDialFailCounter:    serviceEventMetrics.IntervalCounter("service.dial.split_group.fail", time.Minute),
 	}
 
+	network.pathCache = newPathCache(network.linkController.topology)
 	network.Managers = NewManagers(network, config.GetCommandDispatcher(), config.GetDb(), stores)
 	network.Managers.Inspections.network = network
+	network.initTerminatorHealthChecks()
 
 	network.AddCapability("ziti.fabric")
 	network.showOptions()
@@ -229,7 +257,7 @@ func (network *Network) RouteResult(rs *RouteStatus) bool {
 }
 
 func (network *Network) newRouteSender(circuitId string) *routeSender {
-	rs := newRouteSender(circuitId, network.options.RouteTimeout, network, network.Terminators)
+	rs := newRouteSender(circuitId, network.options.RouteTimeout, network, network.Terminators, network.metricsRegistry)
 	network.routeSenderController.addRouteSender(rs)
 	return rs
 }
@@ -271,6 +299,9 @@ func (network *Network) ConnectRouter(r *Router) {
 		go h.RouterConnected(r)
 	}
 	go network.ValidateTerminators(r)
+	go network.syncTerminatorHealthChecks(r)
+	go network.recordRouterPresence(r.Id)
+	go network.restoreOfflineTerminators(r)
 }
 
 func (network *Network) ValidateTerminators(r *Router) {
@@ -317,6 +348,7 @@ func (network *Network) DisconnectRouter(r *Router) {
 	for _, h := range network.routerPresenceHandlers {
 		h.RouterDisconnected(r)
 	}
+	go network.recordRouterPresence(r.Id)
 }
 
 func (network *Network) NotifyExistingLink(id, linkProtocol, dialAddress string, srcRouter *Router, dstRouterId string) (bool, error) {
@@ -328,6 +360,9 @@ func (network *Network) NotifyExistingLink(id, linkProtocol, dialAddress string,
 	link, created := network.linkController.routerReportedLink(id, linkProtocol, dialAddress, srcRouter, dst)
 	if created {
 		network.NotifyLinkEvent(link, event.LinkFromRouterNew)
+		if link.IsFlapSuppressed() {
+			network.notifyLinkFlapStateChanged(link)
+		}
 	} else {
 		network.NotifyLinkEvent(link, event.LinkFromRouterKnown)
 	}
@@ -340,8 +375,12 @@ func (network *Network) LinkConnected(msg *ctrl_pb.LinkConnected) error {
 			return errors.Errorf("link [l/%v] state is %v, not pending, cannot mark connected", msg.Id, state.Mode)
 		}
 
+		wasSuppressed := l.IsFlapSuppressed()
 		l.addState(newLinkState(Connected))
 		network.NotifyLinkConnected(l, msg)
+		if l.IsFlapSuppressed() != wasSuppressed {
+			network.notifyLinkFlapStateChanged(l)
+		}
 		return nil
 	}
 	return errors.Errorf("no such link [l/%s]", msg.Id)
@@ -401,12 +440,18 @@ func (network *Network) VerifyRouter(routerId string, fingerprints []string) err
 }
 
 func (network *Network) LinkChanged(l *Link) {
+	network.topologyChanged()
 	// This is called from Channel.rxer() and thus may not block
 	go func() {
 		network.linkChanged <- l
 	}()
 }
 
+// topologyChanged invalidates cached paths. Should be called when anything affecting path selection changes.
+func (network *Network) topologyChanged() {
+	network.linkController.topology.changed()
+}
+
 func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, error) {
 	srcR := params.GetSourceRouter()
 	clientId := params.GetClientId()
@@ -417,11 +462,17 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 	startTime := time.Now()
 
 	instanceId, serviceId := parseInstanceIdAndService(service)
+	strategyParams := &circuitStrategyParams{
+		clientId:     clientId.Token,
+		tags:         getRequestTags(params),
+		sourceRouter: srcR,
+		routers:      network.Routers,
+	}
 
 	// 1: Allocate Circuit Identifier
 	circuitId, err := network.circuitController.nextCircuitId()
 	if err != nil {
-		network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, CircuitFailureInvalidService)
+		network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, CircuitFailureInvalidService, nil)
 		return nil, err
 	}
 	ctx.WithField("circuitId", circuitId)
@@ -433,20 +484,41 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 	allCleanups := make(map[string]struct{})
 	rs := network.newRouteSender(circuitId)
 	defer func() { network.removeRouteSender(rs) }()
+
+	admitted := false
+	created := false
+	defer func() {
+		if admitted && !created {
+			network.circuitAdmission.release(serviceId, clientId.Token)
+		}
+	}()
+
 	for {
 		// 2: Find Service
 		svc, err := network.Services.Read(serviceId)
 		if err != nil {
-			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, CircuitFailureInvalidService)
+			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, CircuitFailureInvalidService, rs.timings)
 			network.ServiceDialOtherError(serviceId)
 			return nil, err
 		}
 		logger = logger.WithField("serviceName", svc.Name)
+		strategyParams.trafficSplit = svc.TrafficSplit
+
+		// 2a: Check circuit limits
+		if !admitted {
+			if circuitErr := network.circuitAdmission.reserve(svc, clientId.Token, time.Now()); circuitErr != nil {
+				logger.WithError(circuitErr).Warn("circuit rejected by admission limits")
+				network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, circuitErr.Cause(), rs.timings)
+				network.ServiceDialAdmissionLimit(serviceId)
+				return nil, circuitErr
+			}
+			admitted = true
+		}
 
 		// 3: select terminator
-		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, ctx)
+		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, strategyParams, ctx)
 		if circuitErr != nil {
-			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, circuitErr.Cause())
+			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, circuitErr.Cause(), rs.timings)
 			network.ServiceDialOtherError(serviceId)
 			return nil, circuitErr
 		}
@@ -454,7 +526,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		// 4: Create Path
 		path, pathErr := network.CreatePathWithNodes(pathNodes)
 		if pathErr != nil {
-			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, terminator, pathErr.Cause())
+			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, terminator, pathErr.Cause(), rs.timings)
 			network.ServiceDialOtherError(serviceId)
 			return nil, pathErr
 		}
@@ -471,6 +543,10 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 				ChannelMask: ctx.GetChannelsMask(),
 			}
 			msg.Tags = tags
+			msg.ServiceId = svc.Id
+			msg.TerminatorId = terminator.GetId()
+			msg.ClientId = clientId.Token
+			msg.CreatedAt = startTime.UnixMilli()
 		}
 
 		// 5: Routing
@@ -481,7 +557,8 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		}
 		if circuitErr != nil {
 			logger.WithError(circuitErr).Warn("route attempt for circuit failed")
-			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, path, terminator, circuitErr.Cause())
+			network.ServiceSplitGroupDialFail(svc, terminator)
+			network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, path, terminator, circuitErr.Cause(), rs.timings)
 			attempt++
 			ctx.WithField("attemptNumber", attempt+1)
 			logger = logger.WithField("attemptNumber", attempt+1)
@@ -546,8 +623,11 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 			Tags:       tags,
 		}
 		network.circuitController.add(circuit)
+		network.installStandbyPath(circuit)
+		created = true
+		network.ServiceSplitGroupDialSuccess(svc, terminator)
 		creationTimespan := time.Since(startTime)
-		network.CircuitEvent(event.CircuitCreated, circuit, &creationTimespan)
+		network.CircuitCreatedEvent(circuit, creationTimespan, rs.timings)
 
 		logger.WithField("path", circuit.Path).
 			WithField("terminator_local_address", circuit.Path.TerminatorLocalAddr).
@@ -571,8 +651,8 @@ func parseInstanceIdAndService(service string) (string, string) {
 	return identityId, serviceId
 }
 
-func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
-	paths := map[string]*PathAndCost{}
+func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, params xt.CreateCircuitParams, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
+	paths := map[string][]*Router{}
 	var weightedTerminators []xt.CostedTerminator
 	var errList []error
 
@@ -581,44 +661,24 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 	hasOfflineRouters := false
 	pathError := false
 
-	for _, terminator := range svc.Terminators {
-		if terminator.InstanceId != instanceId {
+	for _, candidate := range network.evaluateTerminators(srcR, svc, instanceId) {
+		switch candidate.SkipReason {
+		case TerminatorSkipInstanceMismatch, TerminatorSkipDraining:
+			continue
+		case TerminatorSkipRouterOffline:
+			log.Debugf("error while calculating path for service %v: %v", svc.Id, candidate.Err)
+			errList = append(errList, candidate.Err)
+			hasOfflineRouters = true
+			continue
+		case TerminatorSkipNoPath:
+			log.Debugf("error while calculating path for service %v: %v", svc.Id, candidate.Err)
+			errList = append(errList, candidate.Err)
+			pathError = true
 			continue
 		}
 
-		pathAndCost, found := paths[terminator.Router]
-		if !found {
-			dstR := network.Routers.getConnected(terminator.GetRouterId())
-			if dstR == nil {
-				err := errors.Errorf("router with id=%v on terminator with id=%v for service name=%v is not online",
-					terminator.GetRouterId(), terminator.GetId(), svc.Name)
-				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
-
-				errList = append(errList, err)
-				hasOfflineRouters = true
-				continue
-			}
-
-			path, cost, err := network.shortestPath(srcR, dstR)
-			if err != nil {
-				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
-				errList = append(errList, err)
-				pathError = true
-				continue
-			}
-
-			pathAndCost = newPathAndCost(path, cost)
-			paths[terminator.GetRouterId()] = pathAndCost
-		}
-
-		dynamicCost := xt.GlobalCosts().GetDynamicCost(terminator.Id)
-		unbiasedCost := uint32(terminator.Cost) + uint32(dynamicCost) + pathAndCost.cost
-		biasedCost := terminator.Precedence.GetBiasedCost(unbiasedCost)
-		costedTerminator := &RoutingTerminator{
-			Terminator: terminator,
-			RouteCost:  biasedCost,
-		}
-		weightedTerminators = append(weightedTerminators, costedTerminator)
+		paths[candidate.Terminator.GetRouterId()] = candidate.Path
+		weightedTerminators = append(weightedTerminators, candidate.Routing)
 	}
 
 	if len(svc.Terminators) == 0 {
@@ -646,7 +706,7 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 		return weightedTerminators[i].GetRouteCost() < weightedTerminators[j].GetRouteCost()
 	})
 
-	terminator, err := strategy.Select(weightedTerminators)
+	terminator, err := xt.SelectTerminator(strategy, params, weightedTerminators)
 
 	if err != nil {
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureStrategyError, "strategy %v errored selecting terminator for service %v: %v", svc.TerminatorStrategy, svc.Id, err)
@@ -656,7 +716,7 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureStrategyError, "strategy %v did not select terminator for service %v", svc.TerminatorStrategy, svc.Id)
 	}
 
-	path := paths[terminator.GetRouterId()].path
+	path := paths[terminator.GetRouterId()]
 
 	if log.Logger.IsLevelEnabled(logrus.DebugLevel) {
 		buf := strings.Builder{}
@@ -684,13 +744,15 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	log := pfxlog.Logger().WithField("circuitId", circuitId)
 
 	if circuit, found := network.circuitController.get(circuitId); found {
-		for _, r := range circuit.Path.Nodes {
+		for _, r := range circuit.routers() {
 			err := sendUnroute(r, circuit.Id, now)
 			if err != nil {
 				log.Errorf("error sending unroute to [r/%s] (%s)", r.Id, err)
 			}
 		}
-		network.circuitController.remove(circuit)
+		if network.circuitController.remove(circuit) {
+			network.circuitAdmission.release(circuit.Service.Id, circuit.ClientId)
+		}
 		network.CircuitEvent(event.CircuitDeleted, circuit, nil)
 
 		if strategy, err := network.strategyRegistry.GetStrategy(circuit.Service.TerminatorStrategy); strategy != nil {
@@ -752,9 +814,15 @@ func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitErro
 }
 
 func (network *Network) UpdatePath(path *Path) (*Path, error) {
+	return network.UpdatePathForService(path, nil)
+}
+
+// UpdatePathForService computes a new path between the endpoints of the given path, honoring the routing
+// policy of the given service. If the service is nil, no constraints are applied.
+func (network *Network) UpdatePathForService(path *Path, svc *Service) (*Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.shortestPathForService(svc, srcR, dstR)
 	if err != nil {
 		return nil, err
 	}
@@ -809,6 +877,13 @@ func (network *Network) Run() {
 			network.assemble()
 			network.clean()
 			network.smart()
+			network.recoverCircuits()
+			network.processDrains()
+			network.processTerminatorDrains()
+			network.processTerminatorLeases()
+			go network.syncRouterPresence()
+			go network.processOfflineTerminators()
+			network.updateLinkFlapDamping()
 
 		case <-network.closeNotify:
 			network.eventDispatcher.RemoveMetricsMessageHandler(network)
@@ -883,7 +958,7 @@ func (network *Network) rerouteLink(l *Link, deadline time.Time) error {
 			log := logrus.WithField("linkId", l.Id).
 				WithField("circuitId", circuit.Id)
 			log.Info("circuit uses link")
-			if err := network.rerouteCircuit(circuit, deadline); err != nil {
+			if err := network.rerouteCircuit(circuit, deadline, RerouteReasonLinkFault); err != nil {
 				log.WithError(err).Error("error rerouting circuit, removing")
 				if err := network.RemoveCircuit(circuit.Id, true); err != nil {
 					log.WithError(err).Error("error removing circuit after reroute failure")
@@ -895,12 +970,12 @@ func (network *Network) rerouteLink(l *Link, deadline time.Time) error {
 	return nil
 }
 
-func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int) {
+func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int, reason RerouteReason) {
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
 
 	for i := 0; i < retries; i++ {
 		deadline := time.Now().Add(DefaultNetworkOptionsRouteTimeout)
-		err := network.rerouteCircuit(circuit, deadline)
+		err := network.rerouteCircuit(circuit, deadline, reason)
 		if err == nil {
 			return
 		}
@@ -913,17 +988,42 @@ func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int) {
 	}
 }
 
-func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) error {
-	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time, reason RerouteReason) error {
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("reason", reason)
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Set(false)
 
 		log.Warn("rerouting circuit")
+		oldPath := circuit.Path
+
+		if standby := circuit.StandbyPath; standby != nil && network.isPathUsable(standby) {
+			log.WithField("path", standby).Info("promoting standby path")
+			circuit.StandbyPath = nil
+			circuit.Path = standby
+
+			rms := standby.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+			circuit.addCircuitInfo(rms)
+
+			for i := 0; i < len(standby.Nodes); i++ {
+				if _, err := sendRoute(standby.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+					log.WithError(err).Errorf("error sending route to [r/%s]", standby.Nodes[i].Id)
+				}
+			}
+
+			network.updateStandbyPath(circuit)
+			circuit.markRerouted()
+
+			log.Info("rerouted circuit to standby path")
 
-		if cq, err := network.UpdatePath(circuit.Path); err == nil {
+			network.CircuitRerouteEvent(circuit, oldPath, reason)
+			return nil
+		}
+
+		if cq, err := network.UpdatePathForService(circuit.Path, circuit.Service); err == nil {
 			circuit.Path = cq
 
 			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+			circuit.addCircuitInfo(rms)
 
 			for i := 0; i < len(cq.Nodes); i++ {
 				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -931,9 +1031,12 @@ func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) err
 				}
 			}
 
+			network.updateStandbyPath(circuit)
+			circuit.markRerouted()
+
 			log.Info("rerouted circuit")
 
-			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+			network.CircuitRerouteEvent(circuit, oldPath, reason)
 			return nil
 		} else {
 			return err
@@ -944,15 +1047,128 @@ func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) err
 	}
 }
 
-func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.Time) bool {
+// installStandbyPath sets up the standby path for a newly created circuit in the background, so that dials don't
+// wait on the additional route round trips
+func (network *Network) installStandbyPath(circuit *Circuit) {
+	mode := network.options.StandbyPathMode
+	if mode == "" || mode == DisjointPathModeNone {
+		return
+	}
+
+	go func() {
+		// if a reroute is already in progress, it will set up the standby path itself
+		if !circuit.Rerouting.CompareAndSwap(false, true) {
+			return
+		}
+		defer circuit.Rerouting.Set(false)
+
+		network.updateStandbyPath(circuit)
+
+		// the circuit may have been removed while the standby path was being installed
+		if _, found := network.circuitController.get(circuit.Id); !found {
+			if standby := circuit.StandbyPath; standby != nil {
+				circuit.StandbyPath = nil
+				network.unrouteStandbyRouters(circuit, standby.Nodes)
+			}
+		}
+	}()
+}
+
+// updateStandbyPath computes standby paths for the circuit which are disjoint from its current path and
+// pre-installs the least expensive one which all of its routers accept. If no standby path can be found or
+// installed, the circuit is left without one. Routers which were only on the previous standby path are unrouted.
+func (network *Network) updateStandbyPath(circuit *Circuit) {
+	mode := network.options.StandbyPathMode
+	if mode == "" || mode == DisjointPathModeNone {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+
+	var previous []*Router
+	if oldStandby := circuit.StandbyPath; oldStandby != nil {
+		previous = oldStandby.Nodes
+	}
+	circuit.StandbyPath = nil
+
+	candidates, err := network.standbyPathsForService(circuit.Service, circuit.Path)
+	if err != nil {
+		log.WithError(err).Debug("no standby path available for circuit")
+		network.unrouteStandbyRouters(circuit, previous)
+		return
+	}
+
+	for _, standby := range candidates {
+		if network.installStandbyRoutes(circuit, standby) {
+			circuit.StandbyPath = standby
+			network.unrouteStandbyRouters(circuit, previous)
+			log.WithField("standbyPath", standby).Debug("installed standby path for circuit")
+			return
+		}
+	}
+
+	log.Debug("unable to install any standby path for circuit")
+	network.unrouteStandbyRouters(circuit, previous)
+}
+
+// installStandbyRoutes sends the routes for a standby path to its routers. If a router fails to install its route,
+// the routers which were already sent routes are unrouted, and false is returned
+func (network *Network) installStandbyRoutes(circuit *Circuit, standby *Path) bool {
+	rms := standby.CreateStandbyRouteMessages(circuit.Id, time.Now().Add(network.options.RouteTimeout))
+	circuit.addCircuitInfo(rms)
+	for i := 0; i < len(standby.Nodes); i++ {
+		if _, err := sendRoute(standby.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+			pfxlog.Logger().WithField("circuitId", circuit.Id).WithError(err).
+				Errorf("error sending standby route to [r/%s]", standby.Nodes[i].Id)
+			// include the failed router, as it may have installed the route after the timeout
+			network.unrouteStandbyRouters(circuit, standby.Nodes[:i+1])
+			return false
+		}
+	}
+	return true
+}
+
+// unrouteStandbyRouters removes the circuit from the given standby routers, unless they're still used by one of the
+// circuit's paths. Once a router is no longer on either path, RemoveCircuit won't unroute it. If the circuit no
+// longer has a standby path, routers on the primary path only have their standby forwards removed, so that they
+// can't fail over to routers which were just unrouted.
+func (network *Network) unrouteStandbyRouters(circuit *Circuit, routers []*Router) {
+	inUse := map[string]struct{}{}
+	for _, r := range circuit.routers() {
+		inUse[r.Id] = struct{}{}
+	}
+
+	handled := map[string]struct{}{}
+	for _, r := range routers {
+		if _, found := handled[r.Id]; found {
+			continue
+		}
+		handled[r.Id] = struct{}{}
+
+		log := pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("routerId", r.Id)
+		if _, found := inUse[r.Id]; !found {
+			if err := sendUnroute(r, circuit.Id, true); err != nil {
+				log.WithError(err).Error("error sending unroute for standby path")
+			}
+		} else if circuit.StandbyPath == nil {
+			if err := sendStandbyUnroute(r, circuit.Id); err != nil {
+				log.WithError(err).Error("error sending standby unroute")
+			}
+		}
+	}
+}
+
+func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.Time, reason RerouteReason) bool {
 	retry := false
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Set(false)
 
+		oldPath := circuit.Path
 		circuit.Path = cq
 
 		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+		circuit.addCircuitInfo(rms)
 
 		for i := 0; i < len(cq.Nodes); i++ {
 			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -963,8 +1179,10 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 		}
 
 		if !retry {
-			logrus.Debug("rerouted circuit")
-			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+			network.updateStandbyPath(circuit)
+			circuit.markRerouted()
+			log.WithField("reason", reason).Debug("rerouted circuit")
+			network.CircuitRerouteEvent(circuit, oldPath, reason)
 		}
 	}
 	return retry
@@ -1051,6 +1269,15 @@ func sendUnroute(r *Router, circuitId string, now bool) error {
 	return protobufs.MarshalTyped(unroute).Send(r.Control)
 }
 
+// sendStandbyUnroute removes the standby forwards for the circuit from the router, leaving the rest of the route
+func sendStandbyUnroute(r *Router, circuitId string) error {
+	unroute := &ctrl_pb.Unroute{
+		CircuitId:   circuitId,
+		StandbyOnly: true,
+	}
+	return protobufs.MarshalTyped(unroute).Send(r.Control)
+}
+
 func (network *Network) showOptions() {
 	if jsonOptions, err := json.MarshalIndent(network.options, "", "  "); err == nil {
 		pfxlog.Logger().Infof("network = %s", string(jsonOptions))
@@ -1075,6 +1302,22 @@ func (network *Network) Inspect(name string) *string {
 			result = string(js)
 		}
 		return &result
+	} else if strings.HasPrefix(lc, "strategy:") {
+		strategyName := name[len("strategy:"):]
+		var result string
+		strategy, err := network.strategyRegistry.GetStrategy(strategyName)
+		if err != nil {
+			result = err.Error()
+		} else if inspectable, ok := strategy.(xt.InspectableStrategy); ok {
+			if js, err := json.Marshal(inspectable.Inspect()); err != nil {
+				result = errors.Wrapf(err, "failed to marshal state of strategy %v to json", strategyName).Error()
+			} else {
+				result = string(js)
+			}
+		} else {
+			result = fmt.Sprintf("strategy %v does not support inspection", strategyName)
+		}
+		return &result
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/event"
 	"github.com/openziti/fabric/logcontext"
+	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/openziti/foundation/v2/versions"
 	"github.com/openziti/identity"
 	"github.com/openziti/metrics"
@@ -20,11 +21,29 @@ import (
 )
 
 type testConfig struct {
+	id              string
 	ctx             *db.TestContext
 	options         *Options
 	metricsRegistry metrics.Registry
 	versionProvider versions.VersionProvider
 	closeNotify     chan struct{}
+	dispatcher      command.Dispatcher
+}
+
+// testDispatcher applies commands locally, like a controller in a cluster which may or may not be the leader
+type testDispatcher struct {
+	command.LocalDispatcher
+	leader concurrenz.AtomicBoolean
+}
+
+func newTestDispatcher(leader bool) *testDispatcher {
+	result := &testDispatcher{}
+	result.leader.Set(leader)
+	return result
+}
+
+func (self *testDispatcher) IsLeader() bool {
+	return self.leader.Get()
 }
 
 func newTestConfig(ctx *db.TestContext) *testConfig {
@@ -46,6 +65,9 @@ func (self *testConfig) GetEventDispatcher() event.Dispatcher {
 }
 
 func (self *testConfig) GetId() *identity.TokenId {
+	if self.id != "" {
+		return &identity.TokenId{Token: self.id}
+	}
 	return &identity.TokenId{Token: "test"}
 }
 
@@ -58,7 +80,7 @@ func (self *testConfig) GetOptions() *Options {
 }
 
 func (self *testConfig) GetCommandDispatcher() command.Dispatcher {
-	return nil
+	return self.dispatcher
 }
 
 func (self *testConfig) GetDb() boltz.Db {
@@ -132,7 +154,7 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	*/
 	lc := logcontext.NewContext()
-	_, _, _, cerr := network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr := network.selectPath(r0, svc, "", &circuitStrategyParams{}, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 
@@ -148,15 +170,15 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	}
 
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "", &circuitStrategyParams{}, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoOnlineTerminators, cerr.Cause())
 
 	network.Routers.markConnected(r0)
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "", &circuitStrategyParams{}, lc)
 	assert.NoError(t, cerr)
 
-	_, _, _, cerr = network.selectPath(r0, svc, "test", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "test", &circuitStrategyParams{}, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 }
@@ -0,0 +1,293 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/pkg/errors"
+)
+
+type OfflineTerminatorAction string
+
+const (
+	DefaultOfflineTerminatorThreshold = 7 * 24 * time.Hour
+
+	// OfflineTerminatorActionDelete deletes terminators of routers which have been offline past the threshold
+	OfflineTerminatorActionDelete OfflineTerminatorAction = "delete"
+	// OfflineTerminatorActionFail gives terminators of routers which have been offline past the threshold failed
+	// precedence, so they're only used if there's nothing else available. Their previous precedence is stored with
+	// the terminator and restored when the router connects again
+	OfflineTerminatorActionFail OfflineTerminatorAction = "fail"
+)
+
+// OfflineTerminatorOptions configures cleanup of terminators hosted by routers which have been disconnected for
+// longer than Threshold. Cleanup is disabled by default.
+type OfflineTerminatorOptions struct {
+	Enabled   bool
+	Threshold time.Duration
+	Action    OfflineTerminatorAction
+}
+
+func DefaultOfflineTerminatorOptions() *OfflineTerminatorOptions {
+	return &OfflineTerminatorOptions{
+		Threshold: DefaultOfflineTerminatorThreshold,
+		Action:    OfflineTerminatorActionDelete,
+	}
+}
+
+func loadOfflineTerminatorOptions(src map[interface{}]interface{}) (*OfflineTerminatorOptions, error) {
+	options := DefaultOfflineTerminatorOptions()
+
+	if value, found := src["enabled"]; found {
+		if enabled, ok := value.(bool); ok {
+			options.Enabled = enabled
+		} else {
+			return nil, errors.New("invalid value for 'offlineTerminators.enabled'")
+		}
+	}
+
+	if value, found := src["threshold"]; found {
+		if sval, ok := value.(string); ok {
+			val, err := time.ParseDuration(sval)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for 'offlineTerminators.threshold'")
+			}
+			options.Threshold = val
+		} else {
+			return nil, errors.New("invalid value for 'offlineTerminators.threshold'")
+		}
+	}
+
+	if options.Threshold <= 0 {
+		return nil, errors.New("invalid value for 'offlineTerminators.threshold', must be greater than zero")
+	}
+
+	if value, found := src["action"]; found {
+		action, ok := value.(string)
+		if !ok || (OfflineTerminatorAction(action) != OfflineTerminatorActionDelete && OfflineTerminatorAction(action) != OfflineTerminatorActionFail) {
+			return nil, errors.Errorf("invalid value for 'offlineTerminators.action', must be one of %v or %v",
+				OfflineTerminatorActionDelete, OfflineTerminatorActionFail)
+		}
+		options.Action = OfflineTerminatorAction(action)
+	}
+
+	return options, nil
+}
+
+// offlineTerminators keeps offline terminator cleanup and router presence syncs from overlapping, since they run in
+// the background and may take longer than a cycle
+type offlineTerminators struct {
+	cleanupRunning concurrenz.AtomicBoolean
+	syncRunning    concurrenz.AtomicBoolean
+}
+
+// OfflineTerminator is a terminator whose router has been offline for longer than the offline terminator threshold
+type OfflineTerminator struct {
+	Terminator   *Terminator
+	Router       *Router
+	OfflineSince time.Time
+}
+
+// OfflineTerminatorReport lists the terminators which offline terminator cleanup acts on, given the current options
+type OfflineTerminatorReport struct {
+	Options     OfflineTerminatorOptions
+	Terminators []*OfflineTerminator
+}
+
+// GetOfflineTerminatorReport lists the terminators which offline terminator cleanup would delete or fail, without
+// changing anything. The report is generated whether or not cleanup is enabled.
+func (network *Network) GetOfflineTerminatorReport() (*OfflineTerminatorReport, error) {
+	options := *network.options.OfflineTerminators
+	result := &OfflineTerminatorReport{
+		Options: options,
+	}
+
+	routers, err := network.Routers.BaseList("true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, r := range routers.Entities {
+		// a router is only offline if it isn't connected to any controller in the cluster
+		if network.Routers.IsConnected(r.Id) || r.ConnectedControllerId != "" {
+			continue
+		}
+
+		offlineSince := network.getRouterOfflineSince(r)
+		if now.Sub(offlineSince) < options.Threshold {
+			continue
+		}
+
+		terminators, err := network.Terminators.Query(fmt.Sprintf(`router.id = "%v" limit none`, r.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, terminator := range terminators.Entities {
+			if options.Action == OfflineTerminatorActionFail && terminator.Precedence.IsFailed() {
+				continue
+			}
+			result.Terminators = append(result.Terminators, &OfflineTerminator{
+				Terminator:   terminator,
+				Router:       r,
+				OfflineSince: offlineSince,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// getRouterOfflineSince returns when the router went offline. Routers without a recorded disconnect were either
+// never connected, or were last connected before presence was recorded, so they're considered offline since the
+// controller started
+func (network *Network) getRouterOfflineSince(r *Router) time.Time {
+	if r.LastDisconnected != nil {
+		return *r.LastDisconnected
+	}
+	return network.startTime
+}
+
+// processOfflineTerminators deletes or fails the terminators of routers which have been offline longer than the
+// configured threshold. It only runs on the leader, so that cleanup is based on a single, consistent view
+func (network *Network) processOfflineTerminators() {
+	if !network.options.OfflineTerminators.Enabled || !network.Dispatcher.IsLeader() {
+		return
+	}
+
+	if !network.offlineTerminators.cleanupRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer network.offlineTerminators.cleanupRunning.Set(false)
+
+	report, err := network.GetOfflineTerminatorReport()
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to find terminators of offline routers")
+		return
+	}
+
+	for _, offline := range report.Terminators {
+		log := pfxlog.Logger().WithField("terminatorId", offline.Terminator.Id).
+			WithField("routerId", offline.Router.Id).
+			WithField("offlineSince", offline.OfflineSince)
+
+		if report.Options.Action == OfflineTerminatorActionFail {
+			log.Info("router offline past threshold, failing terminator")
+			if _, err := network.Terminators.failPrecedence(offline.Terminator, TerminatorFailedByOfflineRouter); err != nil {
+				log.WithError(err).Error("unable to fail terminator of offline router")
+			}
+		} else {
+			log.Info("router offline past threshold, deleting terminator")
+			if err := network.Terminators.Delete(offline.Terminator.Id); err != nil {
+				log.WithError(err).Error("unable to delete terminator of offline router")
+			}
+		}
+	}
+}
+
+// restoreOfflineTerminators restores the precedence of the router's terminators which were failed by offline
+// terminator cleanup. The precedence to restore is stored with the terminator, so this works across controller
+// restarts. Terminators whose precedence has since been changed from failed are left alone
+func (network *Network) restoreOfflineTerminators(r *Router) {
+	result, err := network.Terminators.Query(fmt.Sprintf(`router.id = "%v" limit none`, r.Id))
+	if err != nil {
+		pfxlog.Logger().WithField("routerId", r.Id).WithError(err).Error("unable to get terminators to restore after router reconnect")
+		return
+	}
+
+	for _, terminator := range result.Entities {
+		log := pfxlog.Logger().WithField("routerId", r.Id).WithField("terminatorId", terminator.Id)
+		restored, err := network.Terminators.restorePrecedence(terminator, TerminatorFailedByOfflineRouter)
+		if err != nil {
+			log.WithError(err).Error("unable to restore terminator precedence after router reconnect")
+		} else if restored {
+			log.Infof("router reconnected, restored terminator precedence to %v", terminator.Precedence)
+		}
+	}
+}
+
+// recordRouterPresence persists whether the router is connected to a controller in the cluster. A connected router is
+// claimed by this controller, unless another controller already has it. A disconnected router is released, and its
+// disconnect time recorded, only if this controller holds the claim, since the router may still be connected to the
+// controller which does. The current connection state is used rather than the event which triggered the update, so
+// that updates for a router which quickly disconnects and reconnects can't be applied out of order
+func (network *Network) recordRouterPresence(routerId string) {
+	// the router cache is updated asynchronously, so read the stored value
+	router, err := network.Routers.readUncached(routerId)
+	if err != nil {
+		pfxlog.Logger().WithField("routerId", routerId).WithError(err).Debug("unable to read router to record presence")
+		return
+	}
+	network.updateRouterPresence(router)
+}
+
+func (network *Network) updateRouterPresence(router *Router) {
+	update := &Router{
+		BaseEntity: models.BaseEntity{Id: router.Id},
+	}
+
+	if network.Routers.IsConnected(router.Id) {
+		if router.ConnectedControllerId != "" && router.LastDisconnected == nil {
+			return
+		}
+		update.ConnectedControllerId = network.nodeId
+	} else {
+		if router.ConnectedControllerId != network.nodeId {
+			return
+		}
+		now := time.Now()
+		update.LastDisconnected = &now
+	}
+
+	checker := fields.UpdatedFieldsMap{
+		db.FieldRouterLastDisconnected:      struct{}{},
+		db.FieldRouterConnectedControllerId: struct{}{},
+	}
+
+	if err := network.Routers.Update(update, checker); err != nil {
+		pfxlog.Logger().WithField("routerId", router.Id).WithError(err).Error("unable to record router presence")
+	}
+}
+
+// syncRouterPresence records the presence of all routers whose stored state doesn't match this controller's
+// connections. This claims routers left unclaimed when another controller they're also connected to released them,
+// and releases claims this controller held before it restarted. Claims held by a controller which never comes back
+// aren't released, so its routers are never considered offline.
+func (network *Network) syncRouterPresence() {
+	if !network.offlineTerminators.syncRunning.CompareAndSwap(false, true) {
+		return
+	}
+	defer network.offlineTerminators.syncRunning.Set(false)
+
+	routers, err := network.Routers.BaseList("true limit none")
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to list routers to sync presence")
+		return
+	}
+
+	for _, router := range routers.Entities {
+		network.updateRouterPresence(router)
+	}
+}
@@ -0,0 +1,356 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadOfflineTerminatorOptions(t *testing.T) {
+	options, err := LoadOptions(map[interface{}]interface{}{})
+	assert.NoError(t, err)
+	assert.False(t, options.OfflineTerminators.Enabled)
+	assert.Equal(t, DefaultOfflineTerminatorThreshold, options.OfflineTerminators.Threshold)
+	assert.Equal(t, OfflineTerminatorActionDelete, options.OfflineTerminators.Action)
+
+	options, err = LoadOptions(map[interface{}]interface{}{
+		"offlineTerminators": map[interface{}]interface{}{
+			"enabled":   true,
+			"threshold": "48h",
+			"action":    "fail",
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, options.OfflineTerminators.Enabled)
+	assert.Equal(t, 48*time.Hour, options.OfflineTerminators.Threshold)
+	assert.Equal(t, OfflineTerminatorActionFail, options.OfflineTerminators.Action)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"offlineTerminators": map[interface{}]interface{}{
+			"action": "archive",
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"offlineTerminators": map[interface{}]interface{}{
+			"threshold": "0s",
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestOfflineTerminators(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	online := entityHelper.addTestRouter()
+	recent := entityHelper.addTestRouter()
+	old := entityHelper.addTestRouter()
+	unrecorded := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	newTerminator := func(id string, router *Router) *Terminator {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     router.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+		}
+		req.NoError(network.Terminators.Create(terminator))
+		return terminator
+	}
+
+	newTerminator("online", online)
+	newTerminator("recent", recent)
+	oldTerminator := newTerminator("old", old)
+	newTerminator("unrecorded", unrecorded)
+
+	setOffline := func(router *Router, since time.Time) {
+		network.Routers.markDisconnected(router)
+		router.LastDisconnected = &since
+		req.NoError(network.Routers.Update(router, fields.UpdatedFieldsMap{db.FieldRouterLastDisconnected: struct{}{}}))
+	}
+
+	setOffline(recent, time.Now().Add(-time.Hour))
+	setOffline(old, time.Now().Add(-2*DefaultOfflineTerminatorThreshold))
+	network.Routers.markDisconnected(unrecorded)
+
+	getReportIds := func() []string {
+		report, err := network.GetOfflineTerminatorReport()
+		req.NoError(err)
+		var result []string
+		for _, offline := range report.Terminators {
+			result = append(result, offline.Terminator.Id)
+		}
+		return result
+	}
+
+	// routers without a recorded disconnect are considered offline since the controller started
+	req.Equal([]string{oldTerminator.Id}, getReportIds())
+	network.startTime = time.Now().Add(-2 * DefaultOfflineTerminatorThreshold)
+	req.ElementsMatch([]string{oldTerminator.Id, "unrecorded"}, getReportIds())
+
+	// cleanup only happens when enabled
+	network.processOfflineTerminators()
+	_, err = network.Terminators.Read(oldTerminator.Id)
+	req.NoError(err)
+
+	network.options.OfflineTerminators.Enabled = true
+	network.options.OfflineTerminators.Action = OfflineTerminatorActionFail
+	network.processOfflineTerminators()
+
+	terminator, err := network.Terminators.Read(oldTerminator.Id)
+	req.NoError(err)
+	req.Equal(xt.Precedences.Failed, terminator.Precedence)
+
+	terminator, err = network.Terminators.Read("recent")
+	req.NoError(err)
+	req.NotEqual(xt.Precedences.Failed, terminator.Precedence)
+
+	// failed terminators are no longer reported when failing
+	req.Empty(getReportIds())
+
+	network.options.OfflineTerminators.Action = OfflineTerminatorActionDelete
+	req.ElementsMatch([]string{oldTerminator.Id, "unrecorded"}, getReportIds())
+	network.processOfflineTerminators()
+
+	_, err = network.Terminators.Read(oldTerminator.Id)
+	req.Error(err)
+	_, err = network.Terminators.Read("unrecorded")
+	req.Error(err)
+	_, err = network.Terminators.Read("recent")
+	req.NoError(err)
+	_, err = network.Terminators.Read("online")
+	req.NoError(err)
+	req.Empty(getReportIds())
+
+	// presence is recorded on disconnect and cleared on reconnect
+	network.recordRouterPresence(recent.Id)
+	r, err := network.Routers.readUncached(recent.Id)
+	req.NoError(err)
+	req.NotNil(r.LastDisconnected)
+
+	network.Routers.markConnected(recent)
+	network.recordRouterPresence(recent.Id)
+	r, err = network.Routers.readUncached(recent.Id)
+	req.NoError(err)
+	req.Nil(r.LastDisconnected)
+	req.Equal(network.nodeId, r.ConnectedControllerId)
+
+	network.Routers.markDisconnected(recent)
+	network.recordRouterPresence(recent.Id)
+	r, err = network.Routers.readUncached(recent.Id)
+	req.NoError(err)
+	req.NotNil(r.LastDisconnected)
+	req.Equal("", r.ConnectedControllerId)
+}
+
+func TestOfflineTerminatorsInCluster(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	newController := func(id string, leader bool) *Network {
+		config := newTestConfig(ctx)
+		config.id = id
+		config.dispatcher = newTestDispatcher(leader)
+		config.options.OfflineTerminators.Enabled = true
+		config.options.OfflineTerminators.Action = OfflineTerminatorActionFail
+		t.Cleanup(func() { close(config.closeNotify) })
+
+		network, err := NewNetwork(config)
+		req.NoError(err)
+		return network
+	}
+
+	leader := newController("leader", true)
+	follower := newController("follower", false)
+
+	entityHelper := newTestEntityHelper(ctx, leader)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	for _, r := range []*Router{r0, r1} {
+		req.NoError(leader.Terminators.Create(&Terminator{
+			BaseEntity: models.BaseEntity{Id: r.Id},
+			Service:    svc.Id,
+			Router:     r.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+		}))
+	}
+
+	// both routers are disconnected from the leader, but r0 is still connected to the follower
+	lastDisconnected := time.Now().Add(-2 * DefaultOfflineTerminatorThreshold)
+	for _, r := range []*Router{r0, r1} {
+		leader.Routers.markDisconnected(r)
+		r.LastDisconnected = &lastDisconnected
+		req.NoError(leader.Routers.Update(r, fields.UpdatedFieldsMap{db.FieldRouterLastDisconnected: struct{}{}}))
+	}
+
+	connect := func(network *Network, routerId string) {
+		r, err := network.Routers.readUncached(routerId)
+		req.NoError(err)
+		network.Routers.markConnected(r)
+		network.recordRouterPresence(routerId)
+	}
+
+	disconnect := func(network *Network, routerId string) {
+		r := network.Routers.getConnected(routerId)
+		req.NotNil(r)
+		network.Routers.markDisconnected(r)
+		network.recordRouterPresence(routerId)
+	}
+
+	requireClaim := func(routerId string, controllerId string) {
+		r, err := leader.Routers.readUncached(routerId)
+		req.NoError(err)
+		req.Equal(controllerId, r.ConnectedControllerId)
+		if controllerId == "" {
+			req.NotNil(r.LastDisconnected)
+		} else {
+			req.Nil(r.LastDisconnected)
+		}
+	}
+
+	requirePrecedence := func(id string, precedence xt.Precedence) {
+		terminator, err := leader.Terminators.Read(id)
+		req.NoError(err)
+		req.Equal(precedence, terminator.Precedence)
+	}
+
+	connect(follower, r0.Id)
+	requireClaim(r0.Id, follower.nodeId)
+
+	// only the leader cleans up, and routers connected to any controller aren't offline
+	follower.processOfflineTerminators()
+	requirePrecedence(r0.Id, xt.Precedences.Default)
+	requirePrecedence(r1.Id, xt.Precedences.Default)
+
+	leader.processOfflineTerminators()
+	requirePrecedence(r0.Id, xt.Precedences.Default)
+	requirePrecedence(r1.Id, xt.Precedences.Failed)
+
+	// a router connected to several controllers stays claimed while any of them has it
+	connect(leader, r0.Id)
+	requireClaim(r0.Id, follower.nodeId)
+
+	disconnect(leader, r0.Id)
+	requireClaim(r0.Id, follower.nodeId)
+
+	connect(leader, r0.Id)
+	disconnect(follower, r0.Id)
+	requireClaim(r0.Id, "")
+
+	leader.syncRouterPresence()
+	requireClaim(r0.Id, leader.nodeId)
+
+	// claims held by a controller before it restarted are released once it syncs
+	restarted := newController("leader", true)
+	restarted.syncRouterPresence()
+	requireClaim(r0.Id, "")
+}
+
+func TestOfflineTerminatorsRestoredOnReconnect(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	network.options.OfflineTerminators.Enabled = true
+	network.options.OfflineTerminators.Action = OfflineTerminatorActionFail
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	newTerminator := func(id string, precedence xt.Precedence) {
+		req.NoError(network.Terminators.Create(&Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     r0.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+			Precedence: precedence,
+		}))
+	}
+	newTerminator("required", xt.Precedences.Required)
+	newTerminator("changed", xt.Precedences.Default)
+
+	requirePrecedence := func(id string, precedence xt.Precedence) {
+		terminator, err := network.Terminators.Read(id)
+		req.NoError(err)
+		req.Equal(precedence, terminator.Precedence)
+	}
+
+	network.Routers.markDisconnected(r0)
+	lastDisconnected := time.Now().Add(-2 * DefaultOfflineTerminatorThreshold)
+	r0.LastDisconnected = &lastDisconnected
+	req.NoError(network.Routers.Update(r0, fields.UpdatedFieldsMap{db.FieldRouterLastDisconnected: struct{}{}}))
+
+	network.processOfflineTerminators()
+	requirePrecedence("required", xt.Precedences.Failed)
+	requirePrecedence("changed", xt.Precedences.Failed)
+
+	terminator, err := network.Terminators.Read("required")
+	req.NoError(err)
+	req.Equal(TerminatorFailedByOfflineRouter, terminator.FailedBy)
+
+	// precedence changed by someone else after the terminator was failed is left alone
+	network.Terminators.handlePrecedenceChange("changed", xt.Precedences.Required)
+
+	// the precedence to restore is stored with the terminator, so it's restored after a controller restart
+	restarted, err := NewNetwork(config)
+	req.NoError(err)
+
+	restarted.Routers.markConnected(r0)
+	restarted.restoreOfflineTerminators(r0)
+	requirePrecedence("required", xt.Precedences.Required)
+	requirePrecedence("changed", xt.Precedences.Required)
+
+	// restored terminators aren't restored again on later reconnects
+	restarted.Terminators.handlePrecedenceChange("required", xt.Precedences.Failed)
+	restarted.restoreOfflineTerminators(r0)
+	requirePrecedence("required", xt.Precedences.Failed)
+}
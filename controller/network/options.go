@@ -36,6 +36,7 @@ const (
 	DefaultNetworkOptionsSmartRerouteCap         = 4
 	DefaultNetworkOptionsInitialLinkLatency      = 65 * time.Second
 	DefaultNetworkOptionsMetricsReportInterval   = time.Minute
+	DefaultNetworkOptionsStandbyPathCandidates   = 2
 )
 
 type Options struct {
@@ -43,6 +44,13 @@ type Options struct {
 	Smart        struct {
 		RerouteFraction float32
 		RerouteCap      uint32
+		// MinCostImprovement is the minimum absolute path cost reduction required for a smart reroute
+		MinCostImprovement int64
+		// MinCostImprovementFraction is the minimum path cost reduction, as a fraction of the current cost,
+		// required for a smart reroute
+		MinCostImprovementFraction float32
+		// MinRerouteInterval is the minimum time between reroutes of a circuit for a smart reroute to be considered
+		MinRerouteInterval time.Duration
 	}
 	RouteTimeout            time.Duration
 	CreateCircuitRetries    uint32
@@ -52,6 +60,13 @@ type Options struct {
 	RouterConnectChurnLimit time.Duration
 	InitialLinkLatency      time.Duration
 	MetricsReportInterval   time.Duration
+	LinkCostTags            map[string]*LinkCostTagPolicy
+	LinkFlapDamping         *LinkFlapDampingOptions
+	StandbyPathMode         DisjointPathMode
+	// StandbyPathCandidates is how many disjoint standby paths are computed for a circuit. They're tried in order
+	// of cost until one is installed on all of its routers.
+	StandbyPathCandidates int
+	OfflineTerminators    *OfflineTerminatorOptions
 }
 
 func DefaultOptions() *Options {
@@ -65,6 +80,10 @@ func DefaultOptions() *Options {
 		RouterConnectChurnLimit: DefaultNetworkOptionsRouterConnectChurnLimit,
 		InitialLinkLatency:      DefaultNetworkOptionsInitialLinkLatency,
 		MetricsReportInterval:   DefaultNetworkOptionsMetricsReportInterval,
+		LinkFlapDamping:         DefaultLinkFlapDampingOptions(),
+		StandbyPathMode:         DisjointPathModeNone,
+		StandbyPathCandidates:   DefaultNetworkOptionsStandbyPathCandidates,
+		OfflineTerminators:      DefaultOfflineTerminatorOptions(),
 	}
 	options.Smart.RerouteFraction = DefaultNetworkOptionsSmartRerouteFraction
 	options.Smart.RerouteCap = DefaultNetworkOptionsSmartRerouteCap
@@ -126,6 +145,34 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 					logrus.Errorf("%p", value)
 				}
 			}
+
+			if value, found := submap["minCostImprovement"]; found {
+				if minCostImprovement, ok := value.(int); ok && minCostImprovement >= 0 {
+					options.Smart.MinCostImprovement = int64(minCostImprovement)
+				} else {
+					return nil, errors.New("invalid value for 'smart.minCostImprovement'")
+				}
+			}
+
+			if value, found := submap["minCostImprovementFraction"]; found {
+				if fraction, ok := value.(float64); ok && fraction >= 0 {
+					options.Smart.MinCostImprovementFraction = float32(fraction)
+				} else {
+					return nil, errors.New("invalid value for 'smart.minCostImprovementFraction'")
+				}
+			}
+
+			if value, found := submap["minRerouteInterval"]; found {
+				if sval, ok := value.(string); ok {
+					val, err := time.ParseDuration(sval)
+					if err != nil {
+						return nil, errors.Wrap(err, "invalid value for 'smart.minRerouteInterval'")
+					}
+					options.Smart.MinRerouteInterval = val
+				} else {
+					return nil, errors.New("invalid value for 'smart.minRerouteInterval'")
+				}
+			}
 		} else {
 			logrus.Errorf("invalid or empty 'smart' stanza")
 		}
@@ -186,5 +233,61 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["linkCostTags"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			policies, err := loadLinkCostTagPolicies(submap)
+			if err != nil {
+				return nil, err
+			}
+			options.LinkCostTags = policies
+		} else {
+			return nil, errors.New("invalid value for 'linkCostTags'")
+		}
+	}
+
+	if value, found := src["linkFlapDamping"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			flapDamping, err := loadLinkFlapDampingOptions(submap)
+			if err != nil {
+				return nil, err
+			}
+			options.LinkFlapDamping = flapDamping
+		} else {
+			return nil, errors.New("invalid value for 'linkFlapDamping'")
+		}
+	}
+
+	if value, found := src["standbyPathMode"]; found {
+		if sval, ok := value.(string); ok {
+			mode, err := ParseDisjointPathMode(sval)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for 'standbyPathMode'")
+			}
+			options.StandbyPathMode = mode
+		} else {
+			return nil, errors.New("invalid value for 'standbyPathMode'")
+		}
+	}
+
+	if value, found := src["standbyPathCandidates"]; found {
+		if candidates, ok := value.(int); ok && candidates > 0 {
+			options.StandbyPathCandidates = candidates
+		} else {
+			return nil, errors.New("invalid value for 'standbyPathCandidates', must be an integer greater than zero")
+		}
+	}
+
+	if value, found := src["offlineTerminators"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			offlineTerminators, err := loadOfflineTerminatorOptions(submap)
+			if err != nil {
+				return nil, err
+			}
+			options.OfflineTerminators = offlineTerminators
+		} else {
+			return nil, errors.New("invalid value for 'offlineTerminators'")
+		}
+	}
+
 	return options, nil
 }
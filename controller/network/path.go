@@ -70,6 +70,17 @@ func (self *Path) EqualPath(other *Path) bool {
 	return true
 }
 
+func (self *Path) cost() int64 {
+	var cost int64
+	for _, l := range self.Links {
+		cost += l.GetCost()
+	}
+	for _, r := range self.Nodes {
+		cost += int64(r.Cost)
+	}
+	return cost
+}
+
 func (self *Path) EgressRouter() *Router {
 	if len(self.Nodes) > 0 {
 		return self.Nodes[len(self.Nodes)-1]
@@ -159,6 +170,25 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 	return routeMessages
 }
 
+// CreateStandbyRouteMessages creates the route messages used to pre-install a standby path. The standby path
+// shares the ingress and egress addresses of the primary path. The forwards from those addresses are sent as
+// standby forwards, so the ingress and egress routers only switch to them if the primary link goes away.
+func (self *Path) CreateStandbyRouteMessages(circuitId string, deadline time.Time) []*ctrl_pb.Route {
+	routeMessages := self.CreateRouteMessages(SmartRerouteAttempt, circuitId, nil, deadline)
+	for _, routeMessage := range routeMessages {
+		var forwards []*ctrl_pb.Route_Forward
+		for _, forward := range routeMessage.Forwards {
+			if forward.SrcAddress == self.IngressId || forward.SrcAddress == self.EgressId {
+				routeMessage.StandbyForwards = append(routeMessage.StandbyForwards, forward)
+			} else {
+				forwards = append(forwards, forward)
+			}
+		}
+		routeMessage.Forwards = forwards
+	}
+	return routeMessages
+}
+
 func (self *Path) usesLink(l *Link) bool {
 	if self.Links != nil {
 		for _, o := range self.Links {
@@ -170,7 +200,17 @@ func (self *Path) usesLink(l *Link) bool {
 	return false
 }
 
+// routerFilter returns true if the given router may be used as an intermediate hop in a path
+type routerFilter func(r *Router) bool
+
+// hopFilter returns true if a path may go directly from one router to the other
+type hopFilter func(from, to *Router) bool
+
 func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	return network.shortestPathFiltered(srcR, dstR, nil, nil)
+}
+
+func (network *Network) shortestPathFiltered(srcR *Router, dstR *Router, filter routerFilter, hops hopFilter) ([]*Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
@@ -179,84 +219,71 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 		return []*Router{srcR}, 0, nil
 	}
 
-	dist := make(map[*Router]int64)
-	prev := make(map[*Router]*Router)
-	unvisited := make(map[*Router]bool)
+	return network.shortestPathTree(srcR, dstR, filter, hops).pathTo(dstR)
+}
 
-	for _, r := range network.Routers.allConnected() {
-		dist[r] = math.MaxInt32
-		unvisited[r] = true
+// shortestPathWithMaxLinks finds the least expensive path from srcR to dstR which uses at most maxLinks links.
+// It runs a hop-bounded Bellman-Ford, where each iteration extends the known paths by a single link.
+func (network *Network) shortestPathWithMaxLinks(srcR *Router, dstR *Router, filter routerFilter, hops hopFilter, maxLinks int) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	if srcR == dstR {
+		return []*Router{srcR}, 0, nil
 	}
-	dist[srcR] = 0
 
 	minRouterCost := network.options.MinRouterCost
 
-	for len(unvisited) > 0 {
-		u := minCost(unvisited, dist)
-		if u == dstR { // if the dest router is the lowest cost next link, we can stop evaluating
-			break
-		}
-		delete(unvisited, u)
-
-		neighbors := network.linkController.connectedNeighborsOfRouter(u)
-		for _, r := range neighbors {
-			if _, found := unvisited[r]; found {
-				var cost int64 = math.MaxInt32 + 1
-				if l, found := network.linkController.leastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
-						cost = l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
-					}
-				}
+	current := map[*Router]int64{srcR: 0}
+	var prevByHop []map[*Router]*Router
 
-				alt := dist[u] + cost
-				if alt < dist[r] {
-					dist[r] = alt
+	bestCost := int64(math.MaxInt64)
+	bestHops := 0
+
+	for hop := 1; hop <= maxLinks && len(current) > 0; hop++ {
+		next := map[*Router]int64{}
+		prev := map[*Router]*Router{}
+
+		for u, uCost := range current {
+			if u == dstR || (u != srcR && !u.isTransitAllowed()) {
+				continue
+			}
+			for _, r := range network.linkController.connectedNeighborsOfRouter(u) {
+				if r == srcR || (r != dstR && filter != nil && !filter(r)) || (hops != nil && !hops(u, r)) {
+					continue
+				}
+				l, found := network.linkController.leastExpensiveLink(r, u)
+				if !found {
+					continue
+				}
+				alt := uCost + l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+				if existing, found := next[r]; !found || alt < existing {
+					next[r] = alt
 					prev[r] = u
 				}
 			}
 		}
-	}
 
-	/*
-	 * dist: (r2->r1->r0)
-	 *		r0 = 2 <- r1
-	 *		r1 = 1 <- r2
-	 *		r2 = 0 <- nil
-	 */
-
-	routerPath := make([]*Router, 0)
-	p := prev[dstR]
-	for p != nil {
-		routerPath = append([]*Router{p}, routerPath...)
-		p = prev[p]
+		prevByHop = append(prevByHop, prev)
+		if cost, found := next[dstR]; found && cost < bestCost {
+			bestCost = cost
+			bestHops = hop
+		}
+		current = next
 	}
-	routerPath = append(routerPath, dstR)
 
-	if routerPath[0] != srcR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v. source unreachable", srcR.Id, dstR.Id)
-	}
-	if routerPath[len(routerPath)-1] != dstR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", srcR.Id, dstR.Id)
+	if bestHops == 0 {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v using at most %v links", srcR.Id, dstR.Id, maxLinks)
 	}
 
-	return routerPath, dist[dstR], nil
-}
-
-func minCost(q map[*Router]bool, dist map[*Router]int64) *Router {
-	if dist == nil || len(dist) < 1 {
-		return nil
+	routerPath := make([]*Router, bestHops+1)
+	routerPath[bestHops] = dstR
+	for hop := bestHops; hop > 0; hop-- {
+		routerPath[hop-1] = prevByHop[hop-1][routerPath[hop]]
 	}
 
-	min := int64(math.MaxInt64)
-	var selected *Router
-	for r := range q {
-		d := dist[r]
-		if d <= min {
-			selected = r
-			min = d
-		}
-	}
-	return selected
+	return routerPath, bestCost, nil
 }
 
 func maxUint16(v1, v2 uint16) uint16 {
@@ -0,0 +1,217 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"container/heap"
+	"fmt"
+	"github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+	"sync/atomic"
+)
+
+// topologyVersion is incremented whenever something changes which may affect path selection, such as routers
+// connecting or disconnecting, links being added or removed, or link and router costs changing.
+type topologyVersion struct {
+	version int64
+}
+
+func (self *topologyVersion) changed() {
+	if self != nil {
+		atomic.AddInt64(&self.version, 1)
+	}
+}
+
+func (self *topologyVersion) get() int64 {
+	return atomic.LoadInt64(&self.version)
+}
+
+// shortestPathTree holds the result of a Dijkstra search from a single source router
+type shortestPathTree struct {
+	version int64
+	src     *Router
+	dist    map[*Router]int64
+	prev    map[*Router]*Router
+}
+
+func (self *shortestPathTree) pathTo(dstR *Router) ([]*Router, int64, error) {
+	cost, found := self.dist[dstR]
+	if !found {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", self.src.Id, dstR.Id)
+	}
+
+	routerPath := []*Router{dstR}
+	for p := self.prev[dstR]; p != nil; p = self.prev[p] {
+		routerPath = append(routerPath, p)
+	}
+
+	for i, j := 0, len(routerPath)-1; i < j; i, j = i+1, j-1 {
+		routerPath[i], routerPath[j] = routerPath[j], routerPath[i]
+	}
+
+	if routerPath[0] != self.src {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. source unreachable", self.src.Id, dstR.Id)
+	}
+
+	return routerPath, cost, nil
+}
+
+type routerCost struct {
+	router *Router
+	cost   int64
+}
+
+type routerCostHeap []routerCost
+
+func (self routerCostHeap) Len() int {
+	return len(self)
+}
+
+func (self routerCostHeap) Less(i, j int) bool {
+	return self[i].cost < self[j].cost
+}
+
+func (self routerCostHeap) Swap(i, j int) {
+	self[i], self[j] = self[j], self[i]
+}
+
+func (self *routerCostHeap) Push(x interface{}) {
+	*self = append(*self, x.(routerCost))
+}
+
+func (self *routerCostHeap) Pop() interface{} {
+	old := *self
+	n := len(old)
+	result := old[n-1]
+	*self = old[:n-1]
+	return result
+}
+
+// shortestPathTree runs Dijkstra's algorithm from srcR, using a binary heap as the priority queue. If dstR is
+// not nil, the search stops as soon as the least expensive path to dstR is known, otherwise paths to all
+// reachable routers are computed. Routers marked as no-traversal may be the end of a path, but paths may not
+// pass through them.
+func (network *Network) shortestPathTree(srcR, dstR *Router, filter routerFilter, hops hopFilter) *shortestPathTree {
+	tree := &shortestPathTree{
+		src:  srcR,
+		dist: map[*Router]int64{srcR: 0},
+		prev: map[*Router]*Router{},
+	}
+
+	candidates := map[*Router]bool{}
+	for _, r := range network.Routers.allConnected() {
+		if filter == nil || r == srcR || r == dstR || filter(r) {
+			candidates[r] = true
+		}
+	}
+
+	minRouterCost := network.options.MinRouterCost
+	visited := map[*Router]bool{}
+	queue := &routerCostHeap{{router: srcR, cost: 0}}
+
+	for queue.Len() > 0 {
+		next := heap.Pop(queue).(routerCost)
+		u := next.router
+		if visited[u] || next.cost > tree.dist[u] {
+			continue
+		}
+		visited[u] = true
+
+		if u == dstR {
+			break
+		}
+
+		if u != srcR && !u.isTransitAllowed() {
+			continue
+		}
+
+		// relaxing over every usable link is equivalent to relaxing over the least expensive link to each neighbor,
+		// and avoids building a neighbor set for every router visited
+		for _, l := range u.routerLinks.GetLinks() {
+			if !l.IsUsable() || l.IsExcludedByCostTags() {
+				continue
+			}
+
+			r := l.Dst
+			if r == u {
+				r = l.Src
+			}
+
+			if visited[r] || !candidates[r] || (hops != nil && !hops(u, r)) {
+				continue
+			}
+
+			alt := next.cost + l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+			if current, found := tree.dist[r]; !found || alt < current {
+				tree.dist[r] = alt
+				tree.prev[r] = u
+				heap.Push(queue, routerCost{router: r, cost: alt})
+			}
+		}
+	}
+
+	return tree
+}
+
+// pathCache holds complete shortest path trees, keyed by source router id. A tree is only used while the
+// topology version it was computed at is current.
+type pathCache struct {
+	topology *topologyVersion
+	trees    cmap.ConcurrentMap[*shortestPathTree]
+}
+
+func newPathCache(topology *topologyVersion) *pathCache {
+	return &pathCache{
+		topology: topology,
+		trees:    cmap.New[*shortestPathTree](),
+	}
+}
+
+func (self *pathCache) get(srcR *Router) (*shortestPathTree, int64) {
+	version := self.topology.get()
+	if tree, found := self.trees.Get(srcR.Id); found && tree.version == version && tree.src == srcR {
+		return tree, version
+	}
+	return nil, version
+}
+
+func (self *pathCache) put(tree *shortestPathTree) {
+	self.trees.Set(tree.src.Id, tree)
+}
+
+// cachedShortestPath returns the same result as shortestPath, but reuses the shortest path tree for the
+// source router as long as the topology hasn't changed since it was computed
+func (network *Network) cachedShortestPath(srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	if srcR == dstR {
+		return []*Router{srcR}, 0, nil
+	}
+
+	tree, version := network.pathCache.get(srcR)
+	if tree == nil {
+		tree = network.shortestPathTree(srcR, nil, nil, nil)
+		// if the topology changes while the tree is being computed, the tree will be tagged with the older
+		// version and will be recomputed on the next lookup
+		tree.version = version
+		network.pathCache.put(tree)
+	}
+
+	return tree.pathTo(dstR)
+}
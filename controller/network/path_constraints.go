@@ -0,0 +1,143 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+// pathConstraints captures the routing policy of a service. Paths for circuits on the service must only use
+// allowed routers, must traverse the waypoint routers in order and, if maxLinks is set, must not use more
+// than maxLinks links.
+type pathConstraints struct {
+	allowedRouters []string
+	deniedRouters  []string
+	maxLinks       int
+	waypoints      []string
+}
+
+func newPathConstraints(svc *Service) *pathConstraints {
+	if svc == nil {
+		return nil
+	}
+	if len(svc.AllowedRouters) == 0 && len(svc.DeniedRouters) == 0 && svc.MaxLinkCount == 0 && len(svc.WaypointRouters) == 0 {
+		return nil
+	}
+	return &pathConstraints{
+		allowedRouters: svc.AllowedRouters,
+		deniedRouters:  svc.DeniedRouters,
+		maxLinks:       int(svc.MaxLinkCount),
+		waypoints:      svc.WaypointRouters,
+	}
+}
+
+func (self *pathConstraints) allows(r *Router) bool {
+	if len(self.allowedRouters) > 0 && !RouterMatchesAnySelector(r, self.allowedRouters) {
+		return false
+	}
+	return !RouterMatchesAnySelector(r, self.deniedRouters)
+}
+
+// shortestPathForService finds the least expensive path between the given routers which satisfies the
+// routing policy of the given service, if any. If the service has a pinned path, that path is used. If the pinned
+// path isn't available, normal routing is only used if the service allows fallback.
+func (network *Network) shortestPathForService(svc *Service, srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	if svc != nil && len(svc.PinnedPath) > 0 {
+		path, cost, err := network.pinnedPath(srcR, dstR, svc.PinnedPath)
+		if err == nil || !svc.PinnedPathFallback {
+			return path, cost, err
+		}
+		pfxlog.Logger().WithField("serviceId", svc.Id).WithError(err).Debug("pinned path not available, falling back to normal routing")
+	}
+
+	constraints := newPathConstraints(svc)
+	if constraints == nil {
+		return network.cachedShortestPath(srcR, dstR)
+	}
+	return network.constrainedShortestPath(srcR, dstR, constraints)
+}
+
+func (network *Network) constrainedShortestPath(srcR *Router, dstR *Router, constraints *pathConstraints) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	stops := []*Router{srcR}
+	for _, waypointId := range constraints.waypoints {
+		waypoint := network.Routers.getConnected(waypointId)
+		if waypoint == nil {
+			return nil, 0, errors.Errorf("can't route from %v -> %v. waypoint router %v is not connected", srcR.Id, dstR.Id, waypointId)
+		}
+		stops = append(stops, waypoint)
+	}
+	stops = append(stops, dstR)
+
+	isStop := map[*Router]bool{}
+	for _, stop := range stops {
+		if !constraints.allows(stop) {
+			return nil, 0, errors.Errorf("can't route from %v -> %v. router %v is not permitted by service routing policy", srcR.Id, dstR.Id, stop.Id)
+		}
+		isStop[stop] = true
+	}
+
+	used := map[*Router]bool{}
+	var routerPath []*Router
+	var totalCost int64
+
+	for i := 0; i < len(stops)-1; i++ {
+		segmentSrc, segmentDst := stops[i], stops[i+1]
+		if segmentSrc != segmentDst && used[segmentDst] {
+			return nil, 0, errors.Errorf("can't route from %v -> %v. waypoint router %v would be visited twice", srcR.Id, dstR.Id, segmentDst.Id)
+		}
+
+		// intermediate routers may not be visited twice and may not be one of the stops
+		filter := func(r *Router) bool {
+			return !used[r] && !isStop[r] && constraints.allows(r)
+		}
+
+		var segment []*Router
+		var cost int64
+		var err error
+
+		if constraints.maxLinks > 0 {
+			linksUsed := 0
+			if len(routerPath) > 0 {
+				linksUsed = len(routerPath) - 1
+			}
+			segment, cost, err = network.shortestPathWithMaxLinks(segmentSrc, segmentDst, filter, nil, constraints.maxLinks-linksUsed)
+		} else {
+			segment, cost, err = network.shortestPathFiltered(segmentSrc, segmentDst, filter, nil)
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if len(routerPath) > 0 {
+			segment = segment[1:]
+		}
+
+		for _, r := range segment {
+			used[r] = true
+		}
+		routerPath = append(routerPath, segment...)
+		totalCost += cost
+	}
+
+	return routerPath, totalCost, nil
+}
@@ -17,6 +17,7 @@
 package network
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -659,3 +660,303 @@ func newPathTestLink(network *Network, id string, srcR, destR *Router) *Link {
 	network.linkController.add(l)
 	return l
 }
+
+func TestServicePathConstraints(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 100, false)
+	r1.RoleAttributes = []string{"transit"}
+	network.Routers.markConnected(r1)
+
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 200, false)
+	r2.Tags = map[string]interface{}{"region": "eu"}
+	network.Routers.markConnected(r2)
+
+	r3 := newRouterForTest("r3", "", transportAddr, nil, 20, false)
+	network.Routers.markConnected(r3)
+
+	r4 := newRouterForTest("r4", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r4)
+
+	r5 := newRouterForTest("r5", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r5)
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r3)
+	newPathTestLink(network, "l2", r0, r2)
+	newPathTestLink(network, "l3", r2, r3)
+	newPathTestLink(network, "l4", r0, r4)
+	newPathTestLink(network, "l5", r4, r5)
+	newPathTestLink(network, "l6", r5, r3)
+
+	pathIds := func(path []*Router) []string {
+		var result []string
+		for _, r := range path {
+			result = append(result, r.Id)
+		}
+		return result
+	}
+
+	svc := &Service{}
+	path, cost, err := network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r4", "r5", "r3"}, pathIds(path))
+	req.Equal(int64(25), cost)
+
+	svc.MaxLinkCount = 2
+	path, cost, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+	req.Equal(int64(122), cost)
+
+	svc.DeniedRouters = []string{"@r1"}
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, pathIds(path))
+
+	svc.DeniedRouters = []string{"@r1", "#region=eu"}
+	_, _, err = network.shortestPathForService(svc, r0, r3)
+	req.Error(err)
+
+	svc.MaxLinkCount = 0
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r4", "r5", "r3"}, pathIds(path))
+
+	svc.DeniedRouters = nil
+	svc.AllowedRouters = []string{"r0", "r3", "#region"}
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, pathIds(path))
+
+	svc.AllowedRouters = []string{"r0", "r3", "#transit"}
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+
+	svc.AllowedRouters = []string{"r0", "r3", "#transit=true"}
+	_, _, err = network.shortestPathForService(svc, r0, r3)
+	req.Error(err)
+
+	svc.AllowedRouters = nil
+	svc.WaypointRouters = []string{"r1"}
+	path, cost, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+	req.Equal(int64(122), cost)
+
+	svc.WaypointRouters = []string{"r1", "r5"}
+	_, _, err = network.shortestPathForService(svc, r0, r3)
+	req.Error(err)
+
+	svc.WaypointRouters = []string{"r5"}
+	svc.MaxLinkCount = 2
+	_, _, err = network.shortestPathForService(svc, r0, r3)
+	req.Error(err)
+}
+
+func TestDisjointPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	var routers []*Router
+	for i := 0; i < 7; i++ {
+		r := newRouterForTest(fmt.Sprintf("r%v", i), "", transportAddr, nil, 0, false)
+		network.Routers.markConnected(r)
+		routers = append(routers, r)
+	}
+	routers[5].Cost = 100
+
+	newPathTestLink(network, "l0", routers[0], routers[1])
+	newPathTestLink(network, "l1", routers[1], routers[3])
+	newPathTestLink(network, "l2", routers[0], routers[2])
+	newPathTestLink(network, "l3", routers[2], routers[1])
+	newPathTestLink(network, "l4", routers[1], routers[4])
+	newPathTestLink(network, "l5", routers[4], routers[3])
+	newPathTestLink(network, "l6", routers[0], routers[5])
+	newPathTestLink(network, "l7", routers[5], routers[6])
+	newPathTestLink(network, "l8", routers[6], routers[3])
+
+	pathIds := func(path *Path) []string {
+		var result []string
+		for _, r := range path.Nodes {
+			result = append(result, r.Id)
+		}
+		return result
+	}
+
+	paths, err := network.DisjointPaths(routers[0], routers[3], 5, DisjointPathModeLink)
+	req.NoError(err)
+	req.Len(paths, 3)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(paths[0]))
+	req.Equal([]string{"r0", "r2", "r1", "r4", "r3"}, pathIds(paths[1]))
+	req.Equal([]string{"r0", "r5", "r6", "r3"}, pathIds(paths[2]))
+
+	paths, err = network.DisjointPaths(routers[0], routers[3], 5, DisjointPathModeNode)
+	req.NoError(err)
+	req.Len(paths, 2)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(paths[0]))
+	req.Equal([]string{"r0", "r5", "r6", "r3"}, pathIds(paths[1]))
+
+	primary, err := network.CreatePath(routers[0], routers[3])
+	req.NoError(err)
+
+	_, err = network.standbyPathsForService(nil, primary)
+	req.Error(err)
+
+	network.options.StandbyPathMode = DisjointPathModeNode
+	candidates, err := network.standbyPathsForService(nil, primary)
+	req.NoError(err)
+	req.Len(candidates, 1)
+	standby := candidates[0]
+	req.Equal([]string{"r0", "r5", "r6", "r3"}, pathIds(standby))
+	req.Equal(primary.IngressId, standby.IngressId)
+	req.Equal(primary.EgressId, standby.EgressId)
+
+	rms := standby.CreateStandbyRouteMessages("c0", time.Now().Add(time.Second))
+	req.Len(rms, 4)
+
+	// ingress
+	req.Len(rms[0].Forwards, 1)
+	req.Equal("l6", rms[0].Forwards[0].SrcAddress)
+	req.Equal(standby.IngressId, rms[0].Forwards[0].DstAddress)
+	req.Len(rms[0].StandbyForwards, 1)
+	req.Equal(standby.IngressId, rms[0].StandbyForwards[0].SrcAddress)
+	req.Equal("l6", rms[0].StandbyForwards[0].DstAddress)
+
+	// transit
+	req.Len(rms[1].Forwards, 2)
+	req.Len(rms[1].StandbyForwards, 0)
+
+	// egress
+	req.Nil(rms[3].Egress)
+	req.Len(rms[3].Forwards, 1)
+	req.Equal("l8", rms[3].Forwards[0].SrcAddress)
+	req.Equal(standby.EgressId, rms[3].Forwards[0].DstAddress)
+	req.Len(rms[3].StandbyForwards, 1)
+	req.Equal(standby.EgressId, rms[3].StandbyForwards[0].SrcAddress)
+	req.Equal("l8", rms[3].StandbyForwards[0].DstAddress)
+
+	// link disjoint standby paths may share routers, so there's more than one candidate
+	network.options.StandbyPathMode = DisjointPathModeLink
+	candidates, err = network.standbyPathsForService(nil, primary)
+	req.NoError(err)
+	req.Len(candidates, 2)
+	req.Equal([]string{"r0", "r2", "r1", "r4", "r3"}, pathIds(candidates[0]))
+	req.Equal([]string{"r0", "r5", "r6", "r3"}, pathIds(candidates[1]))
+
+	network.options.StandbyPathCandidates = 1
+	candidates, err = network.standbyPathsForService(nil, primary)
+	req.NoError(err)
+	req.Len(candidates, 1)
+}
+
+func TestCachedShortestPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	addr := "tcp:0.0.0.0:0"
+	transportAddr, err := tcp.AddressParser{}.Parse(addr)
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r1)
+
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r2)
+
+	r3 := newRouterForTest("r3", "", transportAddr, nil, 0, true)
+	network.Routers.markConnected(r3)
+
+	l0 := newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r2)
+	l2 := newPathTestLink(network, "l2", r0, r2)
+	newPathTestLink(network, "l3", r1, r3)
+
+	l2.SetStaticCost(100)
+
+	path, cost, err := network.cachedShortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r2}, path)
+	req.Equal(int64(2), cost)
+
+	tree, _ := network.pathCache.get(r0)
+	req.NotNil(tree)
+
+	path, cost, err = network.cachedShortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+	req.Equal(int64(2), cost)
+
+	cachedTree, _ := network.pathCache.get(r0)
+	req.True(tree == cachedTree)
+
+	// no traversal routers may terminate paths, but not be passed through
+	_, _, err = network.cachedShortestPath(r3, r2)
+	req.NoError(err)
+
+	// cost changes invalidate the cache
+	l0.SetStaticCost(100)
+	tree, _ = network.pathCache.get(r0)
+	req.Nil(tree)
+
+	path, cost, err = network.cachedShortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2}, path)
+	req.Equal(int64(100), cost)
+
+	uncachedPath, uncachedCost, err := network.shortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal(uncachedPath, path)
+	req.Equal(uncachedCost, cost)
+
+	// removing links invalidates the cache
+	network.linkController.remove(l2)
+	path, _, err = network.cachedShortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r2}, path)
+
+	// disconnecting routers invalidates the cache
+	network.Routers.markDisconnected(r1)
+	_, _, err = network.cachedShortestPath(r0, r2)
+	req.Error(err)
+}
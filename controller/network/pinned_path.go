@@ -0,0 +1,148 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/pkg/errors"
+)
+
+// pinnedPath builds a path from srcR to dstR which visits exactly the given routers, in order. The source and
+// destination routers may be included at the start and end of the pinned routers, but don't need to be. Each
+// consecutive pair of routers must be connected by a usable link.
+func (network *Network) pinnedPath(srcR, dstR *Router, routerIds []string) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	path := []*Router{srcR}
+	for idx, routerId := range routerIds {
+		if (idx == 0 && routerId == srcR.Id) || (idx == len(routerIds)-1 && routerId == dstR.Id) {
+			continue
+		}
+		r := network.Routers.getConnected(routerId)
+		if r == nil {
+			return nil, 0, errors.Errorf("pinned path router %v is not connected", routerId)
+		}
+		path = append(path, r)
+	}
+	if dstR != srcR || len(path) > 1 {
+		path = append(path, dstR)
+	}
+
+	visited := map[*Router]struct{}{}
+	minRouterCost := network.options.MinRouterCost
+	var cost int64
+	for idx, r := range path {
+		if _, found := visited[r]; found {
+			return nil, 0, errors.Errorf("pinned path visits router %v more than once", r.Id)
+		}
+		visited[r] = struct{}{}
+
+		if idx == 0 {
+			continue
+		}
+
+		prev := path[idx-1]
+		link, found := network.linkController.leastExpensiveLink(prev, r)
+		if !found {
+			return nil, 0, errors.Errorf("pinned path has no usable link from r/%v to r/%v", prev.Id, r.Id)
+		}
+		cost += link.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+	}
+
+	return path, cost, nil
+}
+
+// PinCircuitPath moves the circuit onto a path which visits the given routers in order. The circuit's initiating and
+// terminating routers don't change, and may be omitted from the router list. If pin is true, the circuit is exempt
+// from smart rerouting until it's unpinned. If the router list is empty and pin is false, the circuit is unpinned and
+// left on its current path.
+func (network *Network) PinCircuitPath(circuitId string, routerIds []string, pin bool) (*Circuit, error) {
+	circuit, found := network.circuitController.get(circuitId)
+	if !found {
+		return nil, InvalidCircuitError{circuitId: circuitId}
+	}
+
+	if len(routerIds) == 0 {
+		if pin {
+			return nil, errorz.NewFieldError("a path is required to pin a circuit", "path", routerIds)
+		}
+		circuit.Pinned.Set(false)
+		return circuit, nil
+	}
+
+	if !circuit.Rerouting.CompareAndSwap(false, true) {
+		return nil, errors.Errorf("circuit %v is already being rerouted", circuit.Id)
+	}
+
+	oldPath := circuit.Path
+	if err := network.routeExplicitPath(circuit, routerIds, pin); err != nil {
+		circuit.Rerouting.Set(false)
+		if circuit.Path != oldPath {
+			// some routers may have been updated, so make sure the circuit ends up on a consistent path
+			go network.rerouteCircuitWithTries(circuit, DefaultNetworkOptionsCreateCircuitRetries, RerouteReasonManual)
+		}
+		return nil, err
+	}
+	circuit.Rerouting.Set(false)
+
+	pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("path", circuit.Path).WithField("pinned", pin).
+		Info("moved circuit to explicit path")
+	network.CircuitRerouteEvent(circuit, oldPath, RerouteReasonManual)
+
+	return circuit, nil
+}
+
+func (network *Network) routeExplicitPath(circuit *Circuit, routerIds []string, pin bool) error {
+	oldPath := circuit.Path
+	srcR := oldPath.Nodes[0]
+	dstR := oldPath.Nodes[len(oldPath.Nodes)-1]
+
+	nodes, _, err := network.pinnedPath(srcR, dstR, routerIds)
+	if err != nil {
+		return errorz.NewFieldError(err.Error(), "path", routerIds)
+	}
+
+	path := &Path{
+		Nodes:     nodes,
+		IngressId: oldPath.IngressId,
+		EgressId:  oldPath.EgressId,
+	}
+	if err = network.setLinks(path); err != nil {
+		return err
+	}
+
+	circuit.Path = path
+
+	rms := path.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, time.Now().Add(network.options.RouteTimeout))
+	circuit.addCircuitInfo(rms)
+
+	for i := 0; i < len(path.Nodes); i++ {
+		if _, err = sendRoute(path.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+			return errors.Wrapf(err, "error routing circuit %v on router %v", circuit.Id, path.Nodes[i].Id)
+		}
+	}
+
+	network.updateStandbyPath(circuit)
+	circuit.markRerouted()
+	circuit.Pinned.Set(pin)
+	return nil
+}
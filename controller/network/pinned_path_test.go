@@ -0,0 +1,163 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicePinnedPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 100, false)
+	network.Routers.markConnected(r1)
+
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r2)
+
+	r3 := newRouterForTest("r3", "", transportAddr, nil, 20, false)
+	network.Routers.markConnected(r3)
+
+	r4 := newRouterForTest("r4", "", transportAddr, nil, 1, false)
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r3)
+	newPathTestLink(network, "l2", r0, r2)
+	l3 := newPathTestLink(network, "l3", r2, r3)
+
+	pathIds := func(path []*Router) []string {
+		var result []string
+		for _, r := range path {
+			result = append(result, r.Id)
+		}
+		return result
+	}
+
+	svc := &Service{}
+	path, _, err := network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, pathIds(path))
+
+	// the pinned path is used even though it's more expensive
+	svc.PinnedPath = []string{"r1"}
+	path, cost, err := network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+	req.Equal(int64(122), cost)
+
+	// source and destination routers may be included
+	svc.PinnedPath = []string{"r0", "r1", "r3"}
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+
+	// routers which aren't connected, aren't linked or are repeated can't be used
+	for _, pinned := range [][]string{{r4.Id}, {"r1", "r2"}, {"r1", "r0", "r1"}} {
+		svc.PinnedPath = pinned
+		_, _, err = network.shortestPathForService(svc, r0, r3)
+		req.Error(err)
+	}
+
+	// with fallback enabled, normal routing is used if the pinned path isn't available
+	svc.PinnedPath = []string{r4.Id}
+	svc.PinnedPathFallback = true
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, pathIds(path))
+
+	svc.PinnedPath = []string{"r2"}
+	svc.PinnedPathFallback = false
+	l3.SetDown(true)
+	_, _, err = network.shortestPathForService(svc, r0, r3)
+	req.Error(err)
+
+	svc.PinnedPathFallback = true
+	path, _, err = network.shortestPathForService(svc, r0, r3)
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, pathIds(path))
+}
+
+func TestPinCircuitPathValidation(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+
+	l0 := newPathTestLink(network, "l0", r0, r2)
+
+	circuit := &Circuit{
+		Id:   "c0",
+		Path: &Path{Nodes: []*Router{r0, r2}, Links: []*Link{l0}},
+	}
+	network.circuitController.add(circuit)
+
+	_, err = network.PinCircuitPath("c1", []string{r1.Id}, true)
+	req.ErrorAs(err, &InvalidCircuitError{})
+
+	_, err = network.PinCircuitPath(circuit.Id, nil, true)
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+
+	// r1 has no links, so the circuit can't be moved and is left where it was
+	_, err = network.PinCircuitPath(circuit.Id, []string{r1.Id}, true)
+	req.ErrorAs(err, &fieldErr)
+	req.Equal([]*Router{r0, r2}, circuit.Path.Nodes)
+	req.False(circuit.Pinned.Get())
+	req.False(circuit.Rerouting.Get())
+
+	circuit.Pinned.Set(true)
+	result, err := network.PinCircuitPath(circuit.Id, nil, false)
+	req.NoError(err)
+	req.Equal(circuit, result)
+	req.False(circuit.Pinned.Get())
+
+	// moving a circuit clears the pin
+	circuit.Pinned.Set(true)
+	circuit.markRerouted()
+	req.False(circuit.Pinned.Get())
+}
@@ -318,6 +318,89 @@ func BenchmarkShortestPathPerf(b *testing.B) {
 	}
 }
 
+func BenchmarkCachedShortestPathPerf(b *testing.B) {
+	b.StopTimer()
+	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
+
+	ctx := db.NewTestContext(b)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	var routers []*Router
+
+	for i := 0; i < 400; i++ {
+		router := entityHelper.addTestRouter()
+		routers = append(routers, router)
+	}
+
+	linkIdx := 0
+
+	r := rand.New(rand.NewSource(1))
+
+	nextCost := func() int64 {
+		v := r.Uint32()
+		return int64(v % 1000)
+	}
+
+	var links []*Link
+
+	addLink := func(srcRouter, dstRouter *Router) {
+		if srcRouter != dstRouter {
+			link := newTestLink(fmt.Sprintf("link-%04d", linkIdx), "tls")
+			link.SetStaticCost(int32(nextCost()))
+			link.SetDstLatency(nextCost() * 100_000)
+			link.SetSrcLatency(nextCost() * 100_000)
+			link.Src = srcRouter
+			link.Dst = dstRouter
+			link.addState(newLinkState(Connected))
+			network.linkController.add(link)
+			links = append(links, link)
+			linkIdx++
+		}
+	}
+
+	for _, srcRouter := range routers {
+		for _, dstRouter := range routers {
+			addLink(srcRouter, dstRouter)
+		}
+	}
+
+	b.StartTimer()
+	srcIndex := 0
+	dstIndex := 1
+	for i := 0; i < b.N; i++ {
+		srcRouter := routers[srcIndex]
+		dstRouter := routers[dstIndex]
+		_, _, err := network.cachedShortestPath(srcRouter, dstRouter)
+		ctx.NoError(err)
+
+		// simulate a link cost change every 1000 lookups, which invalidates the cache
+		if i%1000 == 999 {
+			link := links[r.Intn(len(links))]
+			link.SetStaticCost(int32(nextCost()))
+		}
+
+		dstIndex++
+		for dstIndex >= len(routers) {
+			srcIndex++
+			if srcIndex >= len(routers) {
+				srcIndex = 0
+			}
+			dstIndex = 0
+			if dstIndex == srcIndex {
+				dstIndex++
+			}
+		}
+	}
+}
+
 func BenchmarkMoreRealisticShortestPathPerf(b *testing.B) {
 	//b.StopTimer()
 	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
@@ -0,0 +1,205 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+	"sort"
+)
+
+type TerminatorSkipReason string
+
+const (
+	TerminatorSkipInstanceMismatch TerminatorSkipReason = "INSTANCE_MISMATCH"
+	TerminatorSkipRouterOffline    TerminatorSkipReason = "ROUTER_OFFLINE"
+	TerminatorSkipNoPath           TerminatorSkipReason = "NO_PATH"
+	TerminatorSkipDraining         TerminatorSkipReason = "DRAINING"
+)
+
+// TerminatorCandidate records how a single terminator was evaluated during terminator selection. Terminators
+// which can't be used have a SkipReason and an Err describing why, all others have a RoutingTerminator.
+type TerminatorCandidate struct {
+	Terminator  *Terminator
+	Path        []*Router
+	PathCost    uint32
+	DynamicCost uint16
+	Routing     *RoutingTerminator
+	SkipReason  TerminatorSkipReason
+	Err         error
+}
+
+// evaluateTerminators computes the route cost of each terminator of the service which matches the given instance
+// id. The returned candidates are in the same order as the service terminators.
+func (network *Network) evaluateTerminators(srcR *Router, svc *Service, instanceId string) []*TerminatorCandidate {
+	type routerPath struct {
+		*PathAndCost
+		err error
+	}
+
+	var result []*TerminatorCandidate
+	paths := map[string]*routerPath{}
+
+	for _, terminator := range svc.Terminators {
+		candidate := &TerminatorCandidate{Terminator: terminator}
+		result = append(result, candidate)
+
+		if terminator.InstanceId != instanceId {
+			candidate.SkipReason = TerminatorSkipInstanceMismatch
+			candidate.Err = errors.Errorf("terminator with id=%v has instanceId %v, not %v", terminator.Id, terminator.InstanceId, instanceId)
+			continue
+		}
+
+		if terminator.Draining {
+			candidate.SkipReason = TerminatorSkipDraining
+			candidate.Err = errors.Errorf("terminator with id=%v is draining", terminator.Id)
+			continue
+		}
+
+		dstR := network.Routers.getConnected(terminator.GetRouterId())
+		if dstR == nil {
+			candidate.SkipReason = TerminatorSkipRouterOffline
+			candidate.Err = errors.Errorf("router with id=%v on terminator with id=%v for service name=%v is not online",
+				terminator.GetRouterId(), terminator.GetId(), svc.Name)
+			continue
+		}
+
+		rp, found := paths[dstR.Id]
+		if !found {
+			path, cost, err := network.shortestPathForService(svc, srcR, dstR)
+			rp = &routerPath{PathAndCost: newPathAndCost(path, cost), err: err}
+			paths[dstR.Id] = rp
+		}
+
+		if rp.err != nil {
+			candidate.SkipReason = TerminatorSkipNoPath
+			candidate.Err = rp.err
+			continue
+		}
+
+		candidate.Path = rp.path
+		candidate.PathCost = rp.cost
+		candidate.DynamicCost = xt.GlobalCosts().GetDynamicCost(terminator.Id)
+		unbiasedCost := uint32(terminator.Cost) + uint32(candidate.DynamicCost) + rp.cost
+		candidate.Routing = &RoutingTerminator{
+			Terminator: terminator,
+		}
+		// terminators on draining routers are only used if there's nothing else available
+		if dstR.Draining {
+			candidate.Routing.precedence = xt.Precedences.Failed
+		}
+		candidate.Routing.RouteCost = candidate.Routing.GetPrecedence().GetBiasedCost(unbiasedCost)
+	}
+
+	return result
+}
+
+// RoutePreview describes the terminator and path which would be selected for a circuit, without creating it
+type RoutePreview struct {
+	Service      *Service
+	SourceRouter *Router
+	InstanceId   string
+	Strategy     string
+	Candidates   []*TerminatorCandidate
+	Selected     *TerminatorCandidate
+	Path         *Path
+	Err          error
+}
+
+// PreviewRoute runs terminator selection for the given service from the given source router, the same way
+// circuit creation does, and reports how each terminator was evaluated. The service's strategy is consulted
+// to pick a terminator, but isn't notified of any dials, so the preview doesn't affect future selections.
+func (network *Network) PreviewRoute(serviceId, sourceRouterId, instanceId, clientId string) (*RoutePreview, error) {
+	svc, err := network.Services.Read(serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	srcR := network.Routers.getConnected(sourceRouterId)
+	if srcR == nil {
+		if _, err = network.Routers.Read(sourceRouterId); err != nil {
+			if boltz.IsErrNotFoundErr(err) {
+				return nil, errorz.NewFieldError("source router not found", "sourceRouter", sourceRouterId)
+			}
+			return nil, err
+		}
+		return nil, errorz.NewFieldError("source router is not online", "sourceRouter", sourceRouterId)
+	}
+
+	preview := &RoutePreview{
+		Service:      svc,
+		SourceRouter: srcR,
+		InstanceId:   instanceId,
+		Strategy:     svc.TerminatorStrategy,
+		Candidates:   network.evaluateTerminators(srcR, svc, instanceId),
+	}
+
+	var costed []xt.CostedTerminator
+	candidates := map[string]*TerminatorCandidate{}
+	for _, candidate := range preview.Candidates {
+		if candidate.Routing != nil {
+			costed = append(costed, candidate.Routing)
+			candidates[candidate.Terminator.Id] = candidate
+		}
+	}
+
+	if len(costed) == 0 {
+		preview.Err = errors.Errorf("service %v has no usable terminators for instanceId %v", svc.Id, instanceId)
+		return preview, nil
+	}
+
+	strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy)
+	if err != nil {
+		preview.Err = err
+		return preview, nil
+	}
+
+	sort.Slice(costed, func(i, j int) bool {
+		return costed[i].GetRouteCost() < costed[j].GetRouteCost()
+	})
+
+	strategyParams := &circuitStrategyParams{
+		clientId:     clientId,
+		sourceRouter: srcR,
+		routers:      network.Routers,
+		trafficSplit: svc.TrafficSplit,
+	}
+
+	terminator, err := xt.SelectTerminator(strategy, strategyParams, costed)
+	if err != nil {
+		preview.Err = errors.Wrapf(err, "strategy %v errored selecting terminator", svc.TerminatorStrategy)
+		return preview, nil
+	}
+	if terminator == nil {
+		preview.Err = errors.Errorf("strategy %v did not select terminator", svc.TerminatorStrategy)
+		return preview, nil
+	}
+
+	preview.Selected = candidates[terminator.GetId()]
+	if preview.Selected != nil {
+		path := &Path{Nodes: preview.Selected.Path}
+		if err = network.setLinks(path); err != nil {
+			preview.Err = err
+		} else {
+			preview.Path = path
+		}
+	}
+
+	return preview, nil
+}
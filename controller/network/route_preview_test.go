@@ -0,0 +1,92 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewRoute(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+	network.Routers.markDisconnected(r3)
+
+	l0 := newPathTestLink(network, "l0", r0, r1)
+
+	svc := entityHelper.addTestService("svc")
+	reachable := entityHelper.addTestTerminator(svc.Id, r1.Id, "", false)
+	unreachable := entityHelper.addTestTerminator(svc.Id, r2.Id, "", false)
+	offline := entityHelper.addTestTerminator(svc.Id, r3.Id, "", false)
+	otherInstance := entityHelper.addTestTerminator(svc.Id, r1.Id, "other", false)
+
+	preview, err := network.PreviewRoute(svc.Id, r0.Id, "", "")
+	req.NoError(err)
+	req.NoError(preview.Err)
+	req.Equal(4, len(preview.Candidates))
+
+	candidates := map[string]*TerminatorCandidate{}
+	for _, candidate := range preview.Candidates {
+		candidates[candidate.Terminator.Id] = candidate
+	}
+
+	req.Equal(TerminatorSkipReason(""), candidates[reachable.Id].SkipReason)
+	req.Equal([]*Router{r0, r1}, candidates[reachable.Id].Path)
+	req.NotNil(candidates[reachable.Id].Routing)
+	req.Equal(TerminatorSkipNoPath, candidates[unreachable.Id].SkipReason)
+	req.Equal(TerminatorSkipRouterOffline, candidates[offline.Id].SkipReason)
+	req.Equal(TerminatorSkipInstanceMismatch, candidates[otherInstance.Id].SkipReason)
+
+	req.NotNil(preview.Selected)
+	req.Equal(reachable.Id, preview.Selected.Terminator.Id)
+	req.Equal([]*Router{r0, r1}, preview.Path.Nodes)
+	req.Equal([]*Link{l0}, preview.Path.Links)
+
+	// previews must not create circuits
+	req.Equal(0, len(network.GetAllCircuits()))
+
+	preview, err = network.PreviewRoute(svc.Id, r0.Id, "other", "")
+	req.NoError(err)
+	req.Equal(otherInstance.Id, preview.Selected.Terminator.Id)
+
+	preview, err = network.PreviewRoute(svc.Id, r0.Id, "missing", "")
+	req.NoError(err)
+	req.Error(preview.Err)
+	req.Nil(preview.Selected)
+
+	_, err = network.PreviewRoute(svc.Id, r3.Id, "", "")
+	req.Error(err)
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+}
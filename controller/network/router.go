@@ -31,6 +31,7 @@ import (
 	"github.com/openziti/channel"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/openziti/storage/boltz"
 	cmap "github.com/orcaman/concurrent-map/v2"
@@ -38,35 +39,72 @@ import (
 	"go.etcd.io/bbolt"
 )
 
+const (
+	// RouterRegionTag is the router tag holding the region the router is deployed in
+	RouterRegionTag = "region"
+	// RouterZoneTag is the router tag holding the zone the router is deployed in
+	RouterZoneTag = "zone"
+)
+
 type Listener interface {
 	AdvertiseAddress() string
 	Protocol() string
+	CostTags() []string
 }
 
 type Router struct {
 	models.BaseEntity
-	Name        string
-	Fingerprint *string
-	Listeners   []Listener
-	Control     channel.Channel
-	Connected   concurrenz.AtomicBoolean
-	ConnectTime time.Time
-	VersionInfo *versions.VersionInfo
-	routerLinks RouterLinks
-	Cost        uint16
-	NoTraversal bool
+	Name           string
+	Fingerprint    *string
+	Listeners      []Listener
+	Control        channel.Channel
+	Connected      concurrenz.AtomicBoolean
+	ConnectTime    time.Time
+	VersionInfo    *versions.VersionInfo
+	routerLinks    RouterLinks
+	Cost           uint16
+	NoTraversal    bool
+	Draining       bool
+	RoleAttributes []string
+	// LastDisconnected is when the router disconnected, if it hasn't connected since. It's persisted so that
+	// routers which have been offline for a long time can be identified across controller restarts
+	LastDisconnected *time.Time
+	// ConnectedControllerId is the id of a controller which the router is connected to, if any. Unlike Connected,
+	// which is only about this controller, it's shared by all controllers in a cluster
+	ConnectedControllerId string
 }
 
 func (entity *Router) toBolt() boltz.Entity {
 	return &db.Router{
-		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Name:          entity.Name,
-		Fingerprint:   entity.Fingerprint,
-		Cost:          entity.Cost,
-		NoTraversal:   entity.NoTraversal,
+		BaseExtEntity:         *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:                  entity.Name,
+		Fingerprint:           entity.Fingerprint,
+		Cost:                  entity.Cost,
+		NoTraversal:           entity.NoTraversal,
+		Draining:              entity.Draining,
+		RoleAttributes:        entity.RoleAttributes,
+		LastDisconnected:      entity.LastDisconnected,
+		ConnectedControllerId: entity.ConnectedControllerId,
 	}
 }
 
+// GetLocality returns where the router is deployed, based on its region and zone tags
+func (entity *Router) GetLocality() xt.Locality {
+	locality := xt.Locality{}
+	if region, ok := entity.Tags[RouterRegionTag].(string); ok {
+		locality.Region = region
+	}
+	if zone, ok := entity.Tags[RouterZoneTag].(string); ok {
+		locality.Zone = zone
+	}
+	return locality
+}
+
+// isTransitAllowed returns false if paths may start or end at the router, but may not pass through it
+func (entity *Router) isTransitAllowed() bool {
+	return !entity.NoTraversal && !entity.Draining
+}
+
 func (entity *Router) AddLinkListener(addr, linkProtocol string, linkCostTags []string) {
 	entity.Listeners = append(entity.Listeners, linkListener{
 		addr:         addr,
@@ -75,6 +113,16 @@ func (entity *Router) AddLinkListener(addr, linkProtocol string, linkCostTags []
 	})
 }
 
+// getLinkListener returns the listener on this router matching the given protocol and address, if any
+func (entity *Router) getLinkListener(linkProtocol, addr string) Listener {
+	for _, listener := range entity.Listeners {
+		if listener.Protocol() == linkProtocol && listener.AdvertiseAddress() == addr {
+			return listener
+		}
+	}
+	return nil
+}
+
 func NewRouter(id, name, fingerprint string, cost uint16, noTraversal bool) *Router {
 	if name == "" {
 		name = id
@@ -143,6 +191,7 @@ func (self *RouterManager) markConnected(r *Router) {
 
 	r.Connected.Set(true)
 	self.connected.Set(r.Id, r)
+	self.network.topologyChanged()
 }
 
 func (self *RouterManager) markDisconnected(r *Router) {
@@ -155,6 +204,7 @@ func (self *RouterManager) markDisconnected(r *Router) {
 		return exists
 	})
 	r.routerLinks.Clear()
+	self.network.topologyChanged()
 }
 
 func (self *RouterManager) IsConnected(id string) bool {
@@ -240,6 +290,10 @@ func (self *RouterManager) populateRouter(entity *Router, _ *bbolt.Tx, boltEntit
 	entity.Fingerprint = boltRouter.Fingerprint
 	entity.Cost = boltRouter.Cost
 	entity.NoTraversal = boltRouter.NoTraversal
+	entity.Draining = boltRouter.Draining
+	entity.RoleAttributes = boltRouter.RoleAttributes
+	entity.LastDisconnected = boltRouter.LastDisconnected
+	entity.ConnectedControllerId = boltRouter.ConnectedControllerId
 	entity.FillCommon(boltRouter)
 	return nil
 }
@@ -278,6 +332,7 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 	if router, err := self.readUncached(id); err != nil {
 		log.WithError(err).Error("failed to read router for cache update")
 	} else {
+		drainChanged := false
 		updateCb := func(key string, v *Router, exist bool) bool {
 			if !exist {
 				return false
@@ -287,12 +342,25 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 			v.Fingerprint = router.Fingerprint
 			v.Cost = router.Cost
 			v.NoTraversal = router.NoTraversal
+			if v.Draining != router.Draining {
+				drainChanged = true
+			}
+			v.Draining = router.Draining
+			v.RoleAttributes = router.RoleAttributes
+			v.LastDisconnected = router.LastDisconnected
+			v.ConnectedControllerId = router.ConnectedControllerId
+			v.Tags = router.Tags
 
 			return false
 		}
 
 		self.cache.RemoveCb(id, updateCb)
 		self.connected.RemoveCb(id, updateCb)
+		self.network.topologyChanged()
+
+		if drainChanged {
+			go self.network.processDrains()
+		}
 	}
 }
 
@@ -312,12 +380,19 @@ func (self *RouterManager) Marshall(entity *Router) ([]byte, error) {
 	}
 
 	msg := &cmd_pb.Router{
-		Id:          entity.Id,
-		Name:        entity.Name,
-		Fingerprint: fingerprint,
-		Cost:        uint32(entity.Cost),
-		NoTraversal: entity.NoTraversal,
-		Tags:        tags,
+		Id:                    entity.Id,
+		Name:                  entity.Name,
+		Fingerprint:           fingerprint,
+		Cost:                  uint32(entity.Cost),
+		NoTraversal:           entity.NoTraversal,
+		Draining:              entity.Draining,
+		RoleAttributes:        entity.RoleAttributes,
+		Tags:                  tags,
+		ConnectedControllerId: entity.ConnectedControllerId,
+	}
+
+	if entity.LastDisconnected != nil {
+		msg.LastDisconnected = entity.LastDisconnected.UnixMilli()
 	}
 
 	return proto.Marshal(msg)
@@ -335,16 +410,26 @@ func (self *RouterManager) Unmarshall(bytes []byte) (*Router, error) {
 		fingerprint = &tmp
 	}
 
-	return &Router{
+	result := &Router{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
 			Tags: cmd_pb.DecodeTags(msg.Tags),
 		},
-		Name:        msg.Name,
-		Fingerprint: fingerprint,
-		Cost:        uint16(msg.Cost),
-		NoTraversal: msg.NoTraversal,
-	}, nil
+		Name:                  msg.Name,
+		Fingerprint:           fingerprint,
+		Cost:                  uint16(msg.Cost),
+		NoTraversal:           msg.NoTraversal,
+		Draining:              msg.Draining,
+		RoleAttributes:        msg.RoleAttributes,
+		ConnectedControllerId: msg.ConnectedControllerId,
+	}
+
+	if msg.LastDisconnected != 0 {
+		lastDisconnected := time.UnixMilli(msg.LastDisconnected)
+		result.LastDisconnected = &lastDisconnected
+	}
+
+	return result, nil
 }
 
 type RouterLinks struct {
@@ -443,3 +528,7 @@ func (self linkListener) AdvertiseAddress() string {
 func (self linkListener) Protocol() string {
 	return self.linkProtocol
 }
+
+func (self linkListener) CostTags() []string {
+	return self.linkCostTags
+}
@@ -0,0 +1,143 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/event"
+	"sync"
+	"time"
+)
+
+// routerDrains tracks the drain progress last reported for each draining router, so that events are only
+// emitted when progress changes
+type routerDrains struct {
+	sync.Mutex
+	remaining map[string]int64
+}
+
+func newRouterDrains() *routerDrains {
+	return &routerDrains{
+		remaining: map[string]int64{},
+	}
+}
+
+// GetRouterCircuitCount returns the number of circuits whose path includes the given router
+func (network *Network) GetRouterCircuitCount(routerId string) int {
+	count := 0
+	for _, circuit := range network.circuitController.all() {
+		if circuit.HasRouter(routerId) {
+			count++
+		}
+	}
+	return count
+}
+
+// processDrains moves transit circuits off of draining routers and reports drain progress. Draining routers
+// are already excluded from new paths as transit routers, so rerouting a circuit which passes through one will
+// pick a path around it, if one exists. Circuits which start or end at a draining router can't be moved, and
+// will remain until they are closed.
+func (network *Network) processDrains() {
+	drains := network.routerDrains
+	drains.Lock()
+	defer drains.Unlock()
+
+	draining := map[string]*Router{}
+	for _, r := range network.Routers.allConnected() {
+		if r.Draining {
+			draining[r.Id] = r
+		}
+	}
+
+	for routerId := range drains.remaining {
+		if _, found := draining[routerId]; !found {
+			delete(drains.remaining, routerId)
+			network.routerDrainEvent(event.RouterDrainStopped, routerId, nil)
+		}
+	}
+
+	if len(draining) == 0 {
+		return
+	}
+
+	deadline := time.Now().Add(DefaultNetworkOptionsRouteTimeout)
+	for _, circuit := range network.circuitController.all() {
+		if !network.transitsDrainingRouter(circuit.Path) {
+			continue
+		}
+
+		log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+		updatedPath, err := network.UpdatePathForService(circuit.Path, circuit.Service)
+		if err != nil {
+			log.WithError(err).Debug("unable to find path around draining router for circuit")
+			continue
+		}
+
+		if updatedPath.EqualPath(circuit.Path) || network.transitsDrainingRouter(updatedPath) {
+			continue
+		}
+
+		log.Infof("moving circuit off of draining router. %s ==> %s", circuit.Path, updatedPath)
+		if retry := network.smartReroute(circuit, updatedPath, deadline, RerouteReasonManual); retry {
+			log.Warn("failed to move circuit off of draining router, will retry")
+		}
+	}
+
+	for routerId := range draining {
+		remaining := int64(network.GetRouterCircuitCount(routerId))
+		last, found := drains.remaining[routerId]
+		if found && last == remaining {
+			continue
+		}
+		drains.remaining[routerId] = remaining
+
+		network.routerDrainEvent(event.RouterDraining, routerId, &remaining)
+		if remaining == 0 {
+			network.routerDrainEvent(event.RouterDrained, routerId, &remaining)
+		}
+	}
+}
+
+// transitsDrainingRouter returns true if any router in the middle of the path is draining
+func (network *Network) transitsDrainingRouter(path *Path) bool {
+	if path == nil || len(path.Nodes) < 3 {
+		return false
+	}
+	for _, r := range path.Nodes[1 : len(path.Nodes)-1] {
+		if r.Draining {
+			return true
+		}
+	}
+	return false
+}
+
+func (network *Network) routerDrainEvent(eventType event.RouterEventType, routerId string, remaining *int64) {
+	pfxlog.Logger().WithField("routerId", routerId).WithField("eventType", eventType).Info("router drain progress")
+	var roleAttributes []string
+	if r, _ := network.Routers.Read(routerId); r != nil {
+		roleAttributes = r.RoleAttributes
+	}
+	network.eventDispatcher.AcceptRouterEvent(&event.RouterEvent{
+		Namespace:              event.RouterEventsNs,
+		EventType:              eventType,
+		Timestamp:              time.Now(),
+		RouterId:               routerId,
+		RouterOnline:           network.Routers.IsConnected(routerId),
+		RoleAttributes:         roleAttributes,
+		DrainRemainingCircuits: remaining,
+	})
+}
@@ -0,0 +1,120 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainingRouterExcludedFromPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r1, r2)
+	newPathTestLink(network, "l2", r0, r3).SetStaticCost(10)
+	newPathTestLink(network, "l3", r3, r2).SetStaticCost(10)
+
+	path, _, err := network.shortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r2}, path)
+
+	r1.Draining = true
+	network.topologyChanged()
+
+	path, _, err = network.shortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r3, r2}, path)
+
+	path, _, err = network.cachedShortestPath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r3, r2}, path)
+
+	// draining routers may still be used as path endpoints
+	path, _, err = network.shortestPath(r0, r1)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1}, path)
+
+	req.True(network.transitsDrainingRouter(&Path{Nodes: []*Router{r0, r1, r2}}))
+	req.False(network.transitsDrainingRouter(&Path{Nodes: []*Router{r0, r1}}))
+}
+
+func TestDrainingRouterTerminatorsFailed(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+
+	newPathTestLink(network, "l0", r0, r1)
+	newPathTestLink(network, "l1", r0, r2).SetStaticCost(10)
+
+	svc := entityHelper.addTestService("svc")
+	t1 := entityHelper.addTestTerminator(svc.Id, r1.Id, "", false)
+	t2 := entityHelper.addTestTerminator(svc.Id, r2.Id, "", false)
+
+	preview, err := network.PreviewRoute(svc.Id, r0.Id, "", "")
+	req.NoError(err)
+	req.Equal(t1.Id, preview.Selected.Terminator.Id)
+
+	r1.Draining = true
+
+	preview, err = network.PreviewRoute(svc.Id, r0.Id, "", "")
+	req.NoError(err)
+	req.Equal(t2.Id, preview.Selected.Terminator.Id)
+	for _, candidate := range preview.Candidates {
+		if candidate.Terminator.Id == t1.Id {
+			req.True(candidate.Routing.GetPrecedence().IsFailed())
+		}
+	}
+
+	// with no alternative, the terminator on the draining router is still used
+	r2.Draining = true
+	network.Routers.markDisconnected(r2)
+	preview, err = network.PreviewRoute(svc.Id, r0.Id, "", "")
+	req.NoError(err)
+	req.Equal(t1.Id, preview.Selected.Terminator.Id)
+	req.Equal(xt.Precedences.Failed, preview.Selected.Routing.GetPrecedence())
+}
@@ -0,0 +1,74 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RouterMatchesSelector checks a router against a single selector. Supported selectors are:
+//
+//	@<id>          matches the router with the given id
+//	#<attr>        matches routers which have the given role attribute, or the given tag
+//	#<tag>=<value> matches routers where the given tag has the given value
+//
+// Any other selector is treated as a router id.
+func RouterMatchesSelector(router *Router, selector string) bool {
+	if strings.HasPrefix(selector, "@") {
+		return router.Id == selector[1:]
+	}
+
+	if strings.HasPrefix(selector, "#") {
+		tag := selector[1:]
+		var value *string
+		if idx := strings.IndexByte(tag, '='); idx >= 0 {
+			v := tag[idx+1:]
+			value = &v
+			tag = tag[:idx]
+		} else if RouterHasRoleAttribute(router, tag) {
+			return true
+		}
+		tagValue, found := router.Tags[tag]
+		if !found {
+			return false
+		}
+		return value == nil || fmt.Sprintf("%v", tagValue) == *value
+	}
+
+	return router.Id == selector
+}
+
+// RouterMatchesAnySelector returns true if the router matches at least one of the given selectors
+func RouterMatchesAnySelector(router *Router, selectors []string) bool {
+	for _, selector := range selectors {
+		if RouterMatchesSelector(router, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// RouterHasRoleAttribute returns true if the router has the given role attribute
+func RouterHasRoleAttribute(router *Router, roleAttribute string) bool {
+	for _, attr := range router.RoleAttributes {
+		if attr == roleAttribute {
+			return true
+		}
+	}
+	return false
+}
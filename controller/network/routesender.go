@@ -26,6 +26,7 @@ import (
 	"github.com/openziti/fabric/ctrl_msg"
 	"github.com/openziti/fabric/logcontext"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/metrics"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 )
@@ -65,9 +66,11 @@ type routeSender struct {
 	attendance      map[string]bool
 	serviceCounters ServiceCounters
 	terminators     *TerminatorManager
+	metricsRegistry metrics.Registry
+	timings         []*RouteTiming
 }
 
-func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *TerminatorManager) *routeSender {
+func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *TerminatorManager, metricsRegistry metrics.Registry) *routeSender {
 	return &routeSender{
 		circuitId:       circuitId,
 		timeout:         timeout,
@@ -75,6 +78,7 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 		attendance:      make(map[string]bool),
 		serviceCounters: serviceCounters,
 		terminators:     terminators,
+		metricsRegistry: metricsRegistry,
 	}
 }
 
@@ -82,6 +86,7 @@ func (self *routeSender) route(attempt uint32, path *Path, routeMsgs []*ctrl_pb.
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
 
 	// send route messages
+	sentAt := time.Now()
 	for i := 0; i < len(path.Nodes); i++ {
 		r := path.Nodes[i]
 		msg := routeMsgs[i]
@@ -96,6 +101,9 @@ attendance:
 	for {
 		select {
 		case status := <-self.in:
+			if status.Attempt == attempt {
+				self.recordTiming(status, time.Since(sentAt))
+			}
 			var tmpPeerData xt.PeerData
 			tmpPeerData, cleanups, err = self.handleRouteSend(attempt, path, strategy, status, terminator, logger)
 			if err != nil {
@@ -197,6 +205,25 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *Path, strategy xt
 	return nil, nil, nil
 }
 
+// recordTiming tracks how long a router took to answer a route request, and how long the egress dial took, if the
+// router reported it. Timings are kept for all attempts, so they can be reported on circuit events.
+func (self *routeSender) recordTiming(status *RouteStatus, routeDuration time.Duration) {
+	self.timings = append(self.timings, &RouteTiming{
+		RouterId:      status.Router.Id,
+		Attempt:       status.Attempt,
+		Success:       status.Success,
+		RouteDuration: routeDuration,
+		DialDuration:  status.DialDuration,
+	})
+
+	if self.metricsRegistry != nil {
+		self.metricsRegistry.Histogram(RouteLatencyMetric + status.Router.Id).Update(int64(routeDuration))
+		if status.DialDuration != nil {
+			self.metricsRegistry.Histogram(RouteDialTimeMetric + status.Router.Id).Update(int64(*status.DialDuration))
+		}
+	}
+}
+
 func (self *routeSender) sendRoute(r *Router, routeMsg *ctrl_pb.Route, ctx logcontext.Context) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx).WithField("routerId", r.Id)
 
@@ -220,13 +247,32 @@ func (self *routeSender) cleanups(path *Path) map[string]struct{} {
 }
 
 type RouteStatus struct {
-	Router    *Router
-	CircuitId string
-	Attempt   uint32
-	Success   bool
-	Err       string
-	PeerData  xt.PeerData
-	ErrorCode *byte
+	Router       *Router
+	CircuitId    string
+	Attempt      uint32
+	Success      bool
+	Err          string
+	PeerData     xt.PeerData
+	ErrorCode    *byte
+	DialDuration *time.Duration
+}
+
+const (
+	// RouteLatencyMetric is the prefix of the per-router histogram of route request round trip times
+	RouteLatencyMetric = "ctrl.route_latency:"
+
+	// RouteDialTimeMetric is the prefix of the per-router histogram of egress dial times
+	RouteDialTimeMetric = "ctrl.route_dial_time:"
+)
+
+// RouteTiming records how long a router on the path took to answer a route request for a circuit attempt.
+// DialDuration is only set by the terminating router, and is the time it spent dialing the egress.
+type RouteTiming struct {
+	RouterId      string
+	Attempt       uint32
+	Success       bool
+	RouteDuration time.Duration
+	DialDuration  *time.Duration
 }
 
 type routeTimeoutError struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/openziti/fabric/controller/xt_smartrouting"
 	"github.com/openziti/fabric/ctrl_msg"
 	"testing"
+	"time"
 )
 
 func TestRouteSender_DestroysTerminatorWhenInvalidOnHandleRouteSendAndWeControl(t *testing.T) {
@@ -120,3 +121,43 @@ func TestRouteSender_SetPrecidenceToNilTerminatorWhenInvalidOnHandleRouteSendAnd
 
 	ctx.Equal(xt.Precedences.Failed, newTerm.GetPrecedence())
 }
+
+func TestRouteSender_RecordsRouteTimings(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	router1 := entityHelper.addTestRouter()
+	router2 := entityHelper.addTestRouter()
+
+	rs := network.newRouteSender("circuit1")
+	defer network.removeRouteSender(rs)
+
+	dialDuration := 30 * time.Millisecond
+	rs.recordTiming(&RouteStatus{Router: router1, Attempt: 0, Success: true}, 10*time.Millisecond)
+	rs.recordTiming(&RouteStatus{Router: router2, Attempt: 0, Success: false, DialDuration: &dialDuration}, 40*time.Millisecond)
+	rs.recordTiming(&RouteStatus{Router: router2, Attempt: 1, Success: true, DialDuration: &dialDuration}, 35*time.Millisecond)
+
+	timings := toEventRouteTimings(rs.timings)
+	ctx.Equal(3, len(timings))
+	ctx.Equal(router1.Id, timings[0].RouterId)
+	ctx.Equal(10*time.Millisecond, timings[0].RouteDuration)
+	ctx.Nil(timings[0].DialDuration)
+	ctx.Equal(router2.Id, timings[1].RouterId)
+	ctx.False(timings[1].Success)
+	ctx.Equal(uint32(1), timings[2].Attempt)
+	ctx.Equal(dialDuration, *timings[2].DialDuration)
+
+	msg := network.GetMetricsRegistry().Poll()
+	ctx.NotNil(msg)
+	ctx.Equal(int64(1), msg.Histograms[RouteLatencyMetric+router1.Id].Count)
+	ctx.Equal(int64(2), msg.Histograms[RouteLatencyMetric+router2.Id].Count)
+	ctx.Nil(msg.Histograms[RouteDialTimeMetric+router1.Id])
+	ctx.Equal(int64(2), msg.Histograms[RouteDialTimeMetric+router2.Id].Count)
+}
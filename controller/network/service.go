@@ -22,6 +22,7 @@ import (
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/pb/cmd_pb"
 	"github.com/openziti/storage/boltz"
 	"github.com/orcaman/concurrent-map/v2"
@@ -33,9 +34,23 @@ import (
 
 type Service struct {
 	models.BaseEntity
-	Name               string
-	TerminatorStrategy string
-	Terminators        []*Terminator
+	Name                 string
+	TerminatorStrategy   string
+	Terminators          []*Terminator
+	AllowedRouters       []string
+	DeniedRouters        []string
+	MaxLinkCount         uint32
+	WaypointRouters      []string
+	MaxCircuits          uint32
+	MaxCircuitsPerClient uint32
+	CircuitRateLimit     uint32
+	CircuitRateBurst     uint32
+	// PinnedPath, if set, is the sequence of routers circuits for the service must traverse
+	PinnedPath []string
+	// PinnedPathFallback allows normal routing to be used if no pinned path is available
+	PinnedPathFallback bool
+	// TrafficSplit divides circuits between weighted groups of terminators, when using the split strategy
+	TrafficSplit *xt.TrafficSplit
 }
 
 func (self *Service) GetName() string {
@@ -44,9 +59,20 @@ func (self *Service) GetName() string {
 
 func (entity *Service) toBolt() boltz.Entity {
 	return &db.Service{
-		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Name:               entity.Name,
-		TerminatorStrategy: entity.TerminatorStrategy,
+		BaseExtEntity:        *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:                 entity.Name,
+		TerminatorStrategy:   entity.TerminatorStrategy,
+		AllowedRouters:       entity.AllowedRouters,
+		DeniedRouters:        entity.DeniedRouters,
+		MaxLinkCount:         entity.MaxLinkCount,
+		WaypointRouters:      entity.WaypointRouters,
+		MaxCircuits:          entity.MaxCircuits,
+		MaxCircuitsPerClient: entity.MaxCircuitsPerClient,
+		CircuitRateLimit:     entity.CircuitRateLimit,
+		CircuitRateBurst:     entity.CircuitRateBurst,
+		PinnedPath:           entity.PinnedPath,
+		PinnedPathFallback:   entity.PinnedPathFallback,
+		TrafficSplit:         entity.TrafficSplit,
 	}
 }
 
@@ -184,6 +210,17 @@ func (self *ServiceManager) populateService(entity *Service, tx *bbolt.Tx, boltE
 	}
 	entity.Name = boltService.Name
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.AllowedRouters = boltService.AllowedRouters
+	entity.DeniedRouters = boltService.DeniedRouters
+	entity.MaxLinkCount = boltService.MaxLinkCount
+	entity.WaypointRouters = boltService.WaypointRouters
+	entity.MaxCircuits = boltService.MaxCircuits
+	entity.MaxCircuitsPerClient = boltService.MaxCircuitsPerClient
+	entity.CircuitRateLimit = boltService.CircuitRateLimit
+	entity.CircuitRateBurst = boltService.CircuitRateBurst
+	entity.PinnedPath = boltService.PinnedPath
+	entity.PinnedPathFallback = boltService.PinnedPathFallback
+	entity.TrafficSplit = boltService.TrafficSplit
 	entity.FillCommon(boltService)
 
 	terminatorIds := self.store.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
@@ -220,10 +257,33 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 	}
 
 	msg := &cmd_pb.Service{
-		Id:                 entity.Id,
-		Name:               entity.Name,
-		TerminatorStrategy: entity.TerminatorStrategy,
-		Tags:               tags,
+		Id:                   entity.Id,
+		Name:                 entity.Name,
+		TerminatorStrategy:   entity.TerminatorStrategy,
+		Tags:                 tags,
+		AllowedRouters:       entity.AllowedRouters,
+		DeniedRouters:        entity.DeniedRouters,
+		MaxLinkCount:         entity.MaxLinkCount,
+		WaypointRouters:      entity.WaypointRouters,
+		MaxCircuits:          entity.MaxCircuits,
+		MaxCircuitsPerClient: entity.MaxCircuitsPerClient,
+		CircuitRateLimit:     entity.CircuitRateLimit,
+		CircuitRateBurst:     entity.CircuitRateBurst,
+		PinnedPath:           entity.PinnedPath,
+		PinnedPathFallback:   entity.PinnedPathFallback,
+	}
+
+	if entity.TrafficSplit != nil {
+		msg.TrafficSplit = &cmd_pb.TrafficSplit{
+			StickyClients: entity.TrafficSplit.StickyClients,
+		}
+		for _, group := range entity.TrafficSplit.Groups {
+			msg.TrafficSplit.Groups = append(msg.TrafficSplit.Groups, &cmd_pb.TrafficSplit_Group{
+				Name:           group.Name,
+				Weight:         group.Weight,
+				TerminatorTags: group.TerminatorTags,
+			})
+		}
 	}
 
 	return proto.Marshal(msg)
@@ -235,12 +295,37 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		return nil, err
 	}
 
-	return &Service{
+	result := &Service{
 		BaseEntity: models.BaseEntity{
 			Id:   msg.Id,
 			Tags: cmd_pb.DecodeTags(msg.Tags),
 		},
-		Name:               msg.Name,
-		TerminatorStrategy: msg.TerminatorStrategy,
-	}, nil
+		Name:                 msg.Name,
+		TerminatorStrategy:   msg.TerminatorStrategy,
+		AllowedRouters:       msg.AllowedRouters,
+		DeniedRouters:        msg.DeniedRouters,
+		MaxLinkCount:         msg.MaxLinkCount,
+		WaypointRouters:      msg.WaypointRouters,
+		MaxCircuits:          msg.MaxCircuits,
+		MaxCircuitsPerClient: msg.MaxCircuitsPerClient,
+		CircuitRateLimit:     msg.CircuitRateLimit,
+		CircuitRateBurst:     msg.CircuitRateBurst,
+		PinnedPath:           msg.PinnedPath,
+		PinnedPathFallback:   msg.PinnedPathFallback,
+	}
+
+	if msg.TrafficSplit != nil {
+		result.TrafficSplit = &xt.TrafficSplit{
+			StickyClients: msg.TrafficSplit.StickyClients,
+		}
+		for _, group := range msg.TrafficSplit.Groups {
+			result.TrafficSplit.Groups = append(result.TrafficSplit.Groups, &xt.TrafficSplitGroup{
+				Name:           group.Name,
+				Weight:         group.Weight,
+				TerminatorTags: group.TerminatorTags,
+			})
+		}
+	}
+
+	return result, nil
 }
@@ -2,6 +2,7 @@ package network
 
 import (
 	"fmt"
+	"github.com/openziti/fabric/controller/xt"
 	"time"
 )
 
@@ -36,6 +37,10 @@ func (network *Network) ServiceDialOtherError(serviceId string) {
 	network.serviceDialOtherErrorCounter.Update(serviceId, time.Now(), 1)
 }
 
+func (network *Network) ServiceDialAdmissionLimit(serviceId string) {
+	network.serviceDialAdmissionLimitCounter.Update(serviceId, time.Now(), 1)
+}
+
 func (network *Network) ServiceTerminatorTimeout(serviceId, terminatorId string) {
 	combinedId := network.joinIds(serviceId, terminatorId)
 	network.serviceTerminatorTimeoutCounter.Update(combinedId, time.Now(), 1)
@@ -54,6 +59,27 @@ func (network *Network) ServiceMisconfiguredTerminator(serviceId, terminatorId s
 	network.serviceMisconfiguredTerminatorCounter.Update(combinedId, time.Now(), 1)
 }
 
+// ServiceSplitGroupDialSuccess counts a successful dial against the traffic split group the terminator belongs to, if
+// the service has a traffic split and the terminator belongs to a group
+func (network *Network) ServiceSplitGroupDialSuccess(svc *Service, terminator xt.Terminator) {
+	if group := svc.TrafficSplit.GetGroup(terminator); group != nil {
+		network.serviceSplitGroupDialSuccessCounter.Update(network.joinSplitGroupIds(svc.Id, group.Name), time.Now(), 1)
+	}
+}
+
+// ServiceSplitGroupDialFail counts a failed dial against the traffic split group the terminator belongs to, if the
+// service has a traffic split and the terminator belongs to a group
+func (network *Network) ServiceSplitGroupDialFail(svc *Service, terminator xt.Terminator) {
+	if group := svc.TrafficSplit.GetGroup(terminator); group != nil {
+		network.serviceSplitGroupDialFailCounter.Update(network.joinSplitGroupIds(svc.Id, group.Name), time.Now(), 1)
+	}
+}
+
+// joinSplitGroupIds leaves the terminator id blank, as split group counters aren't per terminator
+func (network *Network) joinSplitGroupIds(serviceId, groupName string) string {
+	return fmt.Sprintf("%v::%v", serviceId, groupName)
+}
+
 func (network *Network) joinIds(serviceId, terminatorId string) string {
 	return fmt.Sprintf("%v:%v", serviceId, terminatorId)
 }
@@ -66,9 +66,9 @@ func (network *Network) smart() {
 	}
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
 	for _, sId := range orderedCircuits {
-		if circuit, found := network.GetCircuit(sId); found {
-			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
-				if !updatedPath.EqualPath(circuit.Path) {
+		if circuit, found := network.GetCircuit(sId); found && !circuit.Pinned.Get() {
+			if updatedPath, err := network.UpdatePathForService(circuit.Path, circuit.Service); err == nil {
+				if !updatedPath.EqualPath(circuit.Path) && network.isSmartRerouteWorthwhile(circuit, circuitLatencies[circuit.Id], updatedPath) {
 					if count < ceiling {
 						count++
 						candidates = append(candidates, circuit)
@@ -85,9 +85,46 @@ func (network *Network) smart() {
 	 * Reroute.
 	 */
 	for _, circuit := range candidates {
-		if retry := network.smartReroute(circuit, newPaths[circuit], time.Now().Add(DefaultNetworkOptionsRouteTimeout)); retry {
-			go network.rerouteCircuitWithTries(circuit, DefaultNetworkOptionsCreateCircuitRetries)
+		if retry := network.smartReroute(circuit, newPaths[circuit], time.Now().Add(DefaultNetworkOptionsRouteTimeout), RerouteReasonSmart); retry {
+			go network.rerouteCircuitWithTries(circuit, DefaultNetworkOptionsCreateCircuitRetries, RerouteReasonSmart)
 		}
 	}
 	/* */
 }
+
+// isSmartRerouteWorthwhile applies the smart reroute hysteresis settings, so that circuits aren't moved for marginal
+// cost improvements or too frequently. Circuits whose current path is no longer usable, or which are being moved to
+// a more expensive path, which happens when path constraints change, are always eligible.
+func (network *Network) isSmartRerouteWorthwhile(circuit *Circuit, currentCost int64, updatedPath *Path) bool {
+	if !network.isPathUsable(circuit.Path) {
+		return true
+	}
+
+	improvement := currentCost - updatedPath.cost()
+	if improvement < 0 {
+		return true
+	}
+
+	options := network.options.Smart
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+
+	if options.MinRerouteInterval > 0 {
+		if elapsed, rerouted := circuit.sinceLastReroute(); rerouted && elapsed < options.MinRerouteInterval {
+			log.Tracef("not rerouting, last rerouted %v ago", elapsed)
+			return false
+		}
+	}
+
+	if improvement < options.MinCostImprovement {
+		log.Tracef("not rerouting, cost improvement of %v less than minimum of %v", improvement, options.MinCostImprovement)
+		return false
+	}
+
+	if float64(improvement) < float64(options.MinCostImprovementFraction)*float64(currentCost) {
+		log.Tracef("not rerouting, cost improvement of %v less than %v of current cost %v",
+			improvement, options.MinCostImprovementFraction, currentCost)
+		return false
+	}
+
+	return true
+}
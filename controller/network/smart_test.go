@@ -0,0 +1,111 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmartRerouteHysteresis(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+
+	l0 := newPathTestLink(network, "l0", r0, r2)
+	l0.SetStaticCost(100)
+	l1 := newPathTestLink(network, "l1", r0, r1)
+	l1.SetStaticCost(45)
+	l2 := newPathTestLink(network, "l2", r1, r2)
+	l2.SetStaticCost(45)
+
+	circuit := &Circuit{
+		Id:   "c0",
+		Path: &Path{Nodes: []*Router{r0, r2}, Links: []*Link{l0}},
+	}
+	updatedPath := &Path{Nodes: []*Router{r0, r1, r2}, Links: []*Link{l1, l2}}
+	req.Equal(int64(100), circuit.cost())
+	req.Equal(int64(90), updatedPath.cost())
+
+	// with no hysteresis configured, any improvement is worthwhile
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	network.options.Smart.MinCostImprovement = 20
+	req.False(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	network.options.Smart.MinCostImprovement = 10
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	network.options.Smart.MinCostImprovementFraction = 0.2
+	req.False(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	network.options.Smart.MinCostImprovementFraction = 0.05
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	network.options.Smart.MinRerouteInterval = time.Minute
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	circuit.markRerouted()
+	req.False(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+
+	// a circuit on a path which is no longer usable can always be moved
+	l0.SetDown(true)
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+	l0.SetDown(false)
+
+	// moving to a more expensive path happens when constraints change, so isn't subject to hysteresis
+	l1.SetStaticCost(100)
+	req.True(network.isSmartRerouteWorthwhile(circuit, circuit.cost(), updatedPath))
+}
+
+func TestLoadSmartHysteresisOptions(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(map[interface{}]interface{}{
+		"smart": map[interface{}]interface{}{
+			"minCostImprovement":         25,
+			"minCostImprovementFraction": 0.1,
+			"minRerouteInterval":         "2m",
+		},
+	})
+	req.NoError(err)
+	req.Equal(int64(25), options.Smart.MinCostImprovement)
+	req.Equal(float32(0.1), options.Smart.MinCostImprovementFraction)
+	req.Equal(2*time.Minute, options.Smart.MinRerouteInterval)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"smart": map[interface{}]interface{}{
+			"minRerouteInterval": "soon",
+		},
+	})
+	req.Error(err)
+}
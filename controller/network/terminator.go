@@ -27,6 +27,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type Terminator struct {
@@ -41,6 +42,46 @@ type Terminator struct {
 	Precedence     xt.Precedence
 	PeerData       map[uint32][]byte
 	HostId         string
+	HealthCheck    *TerminatorHealthCheck
+	Draining       bool
+	DrainDeadline  *time.Time
+	LeaseTtl       time.Duration
+	LeaseExpiresAt *time.Time
+	// FailedBy is set if the terminator was given failed precedence automatically, for example by a failing health
+	// check. RestorePrecedence is the precedence to restore once the cause goes away
+	FailedBy          string
+	RestorePrecedence xt.Precedence
+}
+
+const (
+	// TerminatorFailedByHealthCheck marks terminators given failed precedence by a failing health check
+	TerminatorFailedByHealthCheck = "healthCheck"
+	// TerminatorFailedByOfflineRouter marks terminators given failed precedence by offline terminator cleanup
+	TerminatorFailedByOfflineRouter = "offlineRouter"
+)
+
+// TerminatorHealthCheck configures a check which the router hosting a terminator runs on an interval. If the check
+// fails, the terminator is given failed precedence until the check passes again. See the xt.HealthCheckType
+// constants for supported check types.
+type TerminatorHealthCheck struct {
+	Type           string
+	Address        string
+	Interval       time.Duration
+	Timeout        time.Duration
+	ExpectedStatus uint32
+}
+
+func (self *TerminatorHealthCheck) toBolt() *db.TerminatorHealthCheck {
+	if self == nil {
+		return nil
+	}
+	return &db.TerminatorHealthCheck{
+		Type:           self.Type,
+		Address:        self.Address,
+		Interval:       self.Interval,
+		Timeout:        self.Timeout,
+		ExpectedStatus: self.ExpectedStatus,
+	}
 }
 
 func (entity *Terminator) GetServiceId() string {
@@ -88,18 +129,29 @@ func (entity *Terminator) toBolt() *db.Terminator {
 	if entity.Precedence != nil {
 		precedence = entity.Precedence.String()
 	}
+	restorePrecedence := ""
+	if entity.FailedBy != "" && entity.RestorePrecedence != nil {
+		restorePrecedence = entity.RestorePrecedence.String()
+	}
 	return &db.Terminator{
-		BaseExtEntity:  *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Service:        entity.Service,
-		Router:         entity.Router,
-		Binding:        entity.Binding,
-		Address:        entity.Address,
-		InstanceId:     entity.InstanceId,
-		InstanceSecret: entity.InstanceSecret,
-		Cost:           entity.Cost,
-		Precedence:     precedence,
-		PeerData:       entity.PeerData,
-		HostId:         entity.HostId,
+		BaseExtEntity:     *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Service:           entity.Service,
+		Router:            entity.Router,
+		Binding:           entity.Binding,
+		Address:           entity.Address,
+		InstanceId:        entity.InstanceId,
+		InstanceSecret:    entity.InstanceSecret,
+		Cost:              entity.Cost,
+		Precedence:        precedence,
+		PeerData:          entity.PeerData,
+		HostId:            entity.HostId,
+		HealthCheck:       entity.HealthCheck.toBolt(),
+		Draining:          entity.Draining,
+		DrainDeadline:     entity.DrainDeadline,
+		LeaseTtl:          entity.LeaseTtl,
+		LeaseExpiresAt:    entity.LeaseExpiresAt,
+		FailedBy:          entity.FailedBy,
+		RestorePrecedence: restorePrecedence,
 	}
 }
 
@@ -131,6 +183,10 @@ type TerminatorManager struct {
 }
 
 func (self *TerminatorManager) Create(entity *Terminator) error {
+	if entity.LeaseTtl > 0 && entity.LeaseExpiresAt == nil {
+		leaseExpiresAt := time.Now().Add(entity.LeaseTtl)
+		entity.LeaseExpiresAt = &leaseExpiresAt
+	}
 	return DispatchCreate[*Terminator](self, entity)
 }
 
@@ -177,6 +233,44 @@ func (self *TerminatorManager) handlePrecedenceChange(terminatorId string, prece
 	}
 }
 
+// failPrecedence gives the terminator failed precedence, recording what failed it and the precedence it had before,
+// so that restorePrecedence can undo it. Terminators which already have failed precedence are left alone. Returns
+// true if the precedence was changed.
+func (self *TerminatorManager) failPrecedence(terminator *Terminator, failedBy string) (bool, error) {
+	if terminator.Precedence.IsFailed() {
+		return false, nil
+	}
+
+	terminator.RestorePrecedence = terminator.Precedence
+	terminator.Precedence = xt.Precedences.Failed
+	terminator.FailedBy = failedBy
+
+	return true, self.Update(terminator, fields.UpdatedFieldsMap{
+		db.FieldTerminatorPrecedence:        struct{}{},
+		db.FieldTerminatorFailedBy:          struct{}{},
+		db.FieldTerminatorRestorePrecedence: struct{}{},
+	})
+}
+
+// restorePrecedence restores the precedence the terminator had before failPrecedence was called with the same
+// failedBy. Terminators which were failed by something else, or whose precedence has since been changed, are left
+// alone. Returns true if the precedence was changed.
+func (self *TerminatorManager) restorePrecedence(terminator *Terminator, failedBy string) (bool, error) {
+	if !terminator.Precedence.IsFailed() || terminator.FailedBy != failedBy {
+		return false, nil
+	}
+
+	terminator.Precedence = terminator.RestorePrecedence
+	if terminator.Precedence == nil {
+		terminator.Precedence = xt.Precedences.Default
+	}
+
+	// setting a precedence other than failed clears what failed it
+	return true, self.Update(terminator, fields.UpdatedFieldsMap{
+		db.FieldTerminatorPrecedence: struct{}{},
+	})
+}
+
 func (self *TerminatorManager) Update(entity *Terminator, updatedFields fields.UpdatedFields) error {
 	return DispatchUpdate[*Terminator](self, entity, updatedFields)
 }
@@ -189,6 +283,22 @@ func (self *TerminatorManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Ter
 	})
 }
 
+// DeleteWithCause deletes the terminator, recording why it was deleted so that terminator delete events can report it
+func (self *TerminatorManager) DeleteWithCause(id string, cause string) error {
+	cmd := &command.DeleteEntityCommand{
+		Deleter: self,
+		Id:      id,
+		Cause:   cause,
+	}
+	return self.Managers.Dispatch(cmd)
+}
+
+func (self *TerminatorManager) ApplyDelete(cmd *command.DeleteEntityCommand) error {
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.store.DeleteWithCause(boltz.NewMutateContext(tx), cmd.Id, cmd.Cause)
+	})
+}
+
 func (self *TerminatorManager) Read(id string) (entity *Terminator, err error) {
 	err = self.db.View(func(tx *bbolt.Tx) error {
 		entity, err = self.readInTx(tx, id)
@@ -232,6 +342,24 @@ func (self *TerminatorManager) populateTerminator(entity *Terminator, _ *bbolt.T
 	entity.Cost = boltTerminator.Cost
 	entity.Precedence = xt.GetPrecedenceForName(boltTerminator.Precedence)
 	entity.HostId = boltTerminator.HostId
+	entity.Draining = boltTerminator.Draining
+	entity.DrainDeadline = boltTerminator.DrainDeadline
+	entity.LeaseTtl = boltTerminator.LeaseTtl
+	entity.LeaseExpiresAt = boltTerminator.LeaseExpiresAt
+	entity.FailedBy = boltTerminator.FailedBy
+	entity.RestorePrecedence = nil
+	if boltTerminator.RestorePrecedence != "" {
+		entity.RestorePrecedence = xt.GetPrecedenceForName(boltTerminator.RestorePrecedence)
+	}
+	if boltTerminator.HealthCheck != nil {
+		entity.HealthCheck = &TerminatorHealthCheck{
+			Type:           boltTerminator.HealthCheck.Type,
+			Address:        boltTerminator.HealthCheck.Address,
+			Interval:       boltTerminator.HealthCheck.Interval,
+			Timeout:        boltTerminator.HealthCheck.Timeout,
+			ExpectedStatus: boltTerminator.HealthCheck.ExpectedStatus,
+		}
+	}
 	entity.FillCommon(boltTerminator)
 	return nil
 }
@@ -242,28 +370,43 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 		return nil, err
 	}
 
-	var precedence uint32
-	if entity.Precedence != nil {
-		if entity.Precedence.IsFailed() {
-			precedence = 1
-		} else if entity.Precedence.IsRequired() {
-			precedence = 2
-		}
-	}
+	precedence := encodePrecedence(entity.Precedence)
 
 	msg := &cmd_pb.Terminator{
-		Id:             entity.Id,
-		ServiceId:      entity.GetServiceId(),
-		RouterId:       entity.GetRouterId(),
-		Binding:        entity.Binding,
-		Address:        entity.Address,
-		InstanceId:     entity.InstanceId,
-		InstanceSecret: entity.InstanceSecret,
-		Cost:           uint32(entity.Cost),
-		Precedence:     precedence,
-		PeerData:       entity.PeerData,
-		Tags:           tags,
-		HostId:         entity.HostId,
+		Id:                entity.Id,
+		ServiceId:         entity.GetServiceId(),
+		RouterId:          entity.GetRouterId(),
+		Binding:           entity.Binding,
+		Address:           entity.Address,
+		InstanceId:        entity.InstanceId,
+		InstanceSecret:    entity.InstanceSecret,
+		Cost:              uint32(entity.Cost),
+		Precedence:        precedence,
+		PeerData:          entity.PeerData,
+		Tags:              tags,
+		HostId:            entity.HostId,
+		Draining:          entity.Draining,
+		LeaseTtl:          int64(entity.LeaseTtl),
+		FailedBy:          entity.FailedBy,
+		RestorePrecedence: encodePrecedence(entity.RestorePrecedence),
+	}
+
+	if entity.DrainDeadline != nil {
+		msg.DrainDeadline = entity.DrainDeadline.UnixMilli()
+	}
+
+	if entity.LeaseExpiresAt != nil {
+		msg.LeaseExpiresAt = entity.LeaseExpiresAt.UnixMilli()
+	}
+
+	if entity.HealthCheck != nil {
+		msg.HealthCheck = &cmd_pb.TerminatorHealthCheck{
+			Type:           entity.HealthCheck.Type,
+			Address:        entity.HealthCheck.Address,
+			Interval:       int64(entity.HealthCheck.Interval),
+			Timeout:        int64(entity.HealthCheck.Timeout),
+			ExpectedStatus: entity.HealthCheck.ExpectedStatus,
+		}
 	}
 
 	return proto.Marshal(msg)
@@ -275,12 +418,7 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		return nil, err
 	}
 
-	precedence := xt.Precedences.Default
-	if msg.Precedence == 1 {
-		precedence = xt.Precedences.Failed
-	} else if msg.Precedence == 2 {
-		precedence = xt.Precedences.Required
-	}
+	precedence := decodePrecedence(msg.Precedence)
 
 	result := &Terminator{
 		BaseEntity: models.BaseEntity{
@@ -297,11 +435,58 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		Precedence:     precedence,
 		PeerData:       msg.PeerData,
 		HostId:         msg.HostId,
+		Draining:       msg.Draining,
+		LeaseTtl:       time.Duration(msg.LeaseTtl),
+		FailedBy:       msg.FailedBy,
+	}
+
+	if msg.FailedBy != "" {
+		result.RestorePrecedence = decodePrecedence(msg.RestorePrecedence)
+	}
+
+	if msg.DrainDeadline != 0 {
+		drainDeadline := time.UnixMilli(msg.DrainDeadline)
+		result.DrainDeadline = &drainDeadline
+	}
+
+	if msg.LeaseExpiresAt != 0 {
+		leaseExpiresAt := time.UnixMilli(msg.LeaseExpiresAt)
+		result.LeaseExpiresAt = &leaseExpiresAt
+	}
+
+	if msg.HealthCheck != nil {
+		result.HealthCheck = &TerminatorHealthCheck{
+			Type:           msg.HealthCheck.Type,
+			Address:        msg.HealthCheck.Address,
+			Interval:       time.Duration(msg.HealthCheck.Interval),
+			Timeout:        time.Duration(msg.HealthCheck.Timeout),
+			ExpectedStatus: msg.HealthCheck.ExpectedStatus,
+		}
 	}
 
 	return result, nil
 }
 
+func encodePrecedence(precedence xt.Precedence) uint32 {
+	if precedence != nil {
+		if precedence.IsFailed() {
+			return 1
+		} else if precedence.IsRequired() {
+			return 2
+		}
+	}
+	return 0
+}
+
+func decodePrecedence(precedence uint32) xt.Precedence {
+	if precedence == 1 {
+		return xt.Precedences.Failed
+	} else if precedence == 2 {
+		return xt.Precedences.Required
+	}
+	return xt.Precedences.Default
+}
+
 type TerminatorListResult struct {
 	controller *TerminatorManager
 	Entities   []*Terminator
@@ -323,8 +508,18 @@ func (result *TerminatorListResult) collect(tx *bbolt.Tx, ids []string, qmd *mod
 type RoutingTerminator struct {
 	RouteCost uint32
 	*Terminator
+	// precedence, if set, replaces the terminator precedence for this selection, such as when the terminator
+	// router is draining
+	precedence xt.Precedence
 }
 
 func (r *RoutingTerminator) GetRouteCost() uint32 {
 	return r.RouteCost
 }
+
+func (r *RoutingTerminator) GetPrecedence() xt.Precedence {
+	if r.precedence != nil {
+		return r.precedence
+	}
+	return r.Terminator.GetPrecedence()
+}
@@ -0,0 +1,141 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/db"
+)
+
+// TerminatorDrainHandler is notified as circuits using a draining terminator close. The final notification, with
+// zero remaining circuits, is sent just before the terminator is deleted
+type TerminatorDrainHandler interface {
+	TerminatorDrainProgress(terminatorId string, remainingCircuits int64)
+}
+
+// terminatorDrains tracks the circuit count last reported for each draining terminator, so that handlers are only
+// notified when progress changes, and the drained terminators which are being deleted
+type terminatorDrains struct {
+	sync.Mutex
+	remaining map[string]int64
+	deleting  map[string]struct{}
+}
+
+func newTerminatorDrains() *terminatorDrains {
+	return &terminatorDrains{
+		remaining: map[string]int64{},
+		deleting:  map[string]struct{}{},
+	}
+}
+
+func (network *Network) AddTerminatorDrainHandler(h TerminatorDrainHandler) {
+	network.terminatorDrainHandlers = append(network.terminatorDrainHandlers, h)
+}
+
+// processTerminatorDrains reports progress for draining terminators and deletes them once they have no circuits
+// left. Draining terminators are already excluded from terminator selection, so existing circuits are left to
+// finish on their own, unless the drain deadline has passed, in which case they're closed. Circuits are tracked by
+// the controller which created them, so every controller closes its own circuits, but only the leader deletes
+// drained terminators. Deletes are done in the background, so that slow applies don't hold up the network loop.
+func (network *Network) processTerminatorDrains() {
+	result, err := network.Terminators.Query(db.FieldTerminatorDraining + " = true limit none")
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to query draining terminators")
+		return
+	}
+
+	drains := network.terminatorDrains
+	drains.Lock()
+	defer drains.Unlock()
+
+	draining := map[string]*Terminator{}
+	for _, terminator := range result.Entities {
+		draining[terminator.Id] = terminator
+	}
+
+	for terminatorId := range drains.remaining {
+		if _, found := draining[terminatorId]; !found {
+			delete(drains.remaining, terminatorId)
+		}
+	}
+
+	if len(draining) == 0 {
+		return
+	}
+
+	circuitsByTerminator := map[string][]*Circuit{}
+	for _, circuit := range network.circuitController.all() {
+		if circuit.Terminator != nil {
+			if _, found := draining[circuit.Terminator.GetId()]; found {
+				circuitsByTerminator[circuit.Terminator.GetId()] = append(circuitsByTerminator[circuit.Terminator.GetId()], circuit)
+			}
+		}
+	}
+
+	now := time.Now()
+	for terminatorId, terminator := range draining {
+		log := pfxlog.Logger().WithField("terminatorId", terminatorId)
+		circuits := circuitsByTerminator[terminatorId]
+
+		if terminator.DrainDeadline != nil && now.After(*terminator.DrainDeadline) && len(circuits) > 0 {
+			log.WithField("circuits", len(circuits)).Info("terminator drain deadline passed, closing remaining circuits")
+			for _, circuit := range circuits {
+				if err := network.RemoveCircuit(circuit.Id, true); err != nil {
+					log.WithField("circuitId", circuit.Id).WithError(err).Error("unable to close circuit on draining terminator")
+				}
+			}
+			circuits = nil
+			for _, circuit := range circuitsByTerminator[terminatorId] {
+				if _, found := network.circuitController.get(circuit.Id); found {
+					circuits = append(circuits, circuit)
+				}
+			}
+		}
+
+		remaining := int64(len(circuits))
+		if last, found := drains.remaining[terminatorId]; !found || last != remaining {
+			drains.remaining[terminatorId] = remaining
+			for _, h := range network.terminatorDrainHandlers {
+				h.TerminatorDrainProgress(terminatorId, remaining)
+			}
+		}
+
+		if remaining == 0 && network.Dispatcher.IsLeader() {
+			if _, found := drains.deleting[terminatorId]; !found {
+				drains.deleting[terminatorId] = struct{}{}
+				go network.deleteDrainedTerminator(terminatorId)
+			}
+		}
+	}
+}
+
+func (network *Network) deleteDrainedTerminator(terminatorId string) {
+	log := pfxlog.Logger().WithField("terminatorId", terminatorId)
+	log.Info("terminator drained, deleting")
+	if err := network.Terminators.Delete(terminatorId); err != nil {
+		// the terminator is still draining, so the delete will be retried on the next cycle
+		log.WithError(err).Error("unable to delete drained terminator")
+	}
+
+	drains := network.terminatorDrains
+	drains.Lock()
+	defer drains.Unlock()
+	delete(drains.deleting, terminatorId)
+}
@@ -0,0 +1,176 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminatorDrainHandler struct {
+	remaining map[string][]int64
+}
+
+func (self *testTerminatorDrainHandler) TerminatorDrainProgress(terminatorId string, remainingCircuits int64) {
+	self.remaining[terminatorId] = append(self.remaining[terminatorId], remainingCircuits)
+}
+
+func TestTerminatorDrain(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	handler := &testTerminatorDrainHandler{remaining: map[string][]int64{}}
+	network.AddTerminatorDrainHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	newPathTestLink(network, "l0", r0, r1)
+
+	svc := entityHelper.addTestService("svc")
+
+	newTerminator := func(id string) *Terminator {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     r1.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+		}
+		req.NoError(network.Terminators.Create(terminator))
+		return terminator
+	}
+
+	draining := newTerminator("draining")
+	active := newTerminator("active")
+
+	draining.Draining = true
+	req.NoError(network.Terminators.Update(draining, fields.UpdatedFieldsMap{db.FieldTerminatorDraining: struct{}{}}))
+
+	// draining terminators aren't used for new circuits
+	preview, err := network.PreviewRoute(svc.Id, r0.Id, "", "")
+	req.NoError(err)
+	req.NoError(preview.Err)
+	for _, candidate := range preview.Candidates {
+		if candidate.Terminator.Id == draining.Id {
+			req.Equal(TerminatorSkipDraining, candidate.SkipReason)
+		}
+	}
+	req.NotNil(preview.Selected)
+	req.Equal(active.Id, preview.Selected.Terminator.Id)
+
+	circuit := &Circuit{
+		Id:         "c0",
+		Service:    svc,
+		Terminator: &RoutingTerminator{Terminator: draining},
+		Path:       &Path{},
+	}
+	network.circuitController.add(circuit)
+
+	// existing circuits keep the terminator around
+	network.processTerminatorDrains()
+	req.Equal([]int64{1}, handler.remaining[draining.Id])
+	_, err = network.Terminators.Read(draining.Id)
+	req.NoError(err)
+
+	// progress is only reported when it changes
+	network.processTerminatorDrains()
+	req.Equal([]int64{1}, handler.remaining[draining.Id])
+
+	// once the deadline passes, remaining circuits are closed and the terminator is removed
+	deadline := time.Now().Add(-time.Second)
+	draining.DrainDeadline = &deadline
+	req.NoError(network.Terminators.Update(draining, fields.UpdatedFieldsMap{
+		db.FieldTerminatorDraining:      struct{}{},
+		db.FieldTerminatorDrainDeadline: struct{}{},
+	}))
+
+	network.processTerminatorDrains()
+	req.Equal([]int64{1, 0}, handler.remaining[draining.Id])
+	_, found := network.circuitController.get(circuit.Id)
+	req.False(found)
+
+	req.Eventually(func() bool {
+		_, err = network.Terminators.Read(draining.Id)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = network.Terminators.Read(active.Id)
+	req.NoError(err)
+	req.Empty(handler.remaining[active.Id])
+}
+
+func TestTerminatorDrainOnlyDeletedByLeader(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	dispatcher := newTestDispatcher(false)
+	config.dispatcher = dispatcher
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	handler := &testTerminatorDrainHandler{remaining: map[string][]int64{}}
+	network.AddTerminatorDrainHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "draining"},
+		Service:    svc.Id,
+		Router:     r0.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1234",
+		Draining:   true,
+	}
+	req.NoError(network.Terminators.Create(terminator))
+
+	// progress is reported by every controller, but only the leader deletes the drained terminator
+	network.processTerminatorDrains()
+	req.Equal([]int64{0}, handler.remaining[terminator.Id])
+	time.Sleep(50 * time.Millisecond)
+	_, err = network.Terminators.Read(terminator.Id)
+	req.NoError(err)
+
+	dispatcher.leader.Set(true)
+	network.processTerminatorDrains()
+	req.Equal([]int64{0}, handler.remaining[terminator.Id])
+	req.Eventually(func() bool {
+		_, err = network.Terminators.Read(terminator.Id)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+}
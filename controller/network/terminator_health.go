@@ -0,0 +1,232 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/storage/boltz"
+)
+
+// TerminatorHealthStatus is the result of the most recent health check of a terminator, as reported by the router
+// hosting it
+type TerminatorHealthStatus struct {
+	Healthy   bool
+	Message   string
+	CheckedAt time.Time
+}
+
+// TerminatorHealthHandler is notified when a terminator health check starts failing, or passes again
+type TerminatorHealthHandler interface {
+	TerminatorHealthChanged(terminatorId string, status *TerminatorHealthStatus)
+}
+
+// terminatorHealth tracks the last reported health check result for each terminator. Results aren't persisted, as
+// routers report them on every check. Whether a terminator was failed by its health check, and the precedence to
+// restore, is stored with the terminator, so it survives controller restarts.
+type terminatorHealth struct {
+	sync.Mutex
+	states map[string]*TerminatorHealthStatus
+}
+
+func newTerminatorHealth() *terminatorHealth {
+	return &terminatorHealth{
+		states: map[string]*TerminatorHealthStatus{},
+	}
+}
+
+func (self *terminatorHealth) remove(terminatorId string) {
+	self.Lock()
+	defer self.Unlock()
+	delete(self.states, terminatorId)
+}
+
+func (network *Network) AddTerminatorHealthHandler(h TerminatorHealthHandler) {
+	network.terminatorHealthHandlers = append(network.terminatorHealthHandlers, h)
+}
+
+// GetTerminatorHealthStatus returns the last health check result reported for the terminator, or nil if the
+// terminator doesn't have a health check, or no result has been reported yet
+func (network *Network) GetTerminatorHealthStatus(terminatorId string) *TerminatorHealthStatus {
+	health := network.terminatorHealth
+	health.Lock()
+	defer health.Unlock()
+	return health.states[terminatorId]
+}
+
+// HandleTerminatorHealthStatus records a health check result reported by a router. When a terminator starts failing
+// its health check it's given failed precedence. When the check passes again, the previous precedence is restored.
+// Precedence which was set to failed by something other than the health check is left alone.
+func (network *Network) HandleTerminatorHealthStatus(r *Router, msg *ctrl_pb.TerminatorHealthStatus) {
+	log := pfxlog.Logger().WithField("routerId", r.Id).WithField("terminatorId", msg.TerminatorId)
+
+	terminator, err := network.Terminators.Read(msg.TerminatorId)
+	if err != nil || terminator.Router != r.Id || terminator.HealthCheck == nil {
+		log.Info("health status reported for unknown terminator or terminator without health check, stopping check")
+		network.sendTerminatorHealthChecks(r, &ctrl_pb.TerminatorHealthChecks{
+			RemovedTerminatorIds: []string{msg.TerminatorId},
+		})
+		return
+	}
+
+	status := &TerminatorHealthStatus{
+		Healthy:   msg.Healthy,
+		Message:   msg.Message,
+		CheckedAt: time.UnixMilli(msg.CheckedAt),
+	}
+
+	health := network.terminatorHealth
+	health.Lock()
+	last, found := health.states[terminator.Id]
+	health.states[terminator.Id] = status
+	health.Unlock()
+
+	// without a previous result, such as after a controller restart, the stored marker says whether the last
+	// result was failing
+	wasHealthy := !terminator.Precedence.IsFailed() || terminator.FailedBy != TerminatorFailedByHealthCheck
+	if found {
+		wasHealthy = last.Healthy
+	}
+
+	if wasHealthy == status.Healthy {
+		return
+	}
+
+	oldPrecedence := terminator.Precedence
+	var precedenceChanged bool
+	if status.Healthy {
+		log.Info("terminator health check passing")
+		precedenceChanged, err = network.Terminators.restorePrecedence(terminator, TerminatorFailedByHealthCheck)
+	} else {
+		log.WithField("message", status.Message).Warn("terminator health check failing")
+		precedenceChanged, err = network.Terminators.failPrecedence(terminator, TerminatorFailedByHealthCheck)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("unable to update terminator precedence based on health check")
+	} else if precedenceChanged {
+		log.Infof("changed terminator precedence from %v to %v based on health check", oldPrecedence, terminator.Precedence)
+	}
+
+	for _, h := range network.terminatorHealthHandlers {
+		h.TerminatorHealthChanged(terminator.Id, status)
+	}
+}
+
+// syncTerminatorHealthChecks sends a router the health checks for all the terminators it hosts
+func (network *Network) syncTerminatorHealthChecks(r *Router) {
+	result, err := network.Terminators.Query(fmt.Sprintf(`router.id = "%v" limit none`, r.Id))
+	if err != nil {
+		pfxlog.Logger().WithField("routerId", r.Id).WithError(err).Error("failed to get terminators for health check sync")
+		return
+	}
+
+	msg := &ctrl_pb.TerminatorHealthChecks{
+		FullSync: true,
+	}
+	for _, terminator := range result.Entities {
+		if terminator.HealthCheck != nil {
+			msg.Checks = append(msg.Checks, newTerminatorHealthCheckMsg(terminator))
+		}
+	}
+
+	network.sendTerminatorHealthChecks(r, msg)
+}
+
+// terminatorHealthCheckChanged lets the router hosting a created or updated terminator know about changes to its
+// health check
+func (network *Network) terminatorHealthCheckChanged(params ...interface{}) {
+	for _, param := range params {
+		boltTerminator, ok := param.(*db.Terminator)
+		if !ok {
+			continue
+		}
+
+		terminator, err := network.Terminators.Read(boltTerminator.Id)
+		if err != nil {
+			continue
+		}
+
+		r := network.Routers.getConnected(terminator.Router)
+		if r == nil {
+			continue
+		}
+
+		msg := &ctrl_pb.TerminatorHealthChecks{}
+		if terminator.HealthCheck == nil {
+			network.terminatorHealth.remove(terminator.Id)
+			msg.RemovedTerminatorIds = []string{terminator.Id}
+		} else {
+			msg.Checks = []*ctrl_pb.TerminatorHealthChecks_Check{newTerminatorHealthCheckMsg(terminator)}
+		}
+		network.sendTerminatorHealthChecks(r, msg)
+	}
+}
+
+// terminatorHealthCheckDeleted stops health checks for deleted terminators
+func (network *Network) terminatorHealthCheckDeleted(params ...interface{}) {
+	for _, param := range params {
+		terminator, ok := param.(*db.Terminator)
+		if !ok {
+			continue
+		}
+
+		network.terminatorHealth.remove(terminator.Id)
+
+		if terminator.HealthCheck != nil {
+			if r := network.Routers.getConnected(terminator.Router); r != nil {
+				network.sendTerminatorHealthChecks(r, &ctrl_pb.TerminatorHealthChecks{
+					RemovedTerminatorIds: []string{terminator.Id},
+				})
+			}
+		}
+	}
+}
+
+func (network *Network) initTerminatorHealthChecks() {
+	network.GetStores().Terminator.On(boltz.EventCreate, network.terminatorHealthCheckChanged)
+	network.GetStores().Terminator.On(boltz.EventUpdate, network.terminatorHealthCheckChanged)
+	network.GetStores().Terminator.On(boltz.EventDelete, network.terminatorHealthCheckDeleted)
+}
+
+func (network *Network) sendTerminatorHealthChecks(r *Router, msg *ctrl_pb.TerminatorHealthChecks) {
+	if r.Control == nil {
+		return
+	}
+	if err := protobufs.MarshalTyped(msg).Send(r.Control); err != nil {
+		pfxlog.Logger().WithField("routerId", r.Id).WithError(err).Error("error sending terminator health checks to router")
+	}
+}
+
+func newTerminatorHealthCheckMsg(terminator *Terminator) *ctrl_pb.TerminatorHealthChecks_Check {
+	return &ctrl_pb.TerminatorHealthChecks_Check{
+		TerminatorId:      terminator.Id,
+		Binding:           terminator.Binding,
+		TerminatorAddress: terminator.Address,
+		Type:              terminator.HealthCheck.Type,
+		Address:           terminator.HealthCheck.Address,
+		Interval:          int64(terminator.HealthCheck.Interval),
+		Timeout:           int64(terminator.HealthCheck.Timeout),
+		ExpectedStatus:    terminator.HealthCheck.ExpectedStatus,
+	}
+}
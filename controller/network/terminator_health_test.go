@@ -0,0 +1,202 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminatorHealthHandler struct {
+	changes []*TerminatorHealthStatus
+}
+
+func (self *testTerminatorHealthHandler) TerminatorHealthChanged(_ string, status *TerminatorHealthStatus) {
+	self.changes = append(self.changes, status)
+}
+
+func TestTerminatorHealthStatusChangesPrecedence(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	handler := &testTerminatorHealthHandler{}
+	network.AddTerminatorHealthHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "health-checked"},
+		Service:    svc.Id,
+		Router:     r0.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1234",
+		Precedence: xt.Precedences.Required,
+		HealthCheck: &TerminatorHealthCheck{
+			Type: xt.HealthCheckTypeTcp,
+		},
+	}
+	req.NoError(network.Terminators.Create(terminator))
+	req.Nil(network.GetTerminatorHealthStatus(terminator.Id))
+
+	network.HandleTerminatorHealthStatus(r0, &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminator.Id,
+		Healthy:      true,
+		CheckedAt:    time.Now().UnixMilli(),
+	})
+	req.Empty(handler.changes)
+	req.True(network.GetTerminatorHealthStatus(terminator.Id).Healthy)
+
+	network.HandleTerminatorHealthStatus(r0, &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminator.Id,
+		Healthy:      false,
+		Message:      "connection refused",
+		CheckedAt:    time.Now().UnixMilli(),
+	})
+	req.Len(handler.changes, 1)
+	req.False(handler.changes[0].Healthy)
+	req.Equal("connection refused", network.GetTerminatorHealthStatus(terminator.Id).Message)
+
+	loaded, err := network.Terminators.Read(terminator.Id)
+	req.NoError(err)
+	req.True(loaded.Precedence.IsFailed())
+
+	// repeated failures don't generate further changes
+	network.HandleTerminatorHealthStatus(r0, &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminator.Id,
+		Healthy:      false,
+		CheckedAt:    time.Now().UnixMilli(),
+	})
+	req.Len(handler.changes, 1)
+
+	network.HandleTerminatorHealthStatus(r0, &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminator.Id,
+		Healthy:      true,
+		CheckedAt:    time.Now().UnixMilli(),
+	})
+	req.Len(handler.changes, 2)
+	req.True(handler.changes[1].Healthy)
+
+	loaded, err = network.Terminators.Read(terminator.Id)
+	req.NoError(err)
+	req.True(loaded.Precedence.IsRequired())
+
+	// status reported by a router which doesn't host the terminator is ignored
+	r1 := entityHelper.addTestRouter()
+	network.HandleTerminatorHealthStatus(r1, &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminator.Id,
+		Healthy:      false,
+		CheckedAt:    time.Now().UnixMilli(),
+	})
+	req.Len(handler.changes, 2)
+	req.True(network.GetTerminatorHealthStatus(terminator.Id).Healthy)
+}
+
+func TestTerminatorHealthRestoresPrecedenceAfterRestart(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	handler := &testTerminatorHealthHandler{}
+	network.AddTerminatorHealthHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	newTerminator := func(id string, precedence xt.Precedence) *Terminator {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     r0.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+			Precedence: precedence,
+			HealthCheck: &TerminatorHealthCheck{
+				Type: xt.HealthCheckTypeTcp,
+			},
+		}
+		req.NoError(network.Terminators.Create(terminator))
+		return terminator
+	}
+
+	report := func(terminator *Terminator, healthy bool) {
+		network.HandleTerminatorHealthStatus(r0, &ctrl_pb.TerminatorHealthStatus{
+			TerminatorId: terminator.Id,
+			Healthy:      healthy,
+			CheckedAt:    time.Now().UnixMilli(),
+		})
+	}
+
+	requirePrecedence := func(terminator *Terminator, precedence xt.Precedence) {
+		loaded, err := network.Terminators.Read(terminator.Id)
+		req.NoError(err)
+		req.Equal(precedence, loaded.Precedence)
+	}
+
+	healthFailed := newTerminator("health-failed", xt.Precedences.Required)
+	report(healthFailed, false)
+	requirePrecedence(healthFailed, xt.Precedences.Failed)
+	req.Len(handler.changes, 1)
+
+	// the controller restarts, losing the last reported results
+	network.terminatorHealth.remove(healthFailed.Id)
+
+	// repeated failures still don't generate further changes
+	report(healthFailed, false)
+	req.Len(handler.changes, 1)
+
+	network.terminatorHealth.remove(healthFailed.Id)
+	report(healthFailed, true)
+	req.Len(handler.changes, 2)
+	req.True(handler.changes[1].Healthy)
+	requirePrecedence(healthFailed, xt.Precedences.Required)
+
+	// failed precedence set by something other than the health check is left alone
+	operatorFailed := newTerminator("operator-failed", xt.Precedences.Failed)
+	report(operatorFailed, true)
+	req.Len(handler.changes, 2)
+	requirePrecedence(operatorFailed, xt.Precedences.Failed)
+
+	report(operatorFailed, false)
+	req.Len(handler.changes, 3)
+	report(operatorFailed, true)
+	req.Len(handler.changes, 4)
+	requirePrecedence(operatorFailed, xt.Precedences.Failed)
+}
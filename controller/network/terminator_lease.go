@@ -0,0 +1,157 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"go.etcd.io/bbolt"
+)
+
+// MinTerminatorLeaseTtl is the shortest lease a terminator may be created with. Expired leases are only checked once
+// per network cycle, so much shorter leases wouldn't be enforced accurately anyway
+const MinTerminatorLeaseTtl = 5 * time.Second
+
+// terminatorLeases tracks leased terminators without a stored expiry, which were created before lease expiries
+// were stored, and the expired terminators which are being deleted. Leases are only expired by the leader, and
+// terminators without a stored expiry are given a full ttl from when the leader first sees them.
+type terminatorLeases struct {
+	sync.Mutex
+	firstSeen map[string]time.Time
+	deleting  map[string]struct{}
+}
+
+func newTerminatorLeases() *terminatorLeases {
+	return &terminatorLeases{
+		firstSeen: map[string]time.Time{},
+		deleting:  map[string]struct{}{},
+	}
+}
+
+// RenewTerminatorLeases extends the leases of the given terminators by their ttl. Renewals for terminators which
+// don't exist, don't have a lease, or are hosted by a different router are ignored. Renewals arrive at the
+// controller the router is connected to, so the new expiry is stored, making it visible to the leader. To limit
+// writes, it's only stored once less than half the ttl remains.
+func (network *Network) RenewTerminatorLeases(r *Router, msg *ctrl_pb.TerminatorLeaseRenewals) {
+	now := time.Now()
+
+	var renewed []*Terminator
+	err := network.GetDb().View(func(tx *bbolt.Tx) error {
+		for _, terminatorId := range msg.TerminatorIds {
+			terminator, err := network.Terminators.readInTx(tx, terminatorId)
+			if err != nil {
+				pfxlog.Logger().WithField("routerId", r.Id).WithField("terminatorId", terminatorId).
+					Debug("lease renewal for unknown terminator")
+				continue
+			}
+			if terminator.Router != r.Id || terminator.LeaseTtl <= 0 {
+				continue
+			}
+			if terminator.LeaseExpiresAt == nil || terminator.LeaseExpiresAt.Sub(now) < terminator.LeaseTtl/2 {
+				renewed = append(renewed, terminator)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithField("routerId", r.Id).WithError(err).Error("unable to renew terminator leases")
+		return
+	}
+
+	for _, terminator := range renewed {
+		leaseExpiresAt := now.Add(terminator.LeaseTtl)
+		terminator.LeaseExpiresAt = &leaseExpiresAt
+		if err = network.Terminators.Update(terminator, fields.UpdatedFieldsMap{db.FieldTerminatorLeaseExpiresAt: struct{}{}}); err != nil {
+			pfxlog.Logger().WithField("routerId", r.Id).WithField("terminatorId", terminator.Id).
+				WithError(err).Error("unable to renew terminator lease")
+		}
+	}
+}
+
+// processTerminatorLeases deletes terminators whose leases have expired. Only the leader expires leases, and deletes
+// are done in the background, so that slow applies don't hold up the network loop.
+func (network *Network) processTerminatorLeases() {
+	leases := network.terminatorLeases
+
+	if !network.Dispatcher.IsLeader() {
+		// if this controller becomes the leader, terminators without a stored expiry get a full ttl again
+		leases.Lock()
+		leases.firstSeen = map[string]time.Time{}
+		leases.Unlock()
+		return
+	}
+
+	result, err := network.Terminators.Query(db.FieldTerminatorLeaseTtl + " > 0 limit none")
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to query leased terminators")
+		return
+	}
+
+	now := time.Now()
+	leases.Lock()
+	defer leases.Unlock()
+
+	current := map[string]struct{}{}
+	for _, terminator := range result.Entities {
+		current[terminator.Id] = struct{}{}
+
+		expiresAt := terminator.LeaseExpiresAt
+		if expiresAt == nil {
+			firstSeen, found := leases.firstSeen[terminator.Id]
+			if !found {
+				firstSeen = now
+				leases.firstSeen[terminator.Id] = firstSeen
+			}
+			firstSeenExpiry := firstSeen.Add(terminator.LeaseTtl)
+			expiresAt = &firstSeenExpiry
+		}
+
+		if now.After(*expiresAt) {
+			if _, found := leases.deleting[terminator.Id]; !found {
+				leases.deleting[terminator.Id] = struct{}{}
+				go network.deleteExpiredTerminator(terminator)
+			}
+		}
+	}
+
+	for terminatorId := range leases.firstSeen {
+		if _, found := current[terminatorId]; !found {
+			delete(leases.firstSeen, terminatorId)
+		}
+	}
+}
+
+func (network *Network) deleteExpiredTerminator(terminator *Terminator) {
+	log := pfxlog.Logger().WithField("terminatorId", terminator.Id).WithField("routerId", terminator.Router)
+	log.WithField("leaseTtl", terminator.LeaseTtl).Info("terminator lease expired, deleting")
+	if err := network.Terminators.DeleteWithCause(terminator.Id, event.TerminatorCauseExpired); err != nil {
+		// if the terminator still exists, the delete will be retried on the next cycle
+		log.WithError(err).Error("unable to delete terminator with expired lease")
+	}
+
+	leases := network.terminatorLeases
+	leases.Lock()
+	defer leases.Unlock()
+	delete(leases.deleting, terminator.Id)
+}
@@ -0,0 +1,211 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminatorLeaseExpiry(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	newTerminator := func(id string, leaseTtl time.Duration) *Terminator {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     r0.Id,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+			LeaseTtl:   leaseTtl,
+		}
+		req.NoError(network.Terminators.Create(terminator))
+		return terminator
+	}
+
+	// the cause is carried on the deleted entity, so every controller applying the delete can report it
+	deleteCauses := make(chan string, 1)
+	network.GetStores().Terminator.On(boltz.EventDelete, func(params ...interface{}) {
+		for _, param := range params {
+			if terminator, ok := param.(*db.Terminator); ok {
+				deleteCauses <- terminator.DeleteCause
+			}
+		}
+	})
+
+	leased := newTerminator("leased", MinTerminatorLeaseTtl)
+	unleased := newTerminator("unleased", 0)
+
+	leaseExpiry := func(terminator *Terminator) *time.Time {
+		loaded, err := network.Terminators.Read(terminator.Id)
+		req.NoError(err)
+		return loaded.LeaseExpiresAt
+	}
+
+	setLeaseExpiry := func(terminator *Terminator, expiresAt *time.Time) {
+		terminator.LeaseExpiresAt = expiresAt
+		req.NoError(network.Terminators.Update(terminator, fields.UpdatedFieldsMap{db.FieldTerminatorLeaseExpiresAt: struct{}{}}))
+	}
+
+	// leases start when the terminator is created
+	req.NotNil(leaseExpiry(leased))
+	req.True(leaseExpiry(leased).After(time.Now()))
+	req.Nil(leaseExpiry(unleased))
+
+	expired := time.Now().Add(-time.Second)
+
+	// renewals from a router which doesn't host the terminator are ignored
+	setLeaseExpiry(leased, &expired)
+	network.RenewTerminatorLeases(r1, &ctrl_pb.TerminatorLeaseRenewals{TerminatorIds: []string{leased.Id, unleased.Id, "unknown"}})
+	req.True(leaseExpiry(leased).Before(time.Now()))
+
+	network.RenewTerminatorLeases(r0, &ctrl_pb.TerminatorLeaseRenewals{TerminatorIds: []string{leased.Id, unleased.Id, "unknown"}})
+	renewedExpiry := leaseExpiry(leased)
+	req.True(renewedExpiry.After(time.Now()))
+	req.Nil(leaseExpiry(unleased))
+
+	// while more than half the ttl remains, renewals aren't stored
+	network.RenewTerminatorLeases(r0, &ctrl_pb.TerminatorLeaseRenewals{TerminatorIds: []string{leased.Id}})
+	req.True(renewedExpiry.Equal(*leaseExpiry(leased)))
+
+	network.processTerminatorLeases()
+	time.Sleep(50 * time.Millisecond)
+	_, err = network.Terminators.Read(leased.Id)
+	req.NoError(err)
+
+	// terminators without a stored expiry get a full ttl from when they're first seen
+	setLeaseExpiry(leased, nil)
+	network.processTerminatorLeases()
+	time.Sleep(50 * time.Millisecond)
+	_, err = network.Terminators.Read(leased.Id)
+	req.NoError(err)
+
+	network.terminatorLeases.Lock()
+	network.terminatorLeases.firstSeen[leased.Id] = time.Now().Add(-2 * MinTerminatorLeaseTtl)
+	network.terminatorLeases.Unlock()
+
+	network.processTerminatorLeases()
+	req.Eventually(func() bool {
+		_, err = network.Terminators.Read(leased.Id)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case cause := <-deleteCauses:
+		req.Equal(event.TerminatorCauseExpired, cause)
+	case <-time.After(time.Second):
+		req.Fail("no delete event for expired terminator")
+	}
+
+	_, err = network.Terminators.Read(unleased.Id)
+	req.NoError(err)
+
+	// terminators deleted for other reasons don't report a cause
+	req.NoError(network.Terminators.Delete(unleased.Id))
+	select {
+	case cause := <-deleteCauses:
+		req.Equal("", cause)
+	case <-time.After(time.Second):
+		req.Fail("no delete event for deleted terminator")
+	}
+}
+
+func TestTerminatorLeaseRenewedThroughOtherController(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	// two controllers sharing the same data, standing in for a cluster. The router is connected to the controller
+	// which isn't the leader, so only that one receives renewals
+	hostingConfig := newTestConfig(ctx)
+	defer close(hostingConfig.closeNotify)
+	hostingConfig.dispatcher = newTestDispatcher(false)
+
+	hosting, err := NewNetwork(hostingConfig)
+	req.NoError(err)
+
+	leaderConfig := newTestConfig(ctx)
+	defer close(leaderConfig.closeNotify)
+	leaderConfig.dispatcher = newTestDispatcher(true)
+
+	leader, err := NewNetwork(leaderConfig)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, hosting)
+	r0 := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("svc")
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "leased"},
+		Service:    svc.Id,
+		Router:     r0.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1234",
+		LeaseTtl:   MinTerminatorLeaseTtl,
+	}
+	req.NoError(hosting.Terminators.Create(terminator))
+
+	// the lease is close to expiring, and the hosting controller gets a renewal
+	expiresSoon := time.Now().Add(time.Second)
+	terminator.LeaseExpiresAt = &expiresSoon
+	req.NoError(hosting.Terminators.Update(terminator, fields.UpdatedFieldsMap{db.FieldTerminatorLeaseExpiresAt: struct{}{}}))
+	hosting.RenewTerminatorLeases(r0, &ctrl_pb.TerminatorLeaseRenewals{TerminatorIds: []string{terminator.Id}})
+
+	// the leader sees the renewal
+	loaded, err := leader.Terminators.Read(terminator.Id)
+	req.NoError(err)
+	req.True(loaded.LeaseExpiresAt.After(expiresSoon))
+
+	// once the lease expires, only the leader deletes the terminator
+	expired := time.Now().Add(-time.Second)
+	terminator.LeaseExpiresAt = &expired
+	req.NoError(hosting.Terminators.Update(terminator, fields.UpdatedFieldsMap{db.FieldTerminatorLeaseExpiresAt: struct{}{}}))
+
+	hosting.processTerminatorLeases()
+	time.Sleep(50 * time.Millisecond)
+	_, err = leader.Terminators.Read(terminator.Id)
+	req.NoError(err)
+
+	leader.processTerminatorLeases()
+	req.Eventually(func() bool {
+		_, err = leader.Terminators.Read(terminator.Id)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,41 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt
+
+import "time"
+
+const (
+	// HealthCheckTypeTcp checks terminator health by opening a tcp connection to the health check address
+	HealthCheckTypeTcp = "tcp"
+
+	// HealthCheckTypeHttp checks terminator health with an http GET of the health check address, expecting a given
+	// status code
+	HealthCheckTypeHttp = "http"
+
+	// HealthCheckTypeBinding delegates terminator health checks to the xgress binding hosting the terminator
+	HealthCheckTypeBinding = "binding"
+
+	DefaultHealthCheckInterval       = 30 * time.Second
+	DefaultHealthCheckTimeout        = 5 * time.Second
+	MinHealthCheckInterval           = time.Second
+	DefaultHealthCheckExpectedStatus = 200
+)
+
+// IsValidHealthCheckType returns true if the given value is a supported terminator health check type
+func IsValidHealthCheckType(checkType string) bool {
+	return checkType == HealthCheckTypeTcp || checkType == HealthCheckTypeHttp || checkType == HealthCheckTypeBinding
+}
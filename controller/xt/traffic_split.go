@@ -0,0 +1,70 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt
+
+import "fmt"
+
+// TrafficSplit divides the circuits for a service between groups of terminators, in proportion to the group weights.
+// It's configured on the service and used by the split terminator strategy
+type TrafficSplit struct {
+	Groups []*TrafficSplitGroup
+	// StickyClients, if set, sends circuits from a given client to the same group, for as long as the weights and
+	// the set of groups with usable terminators don't change
+	StickyClients bool
+}
+
+// TrafficSplitGroup is a weighted group of terminators. Terminators belong to the first group whose tags they all have
+type TrafficSplitGroup struct {
+	Name           string
+	Weight         uint32
+	TerminatorTags map[string]string
+}
+
+// Matches returns true if the terminator has all the group terminator tags
+func (self *TrafficSplitGroup) Matches(terminator Terminator) bool {
+	var tags map[string]interface{}
+	if tagged, ok := terminator.(TaggedTerminator); ok {
+		tags = tagged.GetTags()
+	}
+	for k, v := range self.TerminatorTags {
+		tagValue, found := tags[k]
+		if !found || tagValue == nil || fmt.Sprintf("%v", tagValue) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// GetGroup returns the group the terminator belongs to, or nil if it doesn't belong to any group
+func (self *TrafficSplit) GetGroup(terminator Terminator) *TrafficSplitGroup {
+	if self == nil {
+		return nil
+	}
+	for _, group := range self.Groups {
+		if group.Matches(terminator) {
+			return group
+		}
+	}
+	return nil
+}
+
+// TrafficSplitParams is implemented by CreateCircuitParams which can provide the traffic split configured on the
+// service being dialed
+type TrafficSplitParams interface {
+	CreateCircuitParams
+	GetTrafficSplit() *TrafficSplit
+}
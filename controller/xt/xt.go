@@ -45,6 +45,13 @@ type Terminator interface {
 	GetHostId() string
 }
 
+// TaggedTerminator is implemented by terminators which can report their tags. It's optional, so that existing
+// Terminator implementations keep working. Terminators which don't implement it are treated as having no tags
+type TaggedTerminator interface {
+	Terminator
+	GetTags() map[string]interface{}
+}
+
 type PeerData map[uint32][]byte
 
 type CostedTerminator interface {
@@ -60,12 +67,57 @@ type StrategyChangeEvent interface {
 	GetRemoved() []Terminator
 }
 
+// CreateCircuitParams gives strategies access to details of the circuit request being serviced, so that they
+// can make selections based on who is dialing
+type CreateCircuitParams interface {
+	GetClientId() string
+	GetCircuitTags() map[string]string
+}
+
+// Locality describes where a router is deployed. Routers are placed using their region and zone tags
+type Locality struct {
+	Region string
+	Zone   string
+}
+
+// LocalityAwareParams is implemented by CreateCircuitParams which can report which router the circuit ingresses at and
+// where the routers hosting terminators are deployed, so that strategies can prefer nearby terminators
+type LocalityAwareParams interface {
+	CreateCircuitParams
+	GetSourceRouterId() string
+	GetRouterLocality(routerId string) Locality
+}
+
 type Strategy interface {
 	Select(terminators []CostedTerminator) (CostedTerminator, error)
 	HandleTerminatorChange(event StrategyChangeEvent) error
 	NotifyEvent(event TerminatorEvent)
 }
 
+// CircuitParamsStrategy is implemented by strategies which make their selection based on the circuit request being
+// serviced. It's optional, so existing strategies keep working unchanged. If implemented, SelectForCircuit is used
+// instead of Select
+type CircuitParamsStrategy interface {
+	Strategy
+	SelectForCircuit(params CreateCircuitParams, terminators []CostedTerminator) (CostedTerminator, error)
+}
+
+// SelectTerminator selects a terminator using the given strategy, passing the circuit request details to strategies
+// which make use of them
+func SelectTerminator(strategy Strategy, params CreateCircuitParams, terminators []CostedTerminator) (CostedTerminator, error) {
+	if paramsStrategy, ok := strategy.(CircuitParamsStrategy); ok {
+		return paramsStrategy.SelectForCircuit(params, terminators)
+	}
+	return strategy.Select(terminators)
+}
+
+// InspectableStrategy is implemented by strategies which can report their internal state, such as per terminator
+// circuit counts, through the inspect framework
+type InspectableStrategy interface {
+	Strategy
+	Inspect() interface{}
+}
+
 type Precedence interface {
 	fmt.Stringer
 	getMinCost() uint32
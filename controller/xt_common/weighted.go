@@ -0,0 +1,57 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_common
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"math/rand"
+)
+
+// SelectWeighted does random selection of the given terminators in proportion to their costs, so that a terminator
+// with twice the cost of another should be selected roughly half as often
+func SelectWeighted(terminators []xt.CostedTerminator) xt.CostedTerminator {
+	if len(terminators) == 1 {
+		return terminators[0]
+	}
+
+	var costIdx []float32
+	totalCost := float32(0)
+	for _, t := range terminators {
+		unbiasedCost := float32(t.GetPrecedence().Unbias(t.GetRouteCost()))
+		if unbiasedCost == 0 {
+			unbiasedCost = 1
+		}
+		costIdx = append(costIdx, unbiasedCost)
+		totalCost += unbiasedCost
+	}
+
+	total := float32(0)
+	for idx, cost := range costIdx {
+		total += 1 - (cost / totalCost)
+		costIdx[idx] = total
+	}
+
+	// the weights sum to one less than the number of terminators, so scale the selection to cover all of them
+	selected := rand.Float32() * total
+	for idx, cost := range costIdx {
+		if selected < cost {
+			return terminators[idx]
+		}
+	}
+
+	return terminators[0]
+}
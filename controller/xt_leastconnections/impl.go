@@ -0,0 +1,119 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastconnections
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"github.com/orcaman/concurrent-map/v2"
+	"math"
+	"time"
+)
+
+const (
+	Name = "leastconnections"
+)
+
+/**
+The leastconnections strategy tracks the number of active circuits on each terminator and picks the terminator with
+the fewest active circuits. It only picks from terminators which match the precedence of the first terminator, which
+is presumably of the highest available precedence. When several terminators have the same number of active circuits,
+the one with the lowest route cost is used. Dial failures increase the dynamic cost of a terminator, the same way
+they do for smartrouting, so that terminators which are failing lose ties.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		circuitCounts: cmap.New[int64](),
+	}
+	strategy.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	circuitCounts cmap.ConcurrentMap[int64]
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	selected := terminators[0]
+	selectedCount := self.getCircuitCount(selected.GetId())
+	for _, t := range terminators[1:] {
+		if count := self.getCircuitCount(t.GetId()); count < selectedCount {
+			selected = t
+			selectedCount = count
+		}
+	}
+	return selected, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.CostVisitor.VisitDialSucceeded(event)
+	self.circuitCounts.Upsert(event.GetTerminator().GetId(), 1, func(exist bool, valueInMap int64, newValue int64) int64 {
+		if exist {
+			return valueInMap + newValue
+		}
+		return newValue
+	})
+}
+
+func (self *strategy) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	self.CostVisitor.VisitCircuitRemoved(event)
+	self.circuitCounts.Upsert(event.GetTerminator().GetId(), 0, func(exist bool, valueInMap int64, newValue int64) int64 {
+		if exist && valueInMap > 0 {
+			return valueInMap - 1
+		}
+		return 0
+	})
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.circuitCounts.Remove(t.GetId())
+	}
+	return nil
+}
+
+// getCircuitCount returns the number of active circuits using the given terminator
+func (self *strategy) getCircuitCount(terminatorId string) int64 {
+	count, _ := self.circuitCounts.Get(terminatorId)
+	return count
+}
+
+// Inspect returns the number of active circuits for each terminator which has been used by this strategy
+func (self *strategy) Inspect() interface{} {
+	return self.circuitCounts.Items()
+}
@@ -0,0 +1,73 @@
+package xt_leastconnections
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id         string
+	precedence xt.Precedence
+	routeCost  uint32
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return self.precedence
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return self.routeCost
+}
+
+func TestSelectLeastConnections(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+
+	t1 := &testTerminator{id: "t1", precedence: xt.Precedences.Default, routeCost: 10}
+	t2 := &testTerminator{id: "t2", precedence: xt.Precedences.Default, routeCost: 20}
+	t3 := &testTerminator{id: "t3", precedence: xt.Precedences.Failed, routeCost: 30}
+	terminators := []xt.CostedTerminator{t1, t2, t3}
+
+	// ties go to the lowest route cost
+	selected, err := s.Select(terminators)
+	req.NoError(err)
+	req.Equal("t1", selected.GetId())
+
+	s.NotifyEvent(xt.NewDialSucceeded(t1))
+	selected, err = s.Select(terminators)
+	req.NoError(err)
+	req.Equal("t2", selected.GetId())
+
+	s.NotifyEvent(xt.NewDialSucceeded(t2))
+	s.NotifyEvent(xt.NewDialSucceeded(t2))
+	selected, err = s.Select(terminators)
+	req.NoError(err)
+	req.Equal("t1", selected.GetId())
+
+	// terminators outside the best precedence tier are never picked, even if they have no circuits
+	s.NotifyEvent(xt.NewDialSucceeded(t1))
+	s.NotifyEvent(xt.NewDialSucceeded(t1))
+	selected, err = s.Select(terminators)
+	req.NoError(err)
+	req.Equal("t2", selected.GetId())
+
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	req.Equal(int64(1), s.getCircuitCount("t1"))
+	req.Equal(map[string]int64{"t1": 1, "t2": 2}, s.Inspect())
+
+	// removals never drive the count negative
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	req.Equal(int64(0), s.getCircuitCount("t1"))
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, []xt.Terminator{t2})))
+	req.Equal(int64(0), s.getCircuitCount("t2"))
+}
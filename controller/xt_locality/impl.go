@@ -0,0 +1,151 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_locality
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"math"
+	"time"
+)
+
+const (
+	Name = "locality"
+
+	// SaturatedCost is the dynamic cost at which a terminator is considered saturated. Dynamic costs grow with the
+	// number of open circuits and with dial failures. Static costs are set by operators to weight terminators, so
+	// they aren't taken into account
+	SaturatedCost = math.MaxUint16 / 8
+)
+
+const (
+	tierSameRouter = iota
+	tierSameZone
+	tierSameRegion
+	tierAnywhere
+	tierCount
+)
+
+/**
+The locality strategy groups terminators into tiers based on where their hosting router is deployed relative to the
+router the circuit ingresses at: the same router, the same zone, the same region and anywhere else. Router locality
+comes from the region and zone router tags. Terminators are picked from the closest tier which has a terminator that
+isn't saturated, using the same cost weighted selection as the weighted strategy. Only terminators which match the
+highest available precedence are considered, so failed terminators are only used if there's nothing else, and
+required terminators are always preferred, regardless of where they are. If every terminator is saturated, the
+closest tier is used.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+	}
+	strategy.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return self.SelectForCircuit(nil, terminators)
+}
+
+func (self *strategy) SelectForCircuit(params xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	localityParams, ok := params.(xt.LocalityAwareParams)
+	if !ok {
+		return xt_common.SelectWeighted(terminators), nil
+	}
+
+	sourceRouterId := localityParams.GetSourceRouterId()
+	source := localityParams.GetRouterLocality(sourceRouterId)
+
+	var tiers [tierCount][]xt.CostedTerminator
+	var saturatedTiers [tierCount][]xt.CostedTerminator
+	for _, t := range terminators {
+		tier := tierSameRouter
+		if t.GetRouterId() != sourceRouterId {
+			tier = getTier(source, localityParams.GetRouterLocality(t.GetRouterId()))
+		}
+		if isSaturated(t) {
+			saturatedTiers[tier] = append(saturatedTiers[tier], t)
+		} else {
+			tiers[tier] = append(tiers[tier], t)
+		}
+	}
+
+	for _, tier := range tiers {
+		if len(tier) > 0 {
+			return xt_common.SelectWeighted(tier), nil
+		}
+	}
+
+	for _, tier := range saturatedTiers {
+		if len(tier) > 0 {
+			return xt_common.SelectWeighted(tier), nil
+		}
+	}
+
+	return terminators[0], nil
+}
+
+func getTier(source, target xt.Locality) int {
+	if source.Region != target.Region {
+		return tierAnywhere
+	}
+	if source.Zone != "" && source.Zone == target.Zone {
+		return tierSameZone
+	}
+	if source.Region != "" {
+		return tierSameRegion
+	}
+	return tierAnywhere
+}
+
+func isSaturated(t xt.CostedTerminator) bool {
+	return xt.GlobalCosts().GetDynamicCost(t.GetId()) >= SaturatedCost
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package xt_locality
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id         string
+	routerId   string
+	precedence xt.Precedence
+	cost       uint16
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetRouterId() string {
+	return self.routerId
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return self.precedence
+}
+
+func (self *testTerminator) GetCost() uint16 {
+	return self.cost
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+type testParams struct {
+	sourceRouterId string
+	localities     map[string]xt.Locality
+}
+
+func (self *testParams) GetClientId() string {
+	return ""
+}
+
+func (self *testParams) GetCircuitTags() map[string]string {
+	return nil
+}
+
+func (self *testParams) GetSourceRouterId() string {
+	return self.sourceRouterId
+}
+
+func (self *testParams) GetRouterLocality(routerId string) xt.Locality {
+	return self.localities[routerId]
+}
+
+func newTerminator(id, routerId string) *testTerminator {
+	return &testTerminator{id: id, routerId: routerId, precedence: xt.Precedences.Default}
+}
+
+func TestLocalityTiers(t *testing.T) {
+	req := require.New(t)
+	s := NewFactory().NewStrategy()
+
+	params := &testParams{
+		sourceRouterId: "ingress",
+		localities: map[string]xt.Locality{
+			"ingress":      {Region: "us-east", Zone: "us-east-1a"},
+			"same-zone":    {Region: "us-east", Zone: "us-east-1a"},
+			"same-region":  {Region: "us-east", Zone: "us-east-1b"},
+			"other-region": {Region: "eu-west", Zone: "eu-west-1a"},
+		},
+	}
+
+	local := newTerminator("local", "ingress")
+	sameZone := newTerminator("same-zone", "same-zone")
+	sameRegion := newTerminator("same-region", "same-region")
+	otherRegion := newTerminator("other-region", "other-region")
+
+	selectAll := func(terminators ...xt.CostedTerminator) map[string]struct{} {
+		result := map[string]struct{}{}
+		for i := 0; i < 100; i++ {
+			selected, err := xt.SelectTerminator(s, params, terminators)
+			req.NoError(err)
+			result[selected.GetId()] = struct{}{}
+		}
+		return result
+	}
+
+	req.Equal(map[string]struct{}{"local": {}}, selectAll(otherRegion, sameRegion, sameZone, local))
+	req.Equal(map[string]struct{}{"same-zone": {}}, selectAll(otherRegion, sameRegion, sameZone))
+	req.Equal(map[string]struct{}{"same-region": {}}, selectAll(otherRegion, sameRegion))
+
+	// a high static cost doesn't make a terminator saturated
+	sameRegion.cost = math.MaxUint16
+	req.Equal(map[string]struct{}{"same-region": {}}, selectAll(otherRegion, sameRegion))
+	sameRegion.cost = 0
+
+	// failed terminators spill to the next tier
+	sameZone.precedence = xt.Precedences.Failed
+	req.Equal(map[string]struct{}{"same-region": {}}, selectAll(otherRegion, sameRegion, sameZone))
+
+	// saturated terminators spill to the next tier
+	xt.GlobalCosts().SetDynamicCost(sameRegion.id, SaturatedCost)
+	defer xt.GlobalCosts().ClearCost(sameRegion.id)
+	req.Equal(map[string]struct{}{"other-region": {}}, selectAll(otherRegion, sameRegion))
+
+	// if every terminator is saturated, the closest is used
+	xt.GlobalCosts().SetDynamicCost(otherRegion.id, SaturatedCost)
+	defer xt.GlobalCosts().ClearCost(otherRegion.id)
+	req.Equal(map[string]struct{}{"same-region": {}}, selectAll(otherRegion, sameRegion))
+
+	// terminators in the same tier are all used
+	xt.GlobalCosts().ClearCost(sameRegion.id)
+	sameRegion2 := newTerminator("same-region-2", "same-region")
+	req.Equal(map[string]struct{}{"same-region": {}, "same-region-2": {}}, selectAll(otherRegion, sameRegion, sameRegion2))
+}
+
+func TestGetTier(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal(tierSameZone, getTier(xt.Locality{Zone: "a"}, xt.Locality{Zone: "a"}))
+	req.Equal(tierAnywhere, getTier(xt.Locality{Zone: "a"}, xt.Locality{Zone: "b"}))
+	req.Equal(tierAnywhere, getTier(xt.Locality{}, xt.Locality{}))
+	req.Equal(tierSameRegion, getTier(xt.Locality{Region: "r"}, xt.Locality{Region: "r"}))
+	req.Equal(tierAnywhere, getTier(xt.Locality{Region: "r", Zone: "a"}, xt.Locality{Region: "s", Zone: "a"}))
+}
@@ -0,0 +1,152 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_split
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	Name = "split"
+)
+
+/**
+The split strategy divides circuits between groups of terminators, in proportion to the group weights. The groups are
+configured on the service as a traffic split, with each group selecting terminators by their tags, for example sending
+5% of circuits to terminators tagged version=v2. Groups with no usable terminators are skipped and their share is
+divided between the remaining groups. If sticky clients is enabled, clients are consistently bucketed into groups by
+hashing the client id, otherwise groups are picked randomly. Within a group, terminators are picked using the same
+cost weighted selection as the weighted strategy. Only terminators which match the highest available precedence are
+considered. Terminators which don't belong to a group are only used if no group has usable terminators, and services
+with no traffic split configured behave as if they were using the weighted strategy.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+			CircuitCost:  2,
+		},
+	}
+	strategy.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+type groupTerminators struct {
+	group       *xt.TrafficSplitGroup
+	terminators []xt.CostedTerminator
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return self.SelectForCircuit(nil, terminators)
+}
+
+func (self *strategy) SelectForCircuit(params xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	var split *xt.TrafficSplit
+	if splitParams, ok := params.(xt.TrafficSplitParams); ok {
+		split = splitParams.GetTrafficSplit()
+	}
+
+	if split == nil || len(split.Groups) == 0 {
+		return xt_common.SelectWeighted(terminators), nil
+	}
+
+	var groups []*groupTerminators
+	byName := map[string]*groupTerminators{}
+	for _, t := range terminators {
+		group := split.GetGroup(t)
+		if group == nil || group.Weight == 0 {
+			continue
+		}
+		current, found := byName[group.Name]
+		if !found {
+			current = &groupTerminators{group: group}
+			byName[group.Name] = current
+		}
+		current.terminators = append(current.terminators, t)
+	}
+
+	// keep the configured group order, so that sticky client buckets are stable
+	totalWeight := uint64(0)
+	for _, group := range split.Groups {
+		if current, found := byName[group.Name]; found {
+			groups = append(groups, current)
+			totalWeight += uint64(group.Weight)
+		}
+	}
+
+	if len(groups) == 0 {
+		return xt_common.SelectWeighted(terminators), nil
+	}
+
+	var bucket uint64
+	if split.StickyClients && params.GetClientId() != "" {
+		bucket = hashClientId(params.GetClientId()) % totalWeight
+	} else {
+		bucket = uint64(rand.Int63n(int64(totalWeight)))
+	}
+
+	for _, current := range groups {
+		if bucket < uint64(current.group.Weight) {
+			return xt_common.SelectWeighted(current.terminators), nil
+		}
+		bucket -= uint64(current.group.Weight)
+	}
+
+	return xt_common.SelectWeighted(groups[len(groups)-1].terminators), nil
+}
+
+func hashClientId(clientId string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(clientId))
+	return h.Sum64()
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package xt_split
+
+import (
+	"fmt"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id         string
+	precedence xt.Precedence
+	tags       map[string]interface{}
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return self.precedence
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+func (self *testTerminator) GetTags() map[string]interface{} {
+	return self.tags
+}
+
+type testParams struct {
+	clientId string
+	split    *xt.TrafficSplit
+}
+
+func (self *testParams) GetClientId() string {
+	return self.clientId
+}
+
+func (self *testParams) GetCircuitTags() map[string]string {
+	return nil
+}
+
+func (self *testParams) GetTrafficSplit() *xt.TrafficSplit {
+	return self.split
+}
+
+func newTerminator(id, version string) *testTerminator {
+	return &testTerminator{
+		id:         id,
+		precedence: xt.Precedences.Default,
+		tags:       map[string]interface{}{"version": version},
+	}
+}
+
+func newSplit(stableWeight, canaryWeight uint32) *xt.TrafficSplit {
+	return &xt.TrafficSplit{
+		Groups: []*xt.TrafficSplitGroup{
+			{Name: "stable", Weight: stableWeight, TerminatorTags: map[string]string{"version": "v1"}},
+			{Name: "canary", Weight: canaryWeight, TerminatorTags: map[string]string{"version": "v2"}},
+		},
+	}
+}
+
+func countSelections(t *testing.T, s xt.Strategy, params *testParams, terminators []xt.CostedTerminator, count int) map[string]int {
+	result := map[string]int{}
+	for i := 0; i < count; i++ {
+		selected, err := xt.SelectTerminator(s, params, terminators)
+		require.NoError(t, err)
+		result[selected.GetId()]++
+	}
+	return result
+}
+
+func TestSplitByWeight(t *testing.T) {
+	req := require.New(t)
+	s := NewFactory().NewStrategy()
+
+	terminators := []xt.CostedTerminator{
+		newTerminator("v1-a", "v1"),
+		newTerminator("v1-b", "v1"),
+		newTerminator("v2-a", "v2"),
+	}
+
+	params := &testParams{split: newSplit(90, 10)}
+	counts := countSelections(t, s, params, terminators, 10000)
+	req.InDelta(1000, counts["v2-a"], 300)
+	req.InDelta(9000, counts["v1-a"]+counts["v1-b"], 300)
+
+	// weight changes take effect immediately
+	params.split = newSplit(0, 10)
+	counts = countSelections(t, s, params, terminators, 100)
+	req.Equal(map[string]int{"v2-a": 100}, counts)
+
+	// groups without usable terminators are skipped
+	terminators[2].(*testTerminator).precedence = xt.Precedences.Failed
+	params.split = newSplit(1, 1000)
+	counts = countSelections(t, s, params, terminators, 100)
+	req.Zero(counts["v2-a"])
+
+	// with no split configured, all terminators are used
+	terminators[2].(*testTerminator).precedence = xt.Precedences.Default
+	params.split = nil
+	counts = countSelections(t, s, params, terminators, 1000)
+	req.Len(counts, 3)
+}
+
+func TestSplitStickyClients(t *testing.T) {
+	req := require.New(t)
+	s := NewFactory().NewStrategy()
+
+	terminators := []xt.CostedTerminator{
+		newTerminator("v1", "v1"),
+		newTerminator("v2", "v2"),
+	}
+
+	split := newSplit(50, 50)
+	split.StickyClients = true
+
+	groups := map[string]int{}
+	for i := 0; i < 100; i++ {
+		params := &testParams{clientId: fmt.Sprintf("client-%v", i), split: split}
+		first, err := xt.SelectTerminator(s, params, terminators)
+		req.NoError(err)
+		for j := 0; j < 10; j++ {
+			selected, err := xt.SelectTerminator(s, params, terminators)
+			req.NoError(err)
+			req.Equal(first.GetId(), selected.GetId())
+		}
+		groups[first.GetId()]++
+	}
+	req.Len(groups, 2)
+}
+
+type untaggedTerminator struct {
+	xt.CostedTerminator
+}
+
+func TestSplitUntaggedTerminators(t *testing.T) {
+	req := require.New(t)
+
+	split := newSplit(1, 1)
+	split.Groups = append(split.Groups, &xt.TrafficSplitGroup{Name: "default", Weight: 1})
+
+	tagged := newTerminator("v1", "v1")
+	req.Equal("stable", split.GetGroup(tagged).Name)
+
+	// terminators which can't report their tags only match groups without terminator tags
+	req.Equal("default", split.GetGroup(&untaggedTerminator{CostedTerminator: tagged}).Name)
+}
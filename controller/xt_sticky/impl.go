@@ -0,0 +1,128 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"hash/fnv"
+	"math"
+	"time"
+)
+
+const (
+	Name = "sticky"
+
+	// StickyKeyTag is the circuit tag which, if present, is used as the affinity key instead of the client id
+	StickyKeyTag = "stickyKey"
+)
+
+/**
+The sticky strategy sends circuits with the same affinity key to the same terminator, for as long as that terminator
+is available. The affinity key is the stickyKey circuit tag, if one is set, otherwise it's the client id. Terminators
+are picked using rendezvous hashing: each terminator is scored by hashing it together with the key and the highest
+score wins. When a terminator is added or removed only the keys which score highest on that terminator move, so
+no state needs to be rebuilt when terminators change. It only picks from terminators which match the precedence of
+the first terminator, which is presumably of the highest available precedence. Circuits with no affinity key go to
+the terminator with the lowest route cost.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+	}
+	strategy.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return self.SelectForCircuit(nil, terminators)
+}
+
+func (self *strategy) SelectForCircuit(params xt.CreateCircuitParams, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	key := getAffinityKey(params)
+	if len(terminators) == 1 || key == "" {
+		return terminators[0], nil
+	}
+
+	var selected xt.CostedTerminator
+	var selectedScore uint64
+	for _, t := range terminators {
+		score := rendezvousScore(key, t.GetId())
+		if selected == nil || score > selectedScore || (score == selectedScore && t.GetId() < selected.GetId()) {
+			selected = t
+			selectedScore = score
+		}
+	}
+	return selected, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+	return nil
+}
+
+func getAffinityKey(params xt.CreateCircuitParams) string {
+	if params == nil {
+		return ""
+	}
+	if key := params.GetCircuitTags()[StickyKeyTag]; key != "" {
+		return key
+	}
+	return params.GetClientId()
+}
+
+func rendezvousScore(key, terminatorId string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(terminatorId))
+	return mix(h.Sum64())
+}
+
+// mix applies the splitmix64 finalizer, so that keys which differ only slightly still produce well spread scores
+func mix(v uint64) uint64 {
+	v ^= v >> 30
+	v *= 0xbf58476d1ce4e5b9
+	v ^= v >> 27
+	v *= 0x94d049bb133111eb
+	v ^= v >> 31
+	return v
+}
@@ -0,0 +1,112 @@
+package xt_sticky
+
+import (
+	"fmt"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id         string
+	precedence xt.Precedence
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return self.precedence
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+type testParams struct {
+	clientId string
+	tags     map[string]string
+}
+
+func (self *testParams) GetClientId() string {
+	return self.clientId
+}
+
+func (self *testParams) GetCircuitTags() map[string]string {
+	return self.tags
+}
+
+func newTerminators(count int) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for i := 0; i < count; i++ {
+		result = append(result, &testTerminator{id: fmt.Sprintf("t%v", i), precedence: xt.Precedences.Default})
+	}
+	return result
+}
+
+func selectAll(t *testing.T, s xt.Strategy, terminators []xt.CostedTerminator) map[string]string {
+	result := map[string]string{}
+	for i := 0; i < 1000; i++ {
+		clientId := fmt.Sprintf("client-%v", i)
+		selected, err := xt.SelectTerminator(s, &testParams{clientId: clientId}, terminators)
+		require.NoError(t, err)
+		result[clientId] = selected.GetId()
+	}
+	return result
+}
+
+func TestStickySelection(t *testing.T) {
+	req := require.New(t)
+	s := NewFactory().NewStrategy()
+	terminators := newTerminators(5)
+
+	first := selectAll(t, s, terminators)
+	req.Equal(first, selectAll(t, s, terminators))
+
+	counts := map[string]int{}
+	for _, id := range first {
+		counts[id]++
+	}
+	req.Len(counts, 5)
+
+	// the sticky key tag takes precedence over the client id
+	a, err := xt.SelectTerminator(s, &testParams{clientId: "client-1", tags: map[string]string{StickyKeyTag: "session"}}, terminators)
+	req.NoError(err)
+	b, err := xt.SelectTerminator(s, &testParams{clientId: "client-2", tags: map[string]string{StickyKeyTag: "session"}}, terminators)
+	req.NoError(err)
+	req.Equal(a.GetId(), b.GetId())
+
+	// without a key, the lowest cost terminator is used
+	selected, err := xt.SelectTerminator(s, &testParams{}, terminators)
+	req.NoError(err)
+	req.Equal("t0", selected.GetId())
+}
+
+func TestStickyMinimalRemapping(t *testing.T) {
+	req := require.New(t)
+	s := NewFactory().NewStrategy()
+	terminators := newTerminators(5)
+	before := selectAll(t, s, terminators)
+
+	// removing a terminator only moves the keys which were on it
+	removed := terminators[2].GetId()
+	afterRemove := selectAll(t, s, append(append([]xt.CostedTerminator{}, terminators[:2]...), terminators[3:]...))
+	for clientId, id := range before {
+		if id != removed {
+			req.Equal(id, afterRemove[clientId])
+		} else {
+			req.NotEqual(removed, afterRemove[clientId])
+		}
+	}
+
+	// adding a terminator only moves keys onto the new terminator
+	added := &testTerminator{id: "t5", precedence: xt.Precedences.Default}
+	afterAdd := selectAll(t, s, append(terminators, added))
+	for clientId, id := range before {
+		if afterAdd[clientId] != added.id {
+			req.Equal(id, afterAdd[clientId])
+		}
+	}
+}
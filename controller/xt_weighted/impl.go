@@ -20,7 +20,6 @@ import (
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/controller/xt_common"
 	"math"
-	"math/rand"
 	"time"
 )
 
@@ -57,35 +56,7 @@ type strategy struct {
 
 func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
 	terminators = xt.GetRelatedTerminators(terminators)
-	if len(terminators) == 1 {
-		return terminators[0], nil
-	}
-
-	var costIdx []float32
-	totalCost := float32(0)
-	for _, t := range terminators {
-		unbiasedCost := float32(t.GetPrecedence().Unbias(t.GetRouteCost()))
-		if unbiasedCost == 0 {
-			unbiasedCost = 1
-		}
-		costIdx = append(costIdx, unbiasedCost)
-		totalCost += unbiasedCost
-	}
-
-	total := float32(0)
-	for idx, cost := range costIdx {
-		total += 1 - (cost / totalCost)
-		costIdx[idx] = total
-	}
-
-	selected := rand.Float32()
-	for idx, cost := range costIdx {
-		if selected < cost {
-			return terminators[idx], nil
-		}
-	}
-
-	return terminators[0], nil
+	return xt_common.SelectWeighted(terminators), nil
 }
 
 func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
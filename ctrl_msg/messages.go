@@ -17,6 +17,8 @@
 package ctrl_msg
 
 import (
+	"time"
+
 	"github.com/openziti/channel"
 )
 
@@ -31,6 +33,9 @@ const (
 	RouteResultErrorHeader      = 1103
 	RouteResultErrorCodeHeader  = 1104
 
+	// RouteResultDialDurationHeader holds the time, in nanoseconds, the terminating router spent dialing the egress
+	RouteResultDialDurationHeader = 1105
+
 	TerminatorLocalAddressHeader  = 1110
 	TerminatorRemoteAddressHeader = 1111
 
@@ -59,6 +64,17 @@ func NewRouteResultSuccessMsg(sessionId string, attempt int) *channel.Message {
 	return msg
 }
 
+// PutDialDuration records how long the egress dial took on a route result
+func PutDialDuration(msg *channel.Message, dialDuration time.Duration) {
+	msg.PutUint64Header(RouteResultDialDurationHeader, uint64(dialDuration))
+}
+
+// GetDialDuration returns the egress dial duration from a route result, if the router reported one
+func GetDialDuration(msg *channel.Message) (time.Duration, bool) {
+	val, found := msg.GetUint64Header(RouteResultDialDurationHeader)
+	return time.Duration(val), found
+}
+
 func NewRouteResultFailedMessage(sessionId string, attempt int, rerr string) *channel.Message {
 	msg := channel.NewMessage(RouteResultType, []byte(sessionId))
 	msg.PutUint32Header(RouteResultAttemptHeader, uint32(attempt))
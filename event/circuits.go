@@ -24,15 +24,18 @@ import (
 type CircuitEventType string
 
 const (
-	CircuitEventsNs                       = "fabric.circuits"
-	CircuitEventsVersion                  = 2
+	CircuitEventsNs = "fabric.circuits"
+	// CircuitEventsVersion 3 added the standby path, present events, reroute details on path updated events and
+	// route timings
+	CircuitEventsVersion                  = 3
 	CircuitCreated       CircuitEventType = "created"
 	CircuitUpdated       CircuitEventType = "pathUpdated"
 	CircuitDeleted       CircuitEventType = "deleted"
 	CircuitFailed        CircuitEventType = "failed"
+	CircuitPresent       CircuitEventType = "present"
 )
 
-var CircuitEventTypes = []CircuitEventType{CircuitCreated, CircuitUpdated, CircuitDeleted, CircuitFailed}
+var CircuitEventTypes = []CircuitEventType{CircuitCreated, CircuitUpdated, CircuitDeleted, CircuitFailed, CircuitPresent}
 
 type CircuitPath struct {
 	Nodes                []string `json:"nodes"`
@@ -58,6 +61,25 @@ func (self *CircuitPath) String() string {
 	return out
 }
 
+// CircuitReroute describes why and how a circuit path changed. It's included on path updated events
+type CircuitReroute struct {
+	Reason  string      `json:"reason"`
+	OldPath CircuitPath `json:"old_path"`
+	OldCost int64       `json:"old_cost"`
+	NewCost int64       `json:"new_cost"`
+}
+
+// CircuitRouteTiming describes how long a router on the path took to respond to a route request. DialDuration is
+// only set for the terminating router, and is the time it spent dialing the egress. Included on created and failed
+// events, for every attempt made to create the circuit
+type CircuitRouteTiming struct {
+	RouterId      string         `json:"router_id"`
+	Attempt       uint32         `json:"attempt"`
+	Success       bool           `json:"success"`
+	RouteDuration time.Duration  `json:"route_duration"`
+	DialDuration  *time.Duration `json:"dial_duration,omitempty"`
+}
+
 type CircuitEvent struct {
 	Namespace        string           `json:"namespace"`
 	Version          uint32           `json:"version"`
@@ -70,9 +92,13 @@ type CircuitEvent struct {
 	InstanceId       string           `json:"instance_id"`
 	CreationTimespan *time.Duration   `json:"creation_timespan,omitempty"`
 	Path             CircuitPath      `json:"path"`
+	StandbyPath      *CircuitPath     `json:"standby_path,omitempty"`
 	LinkCount        int              `json:"link_count"`
 	Cost             *uint32          `json:"path_cost,omitempty"`
 	FailureCause     *string          `json:"failure_cause,omitempty"`
+	Reroute          *CircuitReroute  `json:"reroute,omitempty"`
+
+	RouteTimings []*CircuitRouteTiming `json:"route_timings,omitempty"`
 }
 
 func (event *CircuitEvent) String() string {
@@ -84,6 +110,16 @@ func (event *CircuitEvent) String() string {
 			if event.CreationTimespan != nil {
 				out = fmt.Sprintf("%s creationTimespan=%s", out, *event.CreationTimespan)
 			}
+			for _, timing := range event.RouteTimings {
+				out = fmt.Sprintf("%s routeTiming[r/%s #%d]=%s", out, timing.RouterId, timing.Attempt, timing.RouteDuration)
+				if timing.DialDuration != nil {
+					out = fmt.Sprintf("%s (dial=%s)", out, *timing.DialDuration)
+				}
+			}
+			if event.Reroute != nil {
+				out = fmt.Sprintf("%s rerouteReason=%s oldPath=%v oldCost=%d newCost=%d", out,
+					event.Reroute.Reason, &event.Reroute.OldPath, event.Reroute.OldCost, event.Reroute.NewCost)
+			}
 			return
 		}())
 }
@@ -63,6 +63,9 @@ type Dispatcher interface {
 	AddUsageEventHandler(handler UsageEventHandler)
 	RemoveUsageEventHandler(handler UsageEventHandler)
 
+	// GetJournal returns the event journal, or nil if the event journal isn't enabled
+	GetJournal() Journal
+
 	CircuitEventHandler
 	LinkEventHandler
 	MetricsEventHandler
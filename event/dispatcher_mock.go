@@ -69,6 +69,10 @@ func (d DispatcherMock) AddUsageEventHandler(UsageEventHandler) {}
 
 func (d DispatcherMock) RemoveUsageEventHandler(UsageEventHandler) {}
 
+func (d DispatcherMock) GetJournal() Journal {
+	return nil
+}
+
 func (d DispatcherMock) AcceptCircuitEvent(*CircuitEvent) {}
 
 func (d DispatcherMock) AcceptLinkEvent(*LinkEvent) {}
@@ -0,0 +1,43 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import "time"
+
+// A JournalEntry is an event which was recorded in the event journal. Data holds the JSON encoded event.
+type JournalEntry struct {
+	Sequence  uint64    `json:"sequence"`
+	Namespace string    `json:"namespace"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      []byte    `json:"data"`
+}
+
+// A JournalEntryHandler receives journal entries from a journal subscription. If it returns an error, the
+// subscription is closed
+type JournalEntryHandler func(entry *JournalEntry) error
+
+// The Journal interface provides access to recorded events. Each recorded event is assigned a sequence number,
+// which is one greater than the sequence number of the event recorded before it.
+type Journal interface {
+	// Subscribe sends all retained entries with a sequence greater than or equal to fromSequence to the handler,
+	// followed by new entries as they are recorded. Entries are delivered in sequence order. The returned function
+	// closes the subscription.
+	Subscribe(fromSequence uint64, handler JournalEntryHandler) (unsubscribe func())
+
+	// GetLastSequence returns the sequence number of the most recently recorded event
+	GetLastSequence() uint64
+}
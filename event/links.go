@@ -32,6 +32,8 @@ const (
 	LinkFromRouterNew              LinkEventType = "routerLinkNew"
 	LinkFromRouterKnown            LinkEventType = "routerLinkKnown"
 	LinkFromRouterDisconnectedDest LinkEventType = "routerLinkDisconnectedDest"
+	LinkFlapSuppressed             LinkEventType = "flapSuppressed"
+	LinkFlapReused                 LinkEventType = "flapReused"
 )
 
 type LinkConnection struct {
@@ -51,11 +53,17 @@ type LinkEvent struct {
 	DialAddress string            `json:"dial_address"`
 	Cost        int32             `json:"cost"`
 	Connections []*LinkConnection `json:"connections,omitempty"`
+
+	FlapPenalty           int64 `json:"flap_penalty,omitempty"`
+	FlapSuppressed        bool  `json:"flap_suppressed,omitempty"`
+	FlapSuppressThreshold int64 `json:"flap_suppress_threshold,omitempty"`
+	FlapReuseThreshold    int64 `json:"flap_reuse_threshold,omitempty"`
 }
 
 func (event *LinkEvent) String() string {
-	return fmt.Sprintf("%v.%v time=%v linkId=%v srcRouterId=%v dstRouterId=%v",
-		event.Namespace, event.EventType, event.Timestamp, event.LinkId, event.SrcRouterId, event.DstRouterId)
+	return fmt.Sprintf("%v.%v time=%v linkId=%v srcRouterId=%v dstRouterId=%v flapPenalty=%v flapSuppressed=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.LinkId, event.SrcRouterId, event.DstRouterId,
+		event.FlapPenalty, event.FlapSuppressed)
 }
 
 type LinkEventHandler interface {
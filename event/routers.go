@@ -26,8 +26,11 @@ type RouterEventType string
 const (
 	RouterEventsNs = "fabric.routers"
 
-	RouterOnline  RouterEventType = "router-online"
-	RouterOffline RouterEventType = "router-offline"
+	RouterOnline       RouterEventType = "router-online"
+	RouterOffline      RouterEventType = "router-offline"
+	RouterDraining     RouterEventType = "router-draining"
+	RouterDrained      RouterEventType = "router-drained"
+	RouterDrainStopped RouterEventType = "router-drain-stopped"
 )
 
 type RouterEvent struct {
@@ -36,11 +39,21 @@ type RouterEvent struct {
 	Timestamp    time.Time       `json:"timestamp"`
 	RouterId     string          `json:"router_id"`
 	RouterOnline bool            `json:"router_online"`
+
+	// RoleAttributes are the role attributes of the router at the time of the event
+	RoleAttributes []string `json:"role_attributes,omitempty"`
+
+	// DrainRemainingCircuits is the number of circuits still using the router. Only set on drain events
+	DrainRemainingCircuits *int64 `json:"drain_remaining_circuits,omitempty"`
 }
 
 func (event *RouterEvent) String() string {
-	return fmt.Sprintf("%v.%v time=%v routerId=%v routerOnline=%v",
-		event.Namespace, event.EventType, event.Timestamp, event.RouterId, event.RouterOnline)
+	if event.DrainRemainingCircuits != nil {
+		return fmt.Sprintf("%v.%v time=%v routerId=%v routerOnline=%v roleAttributes=%v drainRemainingCircuits=%v",
+			event.Namespace, event.EventType, event.Timestamp, event.RouterId, event.RouterOnline, event.RoleAttributes, *event.DrainRemainingCircuits)
+	}
+	return fmt.Sprintf("%v.%v time=%v routerId=%v routerOnline=%v roleAttributes=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.RouterId, event.RouterOnline, event.RoleAttributes)
 }
 
 type RouterEventHandler interface {
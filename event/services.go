@@ -28,14 +28,15 @@ type ServiceEvent struct {
 	EventType        string `json:"event_type"`
 	ServiceId        string `json:"service_id"`
 	TerminatorId     string `json:"terminator_id"`
+	SplitGroup       string `json:"split_group,omitempty"`
 	Count            uint64 `json:"count"`
 	IntervalStartUTC int64  `json:"interval_start_utc"`
 	IntervalLength   uint64 `json:"interval_length"`
 }
 
 func (event *ServiceEvent) String() string {
-	return fmt.Sprintf("%v service=%v terminator=%v count=%v intervalStart=%v intervalLength=%v",
-		event.EventType, event.ServiceId, event.TerminatorId, event.Count, event.IntervalStartUTC, event.IntervalLength)
+	return fmt.Sprintf("%v service=%v terminator=%v splitGroup=%v count=%v intervalStart=%v intervalLength=%v",
+		event.EventType, event.ServiceId, event.TerminatorId, event.SplitGroup, event.Count, event.IntervalStartUTC, event.IntervalLength)
 }
 
 type ServiceEventHandler interface {
@@ -31,6 +31,15 @@ const (
 	TerminatorDeleted       TerminatorEventType = "deleted"
 	TerminatorRouterOnline  TerminatorEventType = "router-online"
 	TerminatorRouterOffline TerminatorEventType = "router-offline"
+
+	TerminatorHealthCheckFailed TerminatorEventType = "health-check-failed"
+	TerminatorHealthCheckPassed TerminatorEventType = "health-check-passed"
+
+	TerminatorDraining TerminatorEventType = "draining"
+	TerminatorDrained  TerminatorEventType = "drained"
+
+	// TerminatorCauseExpired is the cause given for terminators deleted because their lease expired
+	TerminatorCauseExpired = "expired"
 )
 
 type TerminatorEvent struct {
@@ -48,14 +57,26 @@ type TerminatorEvent struct {
 	TotalTerminators          int                 `json:"total_terminators"`
 	UsableDefaultTerminators  int                 `json:"usable_default_terminators"`
 	UsableRequiredTerminators int                 `json:"usable_required_terminators"`
+	HealthCheckMessage        string              `json:"health_check_message,omitempty"`
+	Draining                  bool                `json:"draining"`
+	DrainRemainingCircuits    *int64              `json:"drain_remaining_circuits,omitempty"`
+	Cause                     string              `json:"cause,omitempty"`
 }
 
 func (event *TerminatorEvent) String() string {
 	return fmt.Sprintf("%v.%v time=%v serviceId=%v terminatorId=%v routerId=%v routerOnline=%v precedence=%v "+
-		"staticCost=%v dynamicCost=%v totalTerminators=%v usableDefaultTerminator=%v usableRequiredTerminators=%v",
+		"staticCost=%v dynamicCost=%v totalTerminators=%v usableDefaultTerminator=%v usableRequiredTerminators=%v healthCheckMessage=%v "+
+		"draining=%v drainRemainingCircuits=%v cause=%v",
 		event.Namespace, event.EventType, event.Timestamp, event.ServiceId, event.TerminatorId, event.RouterId, event.RouterOnline,
 		event.Precedence, event.StaticCost, event.DynamicCost, event.TotalTerminators, event.UsableDefaultTerminators,
-		event.UsableRequiredTerminators)
+		event.UsableRequiredTerminators, event.HealthCheckMessage, event.Draining, event.getDrainRemainingCircuits(), event.Cause)
+}
+
+func (event *TerminatorEvent) getDrainRemainingCircuits() string {
+	if event.DrainRemainingCircuits == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", *event.DrainRemainingCircuits)
 }
 
 type TerminatorEventHandler interface {
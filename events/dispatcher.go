@@ -42,6 +42,7 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 
 	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
+	result.RegisterEventHandlerFactory("http", HttpEventLoggerFactory{closeNotify: closeNotify})
 
 	go result.eventLoop()
 
@@ -64,6 +65,8 @@ type Dispatcher struct {
 	registrationHandlers  concurrenz.CopyOnWriteMap[string, event.RegistrationHandler]
 	eventHandlerFactories concurrenz.CopyOnWriteMap[string, event.HandlerFactory]
 
+	journal *EventJournal
+
 	closeNotify <-chan struct{}
 	eventC      chan event.Event
 }
@@ -79,6 +82,43 @@ func (self *Dispatcher) InitializeNetworkEvents(n *network.Network) {
 	self.AddMetricsMapper((&linkMetricsMapper{network: n}).mapMetrics)
 }
 
+// EnableJournal creates the event journal and subscribes it to all event types. Metrics events are only journaled
+// if includeMetrics is set. Usage events are journaled using the format given by usageVersion, which defaults to 2.
+func (self *Dispatcher) EnableJournal(config map[interface{}]interface{}) error {
+	journal, err := NewEventJournal(config, self.closeNotify)
+	if err != nil {
+		return err
+	}
+
+	if err = self.registerUsageEventHandler(journal, map[interface{}]interface{}{"version": config["usageVersion"]}); err != nil {
+		return err
+	}
+
+	if value, found := config["includeMetrics"]; found {
+		if includeMetrics, ok := value.(bool); !ok {
+			return errors.New("invalid 'includeMetrics' for event journal, must be a boolean")
+		} else if includeMetrics {
+			self.AddMetricsEventHandler(journal)
+		}
+	}
+
+	self.AddCircuitEventHandler(journal)
+	self.AddLinkEventHandler(journal)
+	self.AddRouterEventHandler(journal)
+	self.AddServiceEventHandler(journal)
+	self.AddTerminatorEventHandler(journal)
+
+	self.journal = journal
+	return nil
+}
+
+func (self *Dispatcher) GetJournal() event.Journal {
+	if self.journal == nil {
+		return nil
+	}
+	return self.journal
+}
+
 func (self *Dispatcher) AddMetricsMapper(mapper event.MetricsMapper) {
 	self.metricsMappers.Append(mapper)
 }
@@ -124,6 +164,7 @@ events:
       - type: fabric.circuits
         include:
           - created
+        filter: serviceId = "x" and linkCount > 2
       - type: edge.sessions
         include:
           - created
@@ -132,6 +173,8 @@ events:
       format: json
       path: /tmp/ziti-events.log
 
+Subscriptions may have a filter expression, which is evaluated against the fields of each event.
+Supported handler types are file, stdout and http. See HttpEventLogger for the http handler configuration.
 */
 func (self *Dispatcher) WireEventHandlers(eventHandlerConfigs []*EventHandlerConfig) error {
 	logger := pfxlog.Logger()
@@ -59,12 +59,20 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		}
 	}
 
-	if len(includeList) == 0 {
+	filter, err := newEventFilter(&event.CircuitEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if len(includeList) == 0 && filter == nil {
 		self.AddCircuitEventHandler(handler)
 		return nil
 	}
 
-	accepted := map[event.CircuitEventType]struct{}{}
+	var accepted map[event.CircuitEventType]struct{}
+	if len(includeList) > 0 {
+		accepted = map[event.CircuitEventType]struct{}{}
+	}
 	for _, include := range includeList {
 		found := false
 		for _, t := range event.CircuitEventTypes {
@@ -80,6 +88,7 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 	}
 	result := &filteredCircuitEventHandler{
 		accepted: accepted,
+		filter:   filter,
 		wrapped:  handler,
 	}
 	self.AddCircuitEventHandler(result)
@@ -88,11 +97,17 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 
 type filteredCircuitEventHandler struct {
 	accepted map[event.CircuitEventType]struct{}
+	filter   *eventFilter
 	wrapped  event.CircuitEventHandler
 }
 
 func (self *filteredCircuitEventHandler) AcceptCircuitEvent(event *event.CircuitEvent) {
-	if _, found := self.accepted[event.EventType]; found {
+	if self.accepted != nil {
+		if _, found := self.accepted[event.EventType]; !found {
+			return
+		}
+	}
+	if self.filter == nil || self.filter.matches(event) {
 		self.wrapped.AcceptCircuitEvent(event)
 	}
 }
@@ -38,13 +38,22 @@ func (self *Dispatcher) AcceptLinkEvent(event *event.LinkEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerLinkEventHandler(val interface{}, _ map[interface{}]interface{}) error {
+func (self *Dispatcher) registerLinkEventHandler(val interface{}, config map[interface{}]interface{}) error {
 	handler, ok := val.(event.LinkEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/LinkEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := newEventFilter(&event.LinkEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &filteredLinkEventHandler{filter: filter, wrapped: handler}
+	}
+
 	self.linkEventHandlers.Append(handler)
 
 	return nil
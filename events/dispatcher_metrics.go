@@ -106,6 +106,15 @@ func (self *Dispatcher) registerMetricsEventHandler(val interface{}, config map[
 		}
 	}
 
+	filter, err := newEventFilter(&event.MetricsEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &filteredMetricsEventHandler{filter: filter, wrapped: handler}
+	}
+
 	adapter := self.NewFilteredMetricsAdapter(sourceFilter, metricFilter, handler)
 	self.AddMetricsMessageHandler(adapter)
 	return nil
@@ -47,13 +47,22 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 	n.AddRouterPresenceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(val interface{}, _ map[interface{}]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(val interface{}, config map[interface{}]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/RouterEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := newEventFilter(&event.RouterEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &filteredRouterEventHandler{filter: filter, wrapped: handler}
+	}
+
 	self.AddRouterEventHandler(handler)
 
 	return nil
@@ -74,11 +83,12 @@ func (self *routerEventAdapter) RouterDisconnected(r *network.Router) {
 
 func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r *network.Router, online bool) {
 	evt := &event.RouterEvent{
-		Namespace:    event.RouterEventsNs,
-		EventType:    eventType,
-		Timestamp:    time.Now(),
-		RouterId:     r.Id,
-		RouterOnline: online,
+		Namespace:      event.RouterEventsNs,
+		EventType:      eventType,
+		Timestamp:      time.Now(),
+		RouterId:       r.Id,
+		RouterOnline:   online,
+		RoleAttributes: r.RoleAttributes,
 	}
 
 	self.Dispatcher.AcceptRouterEvent(evt)
@@ -41,12 +41,21 @@ func (self *Dispatcher) AcceptServiceEvent(event *event.ServiceEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerServiceEventHandler(val interface{}, _ map[interface{}]interface{}) error {
+func (self *Dispatcher) registerServiceEventHandler(val interface{}, config map[interface{}]interface{}) error {
 	handler, ok := val.(event.ServiceEventHandler)
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/ServiceEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := newEventFilter(&event.ServiceEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &filteredServiceEventHandler{filter: filter, wrapped: handler}
+	}
+
 	self.AddServiceEventHandler(handler)
 	return nil
 }
@@ -66,18 +75,23 @@ func (self *serviceEventAdapter) AcceptMetrics(message *metrics_pb.MetricsMessag
 	for name, interval := range message.IntervalCounters {
 		for _, bucket := range interval.Buckets {
 			for combinedId, count := range bucket.Values {
-				ids := strings.Split(combinedId, ":")
+				ids := strings.SplitN(combinedId, ":", 3)
 				serviceId := ids[0]
 				terminatorId := ""
 				if len(ids) > 1 {
 					terminatorId = ids[1]
 				}
+				splitGroup := ""
+				if len(ids) > 2 {
+					splitGroup = ids[2]
+				}
 				evt := &event.ServiceEvent{
 					Namespace:        "service.events",
-					Version:          2,
+					Version:          3,
 					EventType:        name,
 					ServiceId:        serviceId,
 					TerminatorId:     terminatorId,
+					SplitGroup:       splitGroup,
 					Count:            count,
 					IntervalStartUTC: bucket.IntervalStartUTC,
 					IntervalLength:   interval.IntervalLength,
@@ -45,13 +45,22 @@ func (self *Dispatcher) AcceptTerminatorEvent(event *event.TerminatorEvent) {
 	}()
 }
 
-func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, _ map[interface{}]interface{}) error {
+func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, config map[interface{}]interface{}) error {
 	handler, ok := val.(event.TerminatorEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/TerminatorEventHandler interface.", reflect.TypeOf(val))
 	}
 
+	filter, err := newEventFilter(&event.TerminatorEvent{}, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &filteredTerminatorEventHandler{filter: filter, wrapped: handler}
+	}
+
 	self.AddTerminatorEventHandler(handler)
 
 	return nil
@@ -68,6 +77,8 @@ func (self *Dispatcher) initTerminatorEvents(n *network.Network) {
 	n.GetStores().Terminator.AddListener(boltz.EventDelete, terminatorEvtAdapter.terminatorDeleted)
 
 	n.AddRouterPresenceHandler(terminatorEvtAdapter)
+	n.AddTerminatorHealthHandler(terminatorEvtAdapter)
+	n.AddTerminatorDrainHandler(terminatorEvtAdapter)
 }
 
 // terminatorEventAdapter converts router presence online/offline events and terminator entity change events to
@@ -112,6 +123,53 @@ func (self *terminatorEventAdapter) routerChange(eventType event.TerminatorEvent
 	}
 }
 
+func (self *terminatorEventAdapter) TerminatorHealthChanged(terminatorId string, status *network.TerminatorHealthStatus) {
+	var terminator *db.Terminator
+	err := self.Network.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		terminator, err = self.Network.GetStores().Terminator.LoadOneById(tx, terminatorId)
+		return err
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("failure while generating terminator health check event for terminator %v", terminatorId)
+		return
+	}
+
+	eventType := event.TerminatorHealthCheckFailed
+	if status.Healthy {
+		eventType = event.TerminatorHealthCheckPassed
+	}
+
+	evt := self.newTerminatorEvent(eventType, terminator)
+	evt.HealthCheckMessage = status.Message
+	self.Dispatcher.AcceptTerminatorEvent(evt)
+}
+
+func (self *terminatorEventAdapter) TerminatorDrainProgress(terminatorId string, remainingCircuits int64) {
+	var terminator *db.Terminator
+	err := self.Network.GetDb().View(func(tx *bbolt.Tx) error {
+		var err error
+		terminator, err = self.Network.GetStores().Terminator.LoadOneById(tx, terminatorId)
+		return err
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("failure while generating terminator drain event for terminator %v", terminatorId)
+		return
+	}
+
+	evt := self.newTerminatorEvent(event.TerminatorDraining, terminator)
+	evt.DrainRemainingCircuits = &remainingCircuits
+	self.Dispatcher.AcceptTerminatorEvent(evt)
+
+	if remainingCircuits == 0 {
+		evt = self.newTerminatorEvent(event.TerminatorDrained, terminator)
+		evt.DrainRemainingCircuits = &remainingCircuits
+		self.Dispatcher.AcceptTerminatorEvent(evt)
+	}
+}
+
 func (self *terminatorEventAdapter) terminatorCreated(args ...interface{}) {
 	self.terminatorChanged(event.TerminatorCreated, args...)
 }
@@ -136,11 +194,16 @@ func (self *terminatorEventAdapter) terminatorChanged(eventType event.Terminator
 		return
 	}
 
+	cause := terminator.DeleteCause
 	terminator = self.Network.Services.NotifyTerminatorChanged(terminator)
-	self.createTerminatorEvent(eventType, terminator)
+	evt := self.newTerminatorEvent(eventType, terminator)
+	if eventType == event.TerminatorDeleted && cause != "" {
+		evt.Cause = cause
+	}
+	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
 
-func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.TerminatorEventType, terminator *db.Terminator) {
+func (self *terminatorEventAdapter) newTerminatorEvent(eventType event.TerminatorEventType, terminator *db.Terminator) *event.TerminatorEvent {
 	service, _ := self.Network.Services.Read(terminator.Service)
 
 	totalTerminators := -1
@@ -153,6 +216,9 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		usableRequiredTerminators = 0
 		for _, t := range service.Terminators {
 			routerOnline := self.Network.ConnectedRouter(t.Router)
+			if t.Draining {
+				continue
+			}
 			if t.Precedence.IsDefault() && routerOnline {
 				usableDefaultTerminators++
 			} else if t.Precedence.IsRequired() && routerOnline {
@@ -161,7 +227,7 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		}
 	}
 
-	evt := &event.TerminatorEvent{
+	return &event.TerminatorEvent{
 		Namespace:                 event.TerminatorEventsNs,
 		EventType:                 eventType,
 		Timestamp:                 time.Now(),
@@ -176,7 +242,6 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		TotalTerminators:          totalTerminators,
 		UsableDefaultTerminators:  usableDefaultTerminators,
 		UsableRequiredTerminators: usableRequiredTerminators,
+		Draining:                  terminator.Draining,
 	}
-
-	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
@@ -69,12 +69,26 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[in
 		if !ok {
 			return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/UsageEventHandler interface.", reflect.TypeOf(val))
 		}
+		filter, err := newEventFilter(&event.UsageEvent{}, config)
+		if err != nil {
+			return err
+		}
+		if filter != nil {
+			handler = &filteredUsageEventHandler{filter: filter, wrapped: handler}
+		}
 		self.AddUsageEventHandler(handler)
 	} else if version == 3 {
 		handler, ok := val.(event.UsageEventV3Handler)
 		if !ok {
 			return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/UsageEventV3Handler interface.", reflect.TypeOf(val))
 		}
+		filter, err := newEventFilter(&event.UsageEventV3{}, config)
+		if err != nil {
+			return err
+		}
+		if filter != nil {
+			handler = &filteredUsageEventV3Handler{filter: filter, wrapped: handler}
+		}
 		self.AddUsageEventV3Handler(handler)
 	} else {
 		return errors.Errorf("unsupported usage version: %v", version)
@@ -0,0 +1,307 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/storage/ast"
+	"github.com/pkg/errors"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// eventFilter evaluates a filter expression against the fields of an event. Filters use the same query language
+// as the REST list endpoints, for example: serviceId = "x" and linkCount > 2. Fields may be referenced either by
+// their JSON name (service_id) or by the lower camel case form of their go field name (serviceId). Only scalar
+// fields may be used in filters.
+type eventFilter struct {
+	symbolTypes *eventSymbolTypes
+	query       ast.Query
+}
+
+// newEventFilter returns a filter for events of the same type as the given event, using the filter expression in
+// the subscription config. If the subscription doesn't have a filter, nil is returned.
+func newEventFilter(evt interface{}, config map[interface{}]interface{}) (*eventFilter, error) {
+	value, found := config["filter"]
+	if !found {
+		return nil, nil
+	}
+
+	filterStr, ok := value.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid type %v for event subscription filter, must be a string", reflect.TypeOf(value))
+	}
+
+	symbolTypes := newEventSymbolTypes(reflect.TypeOf(evt))
+	query, err := ast.Parse(symbolTypes, filterStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid event subscription filter '%v'", filterStr)
+	}
+
+	return &eventFilter{
+		symbolTypes: symbolTypes,
+		query:       query,
+	}, nil
+}
+
+func (self *eventFilter) matches(evt interface{}) bool {
+	return self.query.EvalBool(&eventSymbols{
+		eventSymbolTypes: self.symbolTypes,
+		value:            reflect.Indirect(reflect.ValueOf(evt)),
+	})
+}
+
+type eventField struct {
+	index    []int
+	nodeType ast.NodeType
+}
+
+type eventSymbolTypes struct {
+	fields map[string]*eventField
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+var timeType = reflect.TypeOf(time.Time{})
+
+func newEventSymbolTypes(t reflect.Type) *eventSymbolTypes {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	result := &eventSymbolTypes{
+		fields: map[string]*eventField{},
+	}
+
+	for _, structField := range reflect.VisibleFields(t) {
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldType := structField.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		var nodeType ast.NodeType
+		switch {
+		case fieldType == timeType:
+			nodeType = ast.NodeTypeDatetime
+		case fieldType == durationType:
+			nodeType = ast.NodeTypeInt64
+		case fieldType.Kind() == reflect.String:
+			nodeType = ast.NodeTypeString
+		case fieldType.Kind() == reflect.Bool:
+			nodeType = ast.NodeTypeBool
+		case fieldType.Kind() >= reflect.Int && fieldType.Kind() <= reflect.Uint64:
+			nodeType = ast.NodeTypeInt64
+		case fieldType.Kind() == reflect.Float32 || fieldType.Kind() == reflect.Float64:
+			nodeType = ast.NodeTypeFloat64
+		default:
+			continue
+		}
+
+		field := &eventField{
+			index:    structField.Index,
+			nodeType: nodeType,
+		}
+
+		result.fields[strings.ToLower(structField.Name[:1])+structField.Name[1:]] = field
+		if jsonName := strings.Split(structField.Tag.Get("json"), ",")[0]; jsonName != "" && jsonName != "-" {
+			result.fields[jsonName] = field
+		}
+	}
+
+	return result
+}
+
+func (self *eventSymbolTypes) GetSymbolType(name string) (ast.NodeType, bool) {
+	if field, found := self.fields[name]; found {
+		return field.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *eventSymbolTypes) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *eventSymbolTypes) IsSet(name string) (bool, bool) {
+	_, found := self.fields[name]
+	return false, found
+}
+
+// eventSymbols provides the field values of a single event to a filter
+type eventSymbols struct {
+	*eventSymbolTypes
+	value reflect.Value
+}
+
+func (self *eventSymbols) getField(name string) (reflect.Value, bool) {
+	field, found := self.fields[name]
+	if !found {
+		return reflect.Value{}, false
+	}
+	val := self.value.FieldByIndex(field.index)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return reflect.Value{}, false
+		}
+		val = val.Elem()
+	}
+	return val, true
+}
+
+func (self *eventSymbols) EvalBool(name string) *bool {
+	if val, found := self.getField(name); found && val.Kind() == reflect.Bool {
+		result := val.Bool()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventSymbols) EvalString(name string) *string {
+	if val, found := self.getField(name); found && val.Kind() == reflect.String {
+		result := val.String()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventSymbols) EvalInt64(name string) *int64 {
+	val, found := self.getField(name)
+	if !found {
+		return nil
+	}
+
+	var result int64
+	switch {
+	case val.Kind() >= reflect.Int && val.Kind() <= reflect.Int64:
+		result = val.Int()
+	case val.Kind() >= reflect.Uint && val.Kind() <= reflect.Uint64:
+		result = int64(val.Uint())
+	default:
+		return nil
+	}
+	return &result
+}
+
+func (self *eventSymbols) EvalFloat64(name string) *float64 {
+	if val, found := self.getField(name); found && (val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64) {
+		result := val.Float()
+		return &result
+	}
+	return nil
+}
+
+func (self *eventSymbols) EvalDatetime(name string) *time.Time {
+	if val, found := self.getField(name); found && val.Type() == timeType {
+		result := val.Interface().(time.Time)
+		return &result
+	}
+	return nil
+}
+
+func (self *eventSymbols) IsNil(name string) bool {
+	_, found := self.getField(name)
+	return !found
+}
+
+func (self *eventSymbols) OpenSetCursor(string) ast.SetCursor {
+	return nil
+}
+
+func (self *eventSymbols) OpenSetCursorForQuery(string, ast.Query) ast.SetCursor {
+	return nil
+}
+
+type filteredLinkEventHandler struct {
+	filter  *eventFilter
+	wrapped event.LinkEventHandler
+}
+
+func (self *filteredLinkEventHandler) AcceptLinkEvent(evt *event.LinkEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptLinkEvent(evt)
+	}
+}
+
+type filteredMetricsEventHandler struct {
+	filter  *eventFilter
+	wrapped event.MetricsEventHandler
+}
+
+func (self *filteredMetricsEventHandler) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptMetricsEvent(evt)
+	}
+}
+
+type filteredRouterEventHandler struct {
+	filter  *eventFilter
+	wrapped event.RouterEventHandler
+}
+
+func (self *filteredRouterEventHandler) AcceptRouterEvent(evt *event.RouterEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptRouterEvent(evt)
+	}
+}
+
+type filteredServiceEventHandler struct {
+	filter  *eventFilter
+	wrapped event.ServiceEventHandler
+}
+
+func (self *filteredServiceEventHandler) AcceptServiceEvent(evt *event.ServiceEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptServiceEvent(evt)
+	}
+}
+
+type filteredTerminatorEventHandler struct {
+	filter  *eventFilter
+	wrapped event.TerminatorEventHandler
+}
+
+func (self *filteredTerminatorEventHandler) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptTerminatorEvent(evt)
+	}
+}
+
+type filteredUsageEventHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventHandler
+}
+
+func (self *filteredUsageEventHandler) AcceptUsageEvent(evt *event.UsageEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptUsageEvent(evt)
+	}
+}
+
+type filteredUsageEventV3Handler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventV3Handler
+}
+
+func (self *filteredUsageEventV3Handler) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptUsageEventV3(evt)
+	}
+}
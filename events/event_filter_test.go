@@ -0,0 +1,66 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFilter(t *testing.T) {
+	req := require.New(t)
+
+	filter, err := newEventFilter(&event.CircuitEvent{}, map[interface{}]interface{}{
+		"filter": `serviceId = "svc" and linkCount > 2`,
+	})
+	req.NoError(err)
+
+	req.True(filter.matches(&event.CircuitEvent{ServiceId: "svc", LinkCount: 3}))
+	req.False(filter.matches(&event.CircuitEvent{ServiceId: "svc", LinkCount: 2}))
+	req.False(filter.matches(&event.CircuitEvent{ServiceId: "other", LinkCount: 3}))
+
+	// json field names may also be used
+	filter, err = newEventFilter(&event.LinkEvent{}, map[interface{}]interface{}{
+		"filter": `src_router_id in ["r1", "r2"] and eventType != "deleted"`,
+	})
+	req.NoError(err)
+
+	req.True(filter.matches(&event.LinkEvent{SrcRouterId: "r1", EventType: event.LinkConnected}))
+	req.False(filter.matches(&event.LinkEvent{SrcRouterId: "r3", EventType: event.LinkConnected}))
+
+	// pointer fields are nil aware
+	filter, err = newEventFilter(&event.CircuitEvent{}, map[interface{}]interface{}{
+		"filter": `creationTimespan > 1000 or failureCause = null`,
+	})
+	req.NoError(err)
+
+	cause := "NO_PATH"
+	timespan := time.Second
+	req.True(filter.matches(&event.CircuitEvent{}))
+	req.False(filter.matches(&event.CircuitEvent{FailureCause: &cause}))
+	req.True(filter.matches(&event.CircuitEvent{FailureCause: &cause, CreationTimespan: &timespan}))
+
+	_, err = newEventFilter(&event.CircuitEvent{}, map[interface{}]interface{}{"filter": `unknownField = 1`})
+	req.Error(err)
+
+	filter, err = newEventFilter(&event.CircuitEvent{}, map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Nil(filter)
+}
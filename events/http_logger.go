@@ -0,0 +1,340 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/identity"
+	"github.com/pkg/errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	HttpEventLoggerSignatureHeader = "X-Ziti-Signature"
+
+	httpEventLoggerDefaultBufferSize       = 100
+	httpEventLoggerDefaultBatchSize        = 100
+	httpEventLoggerDefaultBatchInterval    = 5 * time.Second
+	httpEventLoggerDefaultMaxQueuedBatches = 100
+	httpEventLoggerDefaultMaxRetries       = 5
+	httpEventLoggerDefaultRetryBackoff     = time.Second
+	httpEventLoggerDefaultMaxRetryBackoff  = 30 * time.Second
+	httpEventLoggerDefaultTimeout          = 10 * time.Second
+)
+
+type HttpEventLoggerFactory struct {
+	closeNotify <-chan struct{}
+}
+
+func (self HttpEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewHttpEventLogger(config, self.closeNotify)
+}
+
+/**
+Example configuration:
+events:
+  siem:
+    subscriptions:
+      - type: fabric.circuits
+    handler:
+      type: http
+      url: https://siem.example.com/ingest
+      batchSize: 100
+      batchInterval: 5s
+      maxQueuedBatches: 100
+      maxRetries: 5
+      retryBackoff: 1s
+      maxRetryBackoff: 30s
+      timeout: 10s
+      hmacSecret: secret
+      headers:
+        Authorization: Bearer token
+      identity:
+        cert: /path/to/client.cert
+        key: /path/to/client.key
+        ca: /path/to/ca.pem
+*/
+
+// HttpEventLogger batches JSON formatted events and POSTs each batch to a configured URL, as a JSON array.
+// Batches which can't be delivered are retried with exponential backoff. If the batch queue is full, new batches
+// are dropped, so a slow or unavailable endpoint never blocks event dispatch. If an hmacSecret is configured, the
+// HMAC-SHA256 of the request body is sent in the X-Ziti-Signature header, as sha256=<hex encoded hmac>.
+type HttpEventLogger struct {
+	*JsonFormatter
+	url             string
+	client          *http.Client
+	headers         map[string]string
+	hmacSecret      []byte
+	batchSize       int
+	batchInterval   time.Duration
+	maxRetries      int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	batches         chan [][]byte
+	closeNotify     <-chan struct{}
+}
+
+func NewHttpEventLogger(config map[interface{}]interface{}, closeNotify <-chan struct{}) (*HttpEventLogger, error) {
+	result := &HttpEventLogger{
+		client:          &http.Client{},
+		headers:         map[string]string{},
+		batchSize:       httpEventLoggerDefaultBatchSize,
+		batchInterval:   httpEventLoggerDefaultBatchInterval,
+		maxRetries:      httpEventLoggerDefaultMaxRetries,
+		retryBackoff:    httpEventLoggerDefaultRetryBackoff,
+		maxRetryBackoff: httpEventLoggerDefaultMaxRetryBackoff,
+		closeNotify:     closeNotify,
+	}
+
+	if value, found := config["url"]; found {
+		if urlStr, ok := value.(string); ok {
+			if _, err := url.Parse(urlStr); err != nil {
+				return nil, errors.Wrapf(err, "invalid 'url' for http event handler: %v", urlStr)
+			}
+			result.url = urlStr
+		} else {
+			return nil, errors.New("invalid 'url' for http event handler")
+		}
+	} else {
+		return nil, errors.New("missing required 'url' config for http event handler")
+	}
+
+	bufferSize, err := getIntConfig(config, "bufferSize", httpEventLoggerDefaultBufferSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.batchSize, err = getIntConfig(config, "batchSize", httpEventLoggerDefaultBatchSize); err != nil {
+		return nil, err
+	}
+
+	maxQueuedBatches, err := getIntConfig(config, "maxQueuedBatches", httpEventLoggerDefaultMaxQueuedBatches)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.maxRetries, err = getIntConfig(config, "maxRetries", httpEventLoggerDefaultMaxRetries); err != nil {
+		return nil, err
+	}
+
+	if result.batchInterval, err = getDurationConfig(config, "batchInterval", httpEventLoggerDefaultBatchInterval); err != nil {
+		return nil, err
+	}
+
+	if result.retryBackoff, err = getDurationConfig(config, "retryBackoff", httpEventLoggerDefaultRetryBackoff); err != nil {
+		return nil, err
+	}
+
+	if result.maxRetryBackoff, err = getDurationConfig(config, "maxRetryBackoff", httpEventLoggerDefaultMaxRetryBackoff); err != nil {
+		return nil, err
+	}
+
+	if result.client.Timeout, err = getDurationConfig(config, "timeout", httpEventLoggerDefaultTimeout); err != nil {
+		return nil, err
+	}
+
+	if result.batchSize < 1 || maxQueuedBatches < 1 || bufferSize < 1 {
+		return nil, errors.New("'bufferSize', 'batchSize' and 'maxQueuedBatches' for http event handler must be greater than 0")
+	}
+
+	if value, found := config["hmacSecret"]; found {
+		if secret, ok := value.(string); ok && secret != "" {
+			result.hmacSecret = []byte(secret)
+		} else {
+			return nil, errors.New("invalid 'hmacSecret' for http event handler")
+		}
+	}
+
+	if value, found := config["headers"]; found {
+		if headers, ok := value.(map[interface{}]interface{}); ok {
+			for k, v := range headers {
+				result.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+			}
+		} else {
+			return nil, errors.New("invalid 'headers' for http event handler, must be a map")
+		}
+	}
+
+	if value, found := config["identity"]; found {
+		identityMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid 'identity' for http event handler, must be a map")
+		}
+		identityConfig, err := identity.NewConfigFromMap(identityMap)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid 'identity' for http event handler")
+		}
+		id, err := identity.LoadIdentity(*identityConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load 'identity' for http event handler")
+		}
+		result.client.Transport = &http.Transport{
+			TLSClientConfig: id.ClientTLSConfig(),
+		}
+	}
+
+	result.JsonFormatter = NewJsonFormatter(bufferSize, nil)
+	result.batches = make(chan [][]byte, maxQueuedBatches)
+
+	go result.batchEvents()
+	go result.sendBatches()
+
+	return result, nil
+}
+
+// batchEvents collects formatted events into batches. A batch is queued for sending once it's full, or once the
+// batch interval has passed.
+func (self *HttpEventLogger) batchEvents() {
+	ticker := time.NewTicker(self.batchInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+	for {
+		select {
+		case evt := <-self.events:
+			buf := &bytes.Buffer{}
+			if err := evt.WriteTo(buf); err != nil {
+				pfxlog.Logger().WithError(err).Errorf("failed to format event of type %T", evt)
+				continue
+			}
+			batch = append(batch, buf.Bytes())
+			if len(batch) >= self.batchSize {
+				self.queueBatch(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				self.queueBatch(batch)
+				batch = nil
+			}
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *HttpEventLogger) queueBatch(batch [][]byte) {
+	select {
+	case self.batches <- batch:
+	default:
+		pfxlog.Logger().WithField("url", self.url).WithField("eventCount", len(batch)).
+			Warn("http event handler queue full, dropping events")
+	}
+}
+
+func (self *HttpEventLogger) sendBatches() {
+	for {
+		select {
+		case batch := <-self.batches:
+			self.sendWithRetry(batch)
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *HttpEventLogger) sendWithRetry(batch [][]byte) {
+	log := pfxlog.Logger().WithField("url", self.url).WithField("eventCount", len(batch))
+
+	body := append([]byte("["), bytes.Join(batch, []byte(","))...)
+	body = append(body, ']')
+
+	backoff := self.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := self.send(body)
+		if err == nil {
+			return
+		}
+
+		if attempt >= self.maxRetries {
+			log.WithError(err).Errorf("failed to send events after %v attempts, dropping events", attempt+1)
+			return
+		}
+
+		log.WithError(err).Warnf("failed to send events, retrying in %v", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-self.closeNotify:
+			return
+		}
+
+		backoff *= 2
+		if backoff > self.maxRetryBackoff {
+			backoff = self.maxRetryBackoff
+		}
+	}
+}
+
+func (self *HttpEventLogger) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, self.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range self.headers {
+		req.Header.Set(k, v)
+	}
+
+	if self.hmacSecret != nil {
+		mac := hmac.New(sha256.New, self.hmacSecret)
+		mac.Write(body)
+		req.Header.Set(HttpEventLoggerSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected response status %v", resp.Status)
+	}
+	return nil
+}
+
+func getIntConfig(config map[interface{}]interface{}, key string, defaultValue int) (int, error) {
+	if value, found := config[key]; found {
+		if result, ok := value.(int); ok {
+			return result, nil
+		}
+		return 0, errors.Errorf("invalid '%v' for event handler, must be an integer", key)
+	}
+	return defaultValue, nil
+}
+
+func getDurationConfig(config map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	if value, found := config[key]; found {
+		if str, ok := value.(string); ok {
+			result, err := time.ParseDuration(str)
+			if err != nil {
+				return 0, errors.Wrapf(err, "invalid '%v' for event handler", key)
+			}
+			return result, nil
+		}
+		return 0, errors.Errorf("invalid '%v' for event handler, must be a duration, such as 5s", key)
+	}
+	return defaultValue, nil
+}
@@ -0,0 +1,104 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/event"
+	"github.com/stretchr/testify/require"
+)
+
+type httpTestRequest struct {
+	body      []byte
+	signature string
+}
+
+func TestHttpEventLogger(t *testing.T) {
+	req := require.New(t)
+
+	var failures int32 = 1
+	requests := make(chan *httpTestRequest, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail the first request, so the batch has to be retried
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		requests <- &httpTestRequest{body: body, signature: r.Header.Get(HttpEventLoggerSignatureHeader)}
+	}))
+	defer server.Close()
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	logger, err := NewHttpEventLogger(map[interface{}]interface{}{
+		"url":           server.URL,
+		"batchSize":     2,
+		"batchInterval": "1h",
+		"retryBackoff":  "10ms",
+		"hmacSecret":    "secret",
+	}, closeNotify)
+	req.NoError(err)
+
+	logger.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitCreated, CircuitId: "c1"})
+	logger.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitDeleted, CircuitId: "c1"})
+
+	var request *httpTestRequest
+	select {
+	case request = <-requests:
+	case <-time.After(5 * time.Second):
+		req.Fail("timed out waiting for events")
+	}
+
+	var events []*event.CircuitEvent
+	req.NoError(json.Unmarshal(request.body, &events))
+	req.Len(events, 2)
+	req.Equal(event.CircuitCreated, events[0].EventType)
+	req.Equal(event.CircuitDeleted, events[1].EventType)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(request.body)
+	req.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), request.signature)
+}
+
+func TestHttpEventLoggerConfig(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	_, err := NewHttpEventLogger(map[interface{}]interface{}{}, closeNotify)
+	req.Error(err)
+
+	_, err = NewHttpEventLogger(map[interface{}]interface{}{"url": "http://localhost", "batchInterval": "soon"}, closeNotify)
+	req.Error(err)
+
+	_, err = NewHttpEventLogger(map[interface{}]interface{}{"url": "http://localhost", "batchSize": 0}, closeNotify)
+	req.Error(err)
+}
@@ -0,0 +1,400 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	journalEventsBucket           = "events"
+	journalMetaBucket             = "meta"
+	journalLastSequenceKey        = "lastSequence"
+	journalDefaultBuffer          = 1000
+	journalMaxBatchSize           = 500
+	journalReadBatchSize          = 100
+	journalRetentionCheckInterval = time.Minute
+	journalDropLogInterval        = 1000
+)
+
+/**
+Example configuration:
+eventJournal:
+  path: /var/lib/ziti/event-journal.db
+  maxAge: 168h
+  maxSizeMb: 1024
+  bufferSize: 1000
+  usageVersion: 2
+  includeMetrics: false
+*/
+
+// EventJournal records dispatched events in an append-only bolt database. Each event is stored with a sequence
+// number, so consumers can resume from the last event they processed. Events older than maxAge are removed, as
+// are the oldest events once the retained events exceed maxSize. Events are written in the background. If writes
+// fall behind by more than bufferSize events, new events are dropped rather than holding up event dispatch.
+type EventJournal struct {
+	db           *bbolt.DB
+	maxAge       time.Duration
+	maxSize      int64
+	size         int64
+	lastSequence uint64
+	dropped      uint64
+	entries      chan *event.JournalEntry
+	notifyLock   sync.Mutex
+	notify       chan struct{}
+	closeNotify  <-chan struct{}
+}
+
+type journalRecord struct {
+	Namespace string          `json:"namespace"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func sequenceKey(sequence uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	return key
+}
+
+// NewEventJournal opens or creates the journal database at the configured path
+func NewEventJournal(config map[interface{}]interface{}, closeNotify <-chan struct{}) (*EventJournal, error) {
+	path := ""
+	if value, found := config["path"]; found {
+		if str, ok := value.(string); ok && str != "" {
+			path = str
+		} else {
+			return nil, errors.New("invalid event journal 'path' value")
+		}
+	} else {
+		return nil, errors.New("missing required 'path' config for event journal")
+	}
+
+	bufferSize, err := getIntConfig(config, "bufferSize", journalDefaultBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSizeMb, err := getIntConfig(config, "maxSizeMb", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge, err := getDurationConfig(config, "maxAge", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open event journal at %v", path)
+	}
+
+	result := &EventJournal{
+		db:          db,
+		maxAge:      maxAge,
+		maxSize:     int64(maxSizeMb) * 1024 * 1024,
+		entries:     make(chan *event.JournalEntry, bufferSize),
+		notify:      make(chan struct{}),
+		closeNotify: closeNotify,
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		events, err := tx.CreateBucketIfNotExists([]byte(journalEventsBucket))
+		if err != nil {
+			return err
+		}
+		meta, err := tx.CreateBucketIfNotExists([]byte(journalMetaBucket))
+		if err != nil {
+			return err
+		}
+		if val := meta.Get([]byte(journalLastSequenceKey)); val != nil {
+			result.lastSequence = binary.BigEndian.Uint64(val)
+		}
+		return events.ForEach(func(k, v []byte) error {
+			result.size += int64(len(k) + len(v))
+			return nil
+		})
+	})
+
+	if err != nil {
+		_ = db.Close()
+		return nil, errors.Wrapf(err, "unable to initialize event journal at %v", path)
+	}
+
+	go result.run()
+
+	return result, nil
+}
+
+func (self *EventJournal) GetLastSequence() uint64 {
+	return atomic.LoadUint64(&self.lastSequence)
+}
+
+// GetDroppedCount returns how many events weren't journaled because the write buffer was full
+func (self *EventJournal) GetDroppedCount() uint64 {
+	return atomic.LoadUint64(&self.dropped)
+}
+
+func (self *EventJournal) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptLinkEvent(evt *event.LinkEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptRouterEvent(evt *event.RouterEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptServiceEvent(evt *event.ServiceEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptUsageEvent(evt *event.UsageEvent) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	self.record(evt.Namespace, evt)
+}
+
+func (self *EventJournal) record(namespace string, evt interface{}) {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to marshal %v event for event journal", namespace)
+		return
+	}
+
+	entry := &event.JournalEntry{
+		Namespace: namespace,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+
+	select {
+	case self.entries <- entry:
+	case <-self.closeNotify:
+	default:
+		if dropped := atomic.AddUint64(&self.dropped, 1); dropped%journalDropLogInterval == 1 {
+			pfxlog.Logger().WithField("dropped", dropped).
+				Warnf("event journal buffer full, dropping %v event", namespace)
+		}
+	}
+}
+
+func (self *EventJournal) run() {
+	retentionTicker := time.NewTicker(journalRetentionCheckInterval)
+	defer retentionTicker.Stop()
+
+	defer func() {
+		if err := self.db.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Error("error closing event journal")
+		}
+	}()
+
+	for {
+		select {
+		case entry := <-self.entries:
+			batch := []*event.JournalEntry{entry}
+			for len(batch) < journalMaxBatchSize && len(self.entries) > 0 {
+				batch = append(batch, <-self.entries)
+			}
+			if err := self.append(batch); err != nil {
+				pfxlog.Logger().WithError(err).Errorf("unable to write %v events to event journal", len(batch))
+			}
+		case <-retentionTicker.C:
+			if err := self.enforceRetention(); err != nil {
+				pfxlog.Logger().WithError(err).Error("unable to remove expired events from event journal")
+			}
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *EventJournal) append(batch []*event.JournalEntry) error {
+	sequence := self.GetLastSequence()
+	var size int64
+
+	err := self.db.Update(func(tx *bbolt.Tx) error {
+		events := tx.Bucket([]byte(journalEventsBucket))
+		for _, entry := range batch {
+			val, err := json.Marshal(&journalRecord{
+				Namespace: entry.Namespace,
+				Timestamp: entry.Timestamp,
+				Data:      entry.Data,
+			})
+			if err != nil {
+				return err
+			}
+			sequence++
+			key := sequenceKey(sequence)
+			if err = events.Put(key, val); err != nil {
+				return err
+			}
+			size += int64(len(key) + len(val))
+		}
+		return tx.Bucket([]byte(journalMetaBucket)).Put([]byte(journalLastSequenceKey), sequenceKey(sequence))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&self.size, size)
+	atomic.StoreUint64(&self.lastSequence, sequence)
+
+	self.notifyLock.Lock()
+	close(self.notify)
+	self.notify = make(chan struct{})
+	self.notifyLock.Unlock()
+
+	return nil
+}
+
+func (self *EventJournal) getNotify() <-chan struct{} {
+	self.notifyLock.Lock()
+	defer self.notifyLock.Unlock()
+	return self.notify
+}
+
+// enforceRetention removes events, oldest first, while they are older than maxAge or while the retained events
+// are larger than maxSize
+func (self *EventJournal) enforceRetention() error {
+	if self.maxAge == 0 && self.maxSize == 0 {
+		return nil
+	}
+
+	var removed int64
+	err := self.db.Update(func(tx *bbolt.Tx) error {
+		events := tx.Bucket([]byte(journalEventsBucket))
+		size := atomic.LoadInt64(&self.size)
+
+		var expired [][]byte
+		cursor := events.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			record := &journalRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+			tooOld := self.maxAge > 0 && time.Since(record.Timestamp) > self.maxAge
+			tooBig := self.maxSize > 0 && size-removed > self.maxSize
+			if !tooOld && !tooBig {
+				break
+			}
+			expired = append(expired, k)
+			removed += int64(len(k) + len(v))
+		}
+
+		for _, k := range expired {
+			if err := events.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err == nil {
+		atomic.AddInt64(&self.size, -removed)
+	}
+	return err
+}
+
+func (self *EventJournal) read(fromSequence uint64, limit int) ([]*event.JournalEntry, error) {
+	var result []*event.JournalEntry
+	err := self.db.View(func(tx *bbolt.Tx) error {
+		cursor := tx.Bucket([]byte(journalEventsBucket)).Cursor()
+		for k, v := cursor.Seek(sequenceKey(fromSequence)); k != nil && len(result) < limit; k, v = cursor.Next() {
+			record := &journalRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return err
+			}
+			result = append(result, &event.JournalEntry{
+				Sequence:  binary.BigEndian.Uint64(k),
+				Namespace: record.Namespace,
+				Timestamp: record.Timestamp,
+				Data:      append([]byte(nil), record.Data...),
+			})
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (self *EventJournal) Subscribe(fromSequence uint64, handler event.JournalEntryHandler) func() {
+	done := make(chan struct{})
+	once := sync.Once{}
+	unsubscribe := func() {
+		once.Do(func() { close(done) })
+	}
+
+	go func() {
+		defer unsubscribe()
+		next := fromSequence
+		for {
+			notify := self.getNotify()
+			entries, err := self.read(next, journalReadBatchSize)
+			if err != nil {
+				pfxlog.Logger().WithError(err).Error("unable to read from event journal, closing subscription")
+				return
+			}
+
+			for _, entry := range entries {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if err = handler(entry); err != nil {
+					pfxlog.Logger().WithError(err).Debug("event journal subscriber returned error, closing subscription")
+					return
+				}
+				next = entry.Sequence + 1
+			}
+
+			if len(entries) < journalReadBatchSize {
+				select {
+				case <-notify:
+				case <-done:
+					return
+				case <-self.closeNotify:
+					return
+				}
+			}
+		}
+	}()
+
+	return unsubscribe
+}
@@ -0,0 +1,113 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventJournal(t *testing.T) {
+	req := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "journal.db")
+	closeNotify := make(chan struct{})
+
+	journal, err := NewEventJournal(map[interface{}]interface{}{"path": path}, closeNotify)
+	req.NoError(err)
+
+	for i := 0; i < 5; i++ {
+		journal.AcceptCircuitEvent(&event.CircuitEvent{Namespace: event.CircuitEventsNs, CircuitId: "c1"})
+	}
+
+	entries := make(chan *event.JournalEntry, 10)
+	unsubscribe := journal.Subscribe(3, func(entry *event.JournalEntry) error {
+		entries <- entry
+		return nil
+	})
+
+	// backlog is delivered first, followed by live events
+	journal.AcceptLinkEvent(&event.LinkEvent{Namespace: event.LinkEventsNs, LinkId: "l1"})
+
+	for i := uint64(3); i <= 6; i++ {
+		select {
+		case entry := <-entries:
+			req.Equal(i, entry.Sequence)
+			if i < 6 {
+				req.Equal(event.CircuitEventsNs, entry.Namespace)
+			} else {
+				req.Equal(event.LinkEventsNs, entry.Namespace)
+				linkEvent := &event.LinkEvent{}
+				req.NoError(json.Unmarshal(entry.Data, linkEvent))
+				req.Equal("l1", linkEvent.LinkId)
+			}
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for journal entry")
+		}
+	}
+	unsubscribe()
+
+	// sequences continue after the journal is reopened
+	close(closeNotify)
+	closeNotify = make(chan struct{})
+	defer close(closeNotify)
+
+	for i := 0; i < 50; i++ {
+		if journal, err = NewEventJournal(map[interface{}]interface{}{"path": path}, closeNotify); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	req.NoError(err)
+	req.Equal(uint64(6), journal.GetLastSequence())
+
+	// size based retention removes the oldest events first
+	journal.maxSize = journal.size / 2
+	req.NoError(journal.enforceRetention())
+
+	retained, err := journal.read(0, 10)
+	req.NoError(err)
+	req.Equal(3, len(retained))
+	req.Equal(uint64(4), retained[0].Sequence)
+	req.LessOrEqual(journal.size, journal.maxSize)
+}
+
+func TestEventJournalDropsWhenFull(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	// without the writer running, the buffer fills up and further events are dropped instead of blocking
+	journal := &EventJournal{
+		entries:     make(chan *event.JournalEntry, 1),
+		closeNotify: closeNotify,
+	}
+
+	journal.AcceptCircuitEvent(&event.CircuitEvent{Namespace: event.CircuitEventsNs, CircuitId: "c1"})
+	req.Equal(uint64(0), journal.GetDroppedCount())
+
+	journal.AcceptCircuitEvent(&event.CircuitEvent{Namespace: event.CircuitEventsNs, CircuitId: "c2"})
+	journal.AcceptCircuitEvent(&event.CircuitEvent{Namespace: event.CircuitEventsNs, CircuitId: "c3"})
+	req.Equal(uint64(2), journal.GetDroppedCount())
+	req.Len(journal.entries, 1)
+}
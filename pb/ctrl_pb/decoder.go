@@ -113,6 +113,69 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			return nil, true
 		}
 
+	case int32(ContentType_TerminatorHealthChecksType):
+		request := &TerminatorHealthChecks{}
+		if err := proto.Unmarshal(msg.Body, request); err == nil {
+			meta := channel.NewTraceMessageDecode(DECODER, "Terminator Health Checks")
+			meta["checks"] = len(request.Checks)
+			meta["removed"] = len(request.RemovedTerminatorIds)
+			meta["fullSync"] = request.FullSync
+
+			data, err := meta.MarshalTraceMessageDecode()
+			if err != nil {
+				pfxlog.Logger().Errorf("unexpected error (%s)", err)
+				return nil, true
+			}
+
+			return data, true
+
+		} else {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+
+	case int32(ContentType_TerminatorLeaseRenewalsType):
+		request := &TerminatorLeaseRenewals{}
+		if err := proto.Unmarshal(msg.Body, request); err == nil {
+			meta := channel.NewTraceMessageDecode(DECODER, "Terminator Lease Renewals")
+			meta["terminators"] = len(request.TerminatorIds)
+
+			data, err := meta.MarshalTraceMessageDecode()
+			if err != nil {
+				pfxlog.Logger().Errorf("unexpected error (%s)", err)
+				return nil, true
+			}
+
+			return data, true
+
+		} else {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+
+	case int32(ContentType_TerminatorHealthStatusType):
+		request := &TerminatorHealthStatus{}
+		if err := proto.Unmarshal(msg.Body, request); err == nil {
+			meta := channel.NewTraceMessageDecode(DECODER, "Terminator Health Status")
+			meta["terminatorId"] = request.TerminatorId
+			meta["healthy"] = request.Healthy
+			if request.Message != "" {
+				meta["message"] = request.Message
+			}
+
+			data, err := meta.MarshalTraceMessageDecode()
+			if err != nil {
+				pfxlog.Logger().Errorf("unexpected error (%s)", err)
+				return nil, true
+			}
+
+			return data, true
+
+		} else {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+
 	case int32(ContentType_VerifyLinkType):
 		request := &VerifyLink{}
 		if err := proto.Unmarshal(msg.Body, request); err == nil {
@@ -350,6 +413,9 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 		if !success {
 			meta["errormsg"], _ = msg.GetStringHeader(ctrl_msg.RouteResultErrorHeader)
 		}
+		if dialDuration, found := ctrl_msg.GetDialDuration(msg); found {
+			meta["dialDuration"] = dialDuration.String()
+		}
 
 		data, err := meta.MarshalTraceMessageDecode()
 		if err != nil {
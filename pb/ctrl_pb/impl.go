@@ -38,6 +38,22 @@ func (request *VerifyRouter) GetContentType() int32 {
 	return int32(ContentType_VerifyRouterType)
 }
 
+func (request *RouterCircuits) GetContentType() int32 {
+	return int32(ContentType_RouterCircuitsType)
+}
+
+func (request *TerminatorHealthChecks) GetContentType() int32 {
+	return int32(ContentType_TerminatorHealthChecksType)
+}
+
+func (request *TerminatorHealthStatus) GetContentType() int32 {
+	return int32(ContentType_TerminatorHealthStatusType)
+}
+
+func (request *TerminatorLeaseRenewals) GetContentType() int32 {
+	return int32(ContentType_TerminatorLeaseRenewalsType)
+}
+
 func (request *Fault) GetContentType() int32 {
 	return int32(ContentType_FaultType)
 }
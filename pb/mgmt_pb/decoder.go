@@ -43,6 +43,27 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			return nil, true
 		}
 		return data, true
+	case int32(ContentType_StreamEventJournalRequestType):
+		data, err := channel.NewTraceMessageDecode(DECODER, "Stream Event Journal Request").MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
+	case int32(ContentType_RoutePreviewRequestType):
+		data, err := channel.NewTraceMessageDecode(DECODER, "Route Preview Request").MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
+	case int32(ContentType_RerouteCircuitRequestType):
+		data, err := channel.NewTraceMessageDecode(DECODER, "Reroute Circuit Request").MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
 	case int32(ContentType_StreamTracesRequestType):
 		data, err := channel.NewTraceMessageDecode(DECODER, "Stream Traces Request").MarshalTraceMessageDecode()
 		if err != nil {
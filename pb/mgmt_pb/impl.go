@@ -11,3 +11,27 @@ func (request *InspectResponse) GetContentType() int32 {
 func (request *RaftMemberListResponse) GetContentType() int32 {
 	return int32(ContentType_RaftListMembersResponseType)
 }
+
+func (request *StreamEventJournalRequest) GetContentType() int32 {
+	return int32(ContentType_StreamEventJournalRequestType)
+}
+
+func (request *StreamEventJournalEvent) GetContentType() int32 {
+	return int32(ContentType_StreamEventJournalEventType)
+}
+
+func (request *RoutePreviewRequest) GetContentType() int32 {
+	return int32(ContentType_RoutePreviewRequestType)
+}
+
+func (request *RoutePreviewResponse) GetContentType() int32 {
+	return int32(ContentType_RoutePreviewResponseType)
+}
+
+func (request *RerouteCircuitRequest) GetContentType() int32 {
+	return int32(ContentType_RerouteCircuitRequestType)
+}
+
+func (request *RerouteCircuitResponse) GetContentType() int32 {
+	return int32(ContentType_RerouteCircuitResponseType)
+}
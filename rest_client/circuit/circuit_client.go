@@ -60,6 +60,8 @@ type ClientService interface {
 
 	ListCircuits(params *ListCircuitsParams, opts ...ClientOption) (*ListCircuitsOK, error)
 
+	RerouteCircuit(params *RerouteCircuitParams, opts ...ClientOption) (*RerouteCircuitOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -184,6 +186,50 @@ func (a *Client) ListCircuits(params *ListCircuitsParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+	RerouteCircuit moves a circuit onto an explicit path
+
+	Reroutes the circuit onto a path visiting the given routers in order. The circuit's initiating and terminating
+
+routers may be omitted from the path. If pin is set, the circuit is exempt from smart rerouting until it's
+moved again. An empty path with pin unset unpins the circuit, leaving it on its current path. Requires admin
+access.
+*/
+func (a *Client) RerouteCircuit(params *RerouteCircuitParams, opts ...ClientOption) (*RerouteCircuitOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRerouteCircuitParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rerouteCircuit",
+		Method:             "POST",
+		PathPattern:        "/circuits/{id}/reroute",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RerouteCircuitReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RerouteCircuitOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rerouteCircuit: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package circuit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// NewRerouteCircuitParams creates a new RerouteCircuitParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRerouteCircuitParams() *RerouteCircuitParams {
+	return &RerouteCircuitParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRerouteCircuitParamsWithTimeout creates a new RerouteCircuitParams object
+// with the ability to set a timeout on a request.
+func NewRerouteCircuitParamsWithTimeout(timeout time.Duration) *RerouteCircuitParams {
+	return &RerouteCircuitParams{
+		timeout: timeout,
+	}
+}
+
+// NewRerouteCircuitParamsWithContext creates a new RerouteCircuitParams object
+// with the ability to set a context for a request.
+func NewRerouteCircuitParamsWithContext(ctx context.Context) *RerouteCircuitParams {
+	return &RerouteCircuitParams{
+		Context: ctx,
+	}
+}
+
+// NewRerouteCircuitParamsWithHTTPClient creates a new RerouteCircuitParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRerouteCircuitParamsWithHTTPClient(client *http.Client) *RerouteCircuitParams {
+	return &RerouteCircuitParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RerouteCircuitParams contains all the parameters to send to the API endpoint
+
+	for the reroute circuit operation.
+
+	Typically these are written to a http.Request.
+*/
+type RerouteCircuitParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	/* Reroute.
+
+	   The path to move the circuit to
+	*/
+	Reroute *rest_model.CircuitReroute
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the reroute circuit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RerouteCircuitParams) WithDefaults() *RerouteCircuitParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the reroute circuit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RerouteCircuitParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the reroute circuit params
+func (o *RerouteCircuitParams) WithTimeout(timeout time.Duration) *RerouteCircuitParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the reroute circuit params
+func (o *RerouteCircuitParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the reroute circuit params
+func (o *RerouteCircuitParams) WithContext(ctx context.Context) *RerouteCircuitParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the reroute circuit params
+func (o *RerouteCircuitParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the reroute circuit params
+func (o *RerouteCircuitParams) WithHTTPClient(client *http.Client) *RerouteCircuitParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the reroute circuit params
+func (o *RerouteCircuitParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the reroute circuit params
+func (o *RerouteCircuitParams) WithID(id string) *RerouteCircuitParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the reroute circuit params
+func (o *RerouteCircuitParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithReroute adds the reroute to the reroute circuit params
+func (o *RerouteCircuitParams) WithReroute(reroute *rest_model.CircuitReroute) *RerouteCircuitParams {
+	o.SetReroute(reroute)
+	return o
+}
+
+// SetReroute adds the reroute to the reroute circuit params
+func (o *RerouteCircuitParams) SetReroute(reroute *rest_model.CircuitReroute) {
+	o.Reroute = reroute
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RerouteCircuitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+	if o.Reroute != nil {
+		if err := r.SetBodyParam(o.Reroute); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package circuit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// RerouteCircuitReader is a Reader for the RerouteCircuit structure.
+type RerouteCircuitReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RerouteCircuitReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRerouteCircuitOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRerouteCircuitBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewRerouteCircuitUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRerouteCircuitNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewRerouteCircuitOK creates a RerouteCircuitOK with default headers values
+func NewRerouteCircuitOK() *RerouteCircuitOK {
+	return &RerouteCircuitOK{}
+}
+
+/*
+RerouteCircuitOK describes a response with status code 200, with default header values.
+
+A single circuit
+*/
+type RerouteCircuitOK struct {
+	Payload *rest_model.DetailCircuitEnvelope
+}
+
+// IsSuccess returns true when this reroute circuit o k response has a 2xx status code
+func (o *RerouteCircuitOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this reroute circuit o k response has a 3xx status code
+func (o *RerouteCircuitOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reroute circuit o k response has a 4xx status code
+func (o *RerouteCircuitOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this reroute circuit o k response has a 5xx status code
+func (o *RerouteCircuitOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reroute circuit o k response a status code equal to that given
+func (o *RerouteCircuitOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *RerouteCircuitOK) Error() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitOK  %+v", 200, o.Payload)
+}
+
+func (o *RerouteCircuitOK) String() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitOK  %+v", 200, o.Payload)
+}
+
+func (o *RerouteCircuitOK) GetPayload() *rest_model.DetailCircuitEnvelope {
+	return o.Payload
+}
+
+func (o *RerouteCircuitOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.DetailCircuitEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRerouteCircuitBadRequest creates a RerouteCircuitBadRequest with default headers values
+func NewRerouteCircuitBadRequest() *RerouteCircuitBadRequest {
+	return &RerouteCircuitBadRequest{}
+}
+
+/*
+RerouteCircuitBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type RerouteCircuitBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this reroute circuit bad request response has a 2xx status code
+func (o *RerouteCircuitBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reroute circuit bad request response has a 3xx status code
+func (o *RerouteCircuitBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reroute circuit bad request response has a 4xx status code
+func (o *RerouteCircuitBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reroute circuit bad request response has a 5xx status code
+func (o *RerouteCircuitBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reroute circuit bad request response a status code equal to that given
+func (o *RerouteCircuitBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *RerouteCircuitBadRequest) Error() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RerouteCircuitBadRequest) String() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *RerouteCircuitBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *RerouteCircuitBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRerouteCircuitUnauthorized creates a RerouteCircuitUnauthorized with default headers values
+func NewRerouteCircuitUnauthorized() *RerouteCircuitUnauthorized {
+	return &RerouteCircuitUnauthorized{}
+}
+
+/*
+RerouteCircuitUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type RerouteCircuitUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this reroute circuit unauthorized response has a 2xx status code
+func (o *RerouteCircuitUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reroute circuit unauthorized response has a 3xx status code
+func (o *RerouteCircuitUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reroute circuit unauthorized response has a 4xx status code
+func (o *RerouteCircuitUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reroute circuit unauthorized response has a 5xx status code
+func (o *RerouteCircuitUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reroute circuit unauthorized response a status code equal to that given
+func (o *RerouteCircuitUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *RerouteCircuitUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RerouteCircuitUnauthorized) String() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RerouteCircuitUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *RerouteCircuitUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRerouteCircuitNotFound creates a RerouteCircuitNotFound with default headers values
+func NewRerouteCircuitNotFound() *RerouteCircuitNotFound {
+	return &RerouteCircuitNotFound{}
+}
+
+/*
+RerouteCircuitNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type RerouteCircuitNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this reroute circuit not found response has a 2xx status code
+func (o *RerouteCircuitNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reroute circuit not found response has a 3xx status code
+func (o *RerouteCircuitNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reroute circuit not found response has a 4xx status code
+func (o *RerouteCircuitNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reroute circuit not found response has a 5xx status code
+func (o *RerouteCircuitNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reroute circuit not found response a status code equal to that given
+func (o *RerouteCircuitNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *RerouteCircuitNotFound) Error() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RerouteCircuitNotFound) String() string {
+	return fmt.Sprintf("[POST /circuits/{id}/reroute][%d] rerouteCircuitNotFound  %+v", 404, o.Payload)
+}
+
+func (o *RerouteCircuitNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *RerouteCircuitNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// NewCreateLinkPolicyParams creates a new CreateLinkPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateLinkPolicyParams() *CreateLinkPolicyParams {
+	return &CreateLinkPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateLinkPolicyParamsWithTimeout creates a new CreateLinkPolicyParams object
+// with the ability to set a timeout on a request.
+func NewCreateLinkPolicyParamsWithTimeout(timeout time.Duration) *CreateLinkPolicyParams {
+	return &CreateLinkPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateLinkPolicyParamsWithContext creates a new CreateLinkPolicyParams object
+// with the ability to set a context for a request.
+func NewCreateLinkPolicyParamsWithContext(ctx context.Context) *CreateLinkPolicyParams {
+	return &CreateLinkPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateLinkPolicyParamsWithHTTPClient creates a new CreateLinkPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateLinkPolicyParamsWithHTTPClient(client *http.Client) *CreateLinkPolicyParams {
+	return &CreateLinkPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateLinkPolicyParams contains all the parameters to send to the API endpoint
+
+	for the create link policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateLinkPolicyParams struct {
+
+	/* LinkPolicy.
+
+	   A link policy to create
+	*/
+	LinkPolicy *rest_model.LinkPolicyCreate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateLinkPolicyParams) WithDefaults() *CreateLinkPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateLinkPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create link policy params
+func (o *CreateLinkPolicyParams) WithTimeout(timeout time.Duration) *CreateLinkPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create link policy params
+func (o *CreateLinkPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create link policy params
+func (o *CreateLinkPolicyParams) WithContext(ctx context.Context) *CreateLinkPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create link policy params
+func (o *CreateLinkPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create link policy params
+func (o *CreateLinkPolicyParams) WithHTTPClient(client *http.Client) *CreateLinkPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create link policy params
+func (o *CreateLinkPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLinkPolicy adds the linkPolicy to the create link policy params
+func (o *CreateLinkPolicyParams) WithLinkPolicy(linkPolicy *rest_model.LinkPolicyCreate) *CreateLinkPolicyParams {
+	o.SetLinkPolicy(linkPolicy)
+	return o
+}
+
+// SetLinkPolicy adds the linkPolicy to the create link policy params
+func (o *CreateLinkPolicyParams) SetLinkPolicy(linkPolicy *rest_model.LinkPolicyCreate) {
+	o.LinkPolicy = linkPolicy
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateLinkPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.LinkPolicy != nil {
+		if err := r.SetBodyParam(o.LinkPolicy); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
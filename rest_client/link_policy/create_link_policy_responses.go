@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// CreateLinkPolicyReader is a Reader for the CreateLinkPolicy structure.
+type CreateLinkPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateLinkPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateLinkPolicyCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateLinkPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewCreateLinkPolicyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCreateLinkPolicyCreated creates a CreateLinkPolicyCreated with default headers values
+func NewCreateLinkPolicyCreated() *CreateLinkPolicyCreated {
+	return &CreateLinkPolicyCreated{}
+}
+
+/*
+CreateLinkPolicyCreated describes a response with status code 201, with default header values.
+
+The create request was successful and the resource has been added at the following location
+*/
+type CreateLinkPolicyCreated struct {
+	Payload *rest_model.CreateEnvelope
+}
+
+// IsSuccess returns true when this create link policy created response has a 2xx status code
+func (o *CreateLinkPolicyCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create link policy created response has a 3xx status code
+func (o *CreateLinkPolicyCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link policy created response has a 4xx status code
+func (o *CreateLinkPolicyCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create link policy created response has a 5xx status code
+func (o *CreateLinkPolicyCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link policy created response a status code equal to that given
+func (o *CreateLinkPolicyCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *CreateLinkPolicyCreated) Error() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateLinkPolicyCreated) String() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateLinkPolicyCreated) GetPayload() *rest_model.CreateEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkPolicyCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.CreateEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateLinkPolicyBadRequest creates a CreateLinkPolicyBadRequest with default headers values
+func NewCreateLinkPolicyBadRequest() *CreateLinkPolicyBadRequest {
+	return &CreateLinkPolicyBadRequest{}
+}
+
+/*
+CreateLinkPolicyBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type CreateLinkPolicyBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this create link policy bad request response has a 2xx status code
+func (o *CreateLinkPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create link policy bad request response has a 3xx status code
+func (o *CreateLinkPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link policy bad request response has a 4xx status code
+func (o *CreateLinkPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create link policy bad request response has a 5xx status code
+func (o *CreateLinkPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link policy bad request response a status code equal to that given
+func (o *CreateLinkPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *CreateLinkPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateLinkPolicyBadRequest) String() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateLinkPolicyBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateLinkPolicyUnauthorized creates a CreateLinkPolicyUnauthorized with default headers values
+func NewCreateLinkPolicyUnauthorized() *CreateLinkPolicyUnauthorized {
+	return &CreateLinkPolicyUnauthorized{}
+}
+
+/*
+CreateLinkPolicyUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type CreateLinkPolicyUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this create link policy unauthorized response has a 2xx status code
+func (o *CreateLinkPolicyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create link policy unauthorized response has a 3xx status code
+func (o *CreateLinkPolicyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link policy unauthorized response has a 4xx status code
+func (o *CreateLinkPolicyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create link policy unauthorized response has a 5xx status code
+func (o *CreateLinkPolicyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link policy unauthorized response a status code equal to that given
+func (o *CreateLinkPolicyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *CreateLinkPolicyUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CreateLinkPolicyUnauthorized) String() string {
+	return fmt.Sprintf("[POST /link-policies][%d] createLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CreateLinkPolicyUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkPolicyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
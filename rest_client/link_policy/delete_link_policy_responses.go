@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// DeleteLinkPolicyReader is a Reader for the DeleteLinkPolicy structure.
+type DeleteLinkPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteLinkPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteLinkPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDeleteLinkPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewDeleteLinkPolicyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteLinkPolicyOK creates a DeleteLinkPolicyOK with default headers values
+func NewDeleteLinkPolicyOK() *DeleteLinkPolicyOK {
+	return &DeleteLinkPolicyOK{}
+}
+
+/*
+DeleteLinkPolicyOK describes a response with status code 200, with default header values.
+
+The delete request was successful and the resource has been removed
+*/
+type DeleteLinkPolicyOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this delete link policy o k response has a 2xx status code
+func (o *DeleteLinkPolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete link policy o k response has a 3xx status code
+func (o *DeleteLinkPolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link policy o k response has a 4xx status code
+func (o *DeleteLinkPolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete link policy o k response has a 5xx status code
+func (o *DeleteLinkPolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link policy o k response a status code equal to that given
+func (o *DeleteLinkPolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *DeleteLinkPolicyOK) Error() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteLinkPolicyOK) String() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteLinkPolicyOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *DeleteLinkPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteLinkPolicyBadRequest creates a DeleteLinkPolicyBadRequest with default headers values
+func NewDeleteLinkPolicyBadRequest() *DeleteLinkPolicyBadRequest {
+	return &DeleteLinkPolicyBadRequest{}
+}
+
+/*
+DeleteLinkPolicyBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type DeleteLinkPolicyBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this delete link policy bad request response has a 2xx status code
+func (o *DeleteLinkPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete link policy bad request response has a 3xx status code
+func (o *DeleteLinkPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link policy bad request response has a 4xx status code
+func (o *DeleteLinkPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete link policy bad request response has a 5xx status code
+func (o *DeleteLinkPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link policy bad request response a status code equal to that given
+func (o *DeleteLinkPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *DeleteLinkPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeleteLinkPolicyBadRequest) String() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeleteLinkPolicyBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteLinkPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteLinkPolicyUnauthorized creates a DeleteLinkPolicyUnauthorized with default headers values
+func NewDeleteLinkPolicyUnauthorized() *DeleteLinkPolicyUnauthorized {
+	return &DeleteLinkPolicyUnauthorized{}
+}
+
+/*
+DeleteLinkPolicyUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DeleteLinkPolicyUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this delete link policy unauthorized response has a 2xx status code
+func (o *DeleteLinkPolicyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete link policy unauthorized response has a 3xx status code
+func (o *DeleteLinkPolicyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link policy unauthorized response has a 4xx status code
+func (o *DeleteLinkPolicyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete link policy unauthorized response has a 5xx status code
+func (o *DeleteLinkPolicyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link policy unauthorized response a status code equal to that given
+func (o *DeleteLinkPolicyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *DeleteLinkPolicyUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeleteLinkPolicyUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /link-policies/{id}][%d] deleteLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeleteLinkPolicyUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteLinkPolicyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDetailLinkPolicyParams creates a new DetailLinkPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDetailLinkPolicyParams() *DetailLinkPolicyParams {
+	return &DetailLinkPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDetailLinkPolicyParamsWithTimeout creates a new DetailLinkPolicyParams object
+// with the ability to set a timeout on a request.
+func NewDetailLinkPolicyParamsWithTimeout(timeout time.Duration) *DetailLinkPolicyParams {
+	return &DetailLinkPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewDetailLinkPolicyParamsWithContext creates a new DetailLinkPolicyParams object
+// with the ability to set a context for a request.
+func NewDetailLinkPolicyParamsWithContext(ctx context.Context) *DetailLinkPolicyParams {
+	return &DetailLinkPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewDetailLinkPolicyParamsWithHTTPClient creates a new DetailLinkPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDetailLinkPolicyParamsWithHTTPClient(client *http.Client) *DetailLinkPolicyParams {
+	return &DetailLinkPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DetailLinkPolicyParams contains all the parameters to send to the API endpoint
+
+	for the detail link policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type DetailLinkPolicyParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the detail link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DetailLinkPolicyParams) WithDefaults() *DetailLinkPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the detail link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DetailLinkPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the detail link policy params
+func (o *DetailLinkPolicyParams) WithTimeout(timeout time.Duration) *DetailLinkPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the detail link policy params
+func (o *DetailLinkPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the detail link policy params
+func (o *DetailLinkPolicyParams) WithContext(ctx context.Context) *DetailLinkPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the detail link policy params
+func (o *DetailLinkPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the detail link policy params
+func (o *DetailLinkPolicyParams) WithHTTPClient(client *http.Client) *DetailLinkPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the detail link policy params
+func (o *DetailLinkPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the detail link policy params
+func (o *DetailLinkPolicyParams) WithID(id string) *DetailLinkPolicyParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the detail link policy params
+func (o *DetailLinkPolicyParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DetailLinkPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
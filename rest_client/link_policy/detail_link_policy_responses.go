@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// DetailLinkPolicyReader is a Reader for the DetailLinkPolicy structure.
+type DetailLinkPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DetailLinkPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDetailLinkPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDetailLinkPolicyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDetailLinkPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDetailLinkPolicyOK creates a DetailLinkPolicyOK with default headers values
+func NewDetailLinkPolicyOK() *DetailLinkPolicyOK {
+	return &DetailLinkPolicyOK{}
+}
+
+/*
+DetailLinkPolicyOK describes a response with status code 200, with default header values.
+
+A single link policy
+*/
+type DetailLinkPolicyOK struct {
+	Payload *rest_model.DetailLinkPolicyEnvelope
+}
+
+// IsSuccess returns true when this detail link policy o k response has a 2xx status code
+func (o *DetailLinkPolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this detail link policy o k response has a 3xx status code
+func (o *DetailLinkPolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link policy o k response has a 4xx status code
+func (o *DetailLinkPolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this detail link policy o k response has a 5xx status code
+func (o *DetailLinkPolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link policy o k response a status code equal to that given
+func (o *DetailLinkPolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *DetailLinkPolicyOK) Error() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *DetailLinkPolicyOK) String() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *DetailLinkPolicyOK) GetPayload() *rest_model.DetailLinkPolicyEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.DetailLinkPolicyEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDetailLinkPolicyUnauthorized creates a DetailLinkPolicyUnauthorized with default headers values
+func NewDetailLinkPolicyUnauthorized() *DetailLinkPolicyUnauthorized {
+	return &DetailLinkPolicyUnauthorized{}
+}
+
+/*
+DetailLinkPolicyUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DetailLinkPolicyUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this detail link policy unauthorized response has a 2xx status code
+func (o *DetailLinkPolicyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this detail link policy unauthorized response has a 3xx status code
+func (o *DetailLinkPolicyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link policy unauthorized response has a 4xx status code
+func (o *DetailLinkPolicyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this detail link policy unauthorized response has a 5xx status code
+func (o *DetailLinkPolicyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link policy unauthorized response a status code equal to that given
+func (o *DetailLinkPolicyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *DetailLinkPolicyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DetailLinkPolicyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DetailLinkPolicyUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkPolicyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDetailLinkPolicyNotFound creates a DetailLinkPolicyNotFound with default headers values
+func NewDetailLinkPolicyNotFound() *DetailLinkPolicyNotFound {
+	return &DetailLinkPolicyNotFound{}
+}
+
+/*
+DetailLinkPolicyNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type DetailLinkPolicyNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this detail link policy not found response has a 2xx status code
+func (o *DetailLinkPolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this detail link policy not found response has a 3xx status code
+func (o *DetailLinkPolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link policy not found response has a 4xx status code
+func (o *DetailLinkPolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this detail link policy not found response has a 5xx status code
+func (o *DetailLinkPolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link policy not found response a status code equal to that given
+func (o *DetailLinkPolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *DetailLinkPolicyNotFound) Error() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DetailLinkPolicyNotFound) String() string {
+	return fmt.Sprintf("[GET /link-policies/{id}][%d] detailLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DetailLinkPolicyNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
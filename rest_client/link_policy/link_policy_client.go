@@ -0,0 +1,361 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new link policy API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for link policy API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	CreateLinkPolicy(params *CreateLinkPolicyParams, opts ...ClientOption) (*CreateLinkPolicyCreated, error)
+
+	DeleteLinkPolicy(params *DeleteLinkPolicyParams, opts ...ClientOption) (*DeleteLinkPolicyOK, error)
+
+	DetailLinkPolicy(params *DetailLinkPolicyParams, opts ...ClientOption) (*DetailLinkPolicyOK, error)
+
+	ListLinkPolicies(params *ListLinkPoliciesParams, opts ...ClientOption) (*ListLinkPoliciesOK, error)
+
+	PatchLinkPolicy(params *PatchLinkPolicyParams, opts ...ClientOption) (*PatchLinkPolicyOK, error)
+
+	PreviewLinkPolicies(params *PreviewLinkPoliciesParams, opts ...ClientOption) (*PreviewLinkPoliciesOK, error)
+
+	UpdateLinkPolicy(params *UpdateLinkPolicyParams, opts ...ClientOption) (*UpdateLinkPolicyOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+CreateLinkPolicy creates a link policy resource
+
+Create a link policy resource. Requires admin access.
+*/
+func (a *Client) CreateLinkPolicy(params *CreateLinkPolicyParams, opts ...ClientOption) (*CreateLinkPolicyCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateLinkPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createLinkPolicy",
+		Method:             "POST",
+		PathPattern:        "/link-policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateLinkPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateLinkPolicyCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createLinkPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteLinkPolicy deletes a link policy
+
+Delete a link policy by id. Requires admin access.
+*/
+func (a *Client) DeleteLinkPolicy(params *DeleteLinkPolicyParams, opts ...ClientOption) (*DeleteLinkPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteLinkPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteLinkPolicy",
+		Method:             "DELETE",
+		PathPattern:        "/link-policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteLinkPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteLinkPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteLinkPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DetailLinkPolicy retrieves a single link policy
+
+Retrieves a single link policy by id. Requires admin access.
+*/
+func (a *Client) DetailLinkPolicy(params *DetailLinkPolicyParams, opts ...ClientOption) (*DetailLinkPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDetailLinkPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "detailLinkPolicy",
+		Method:             "GET",
+		PathPattern:        "/link-policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DetailLinkPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DetailLinkPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for detailLinkPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListLinkPolicies lists link policies
+
+Retrieves a list of link policy resources; supports filtering, sorting, and pagination. Requires admin access.
+*/
+func (a *Client) ListLinkPolicies(params *ListLinkPoliciesParams, opts ...ClientOption) (*ListLinkPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListLinkPoliciesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listLinkPolicies",
+		Method:             "GET",
+		PathPattern:        "/link-policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListLinkPoliciesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListLinkPoliciesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listLinkPolicies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PatchLinkPolicy updates the supplied fields on a link policy
+
+Update the supplied fields on a link policy. Requires admin access.
+*/
+func (a *Client) PatchLinkPolicy(params *PatchLinkPolicyParams, opts ...ClientOption) (*PatchLinkPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchLinkPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "patchLinkPolicy",
+		Method:             "PATCH",
+		PathPattern:        "/link-policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PatchLinkPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchLinkPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for patchLinkPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	PreviewLinkPolicies previews the links produced by a set of link policies
+
+	Evaluates a set of link policies against all routers and returns the links the controller would dial. If no
+
+policies are given, the stored link policies are evaluated. An empty list of policies previews the default
+behavior, where all routers are linked. Router listeners are only known for connected routers, so they aren't
+taken into account. Requires admin access.
+*/
+func (a *Client) PreviewLinkPolicies(params *PreviewLinkPoliciesParams, opts ...ClientOption) (*PreviewLinkPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewLinkPoliciesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "previewLinkPolicies",
+		Method:             "POST",
+		PathPattern:        "/link-policies/preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PreviewLinkPoliciesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PreviewLinkPoliciesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for previewLinkPolicies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateLinkPolicy updates all fields on a link policy
+
+Update all fields on a link policy by id. Requires admin access.
+*/
+func (a *Client) UpdateLinkPolicy(params *UpdateLinkPolicyParams, opts ...ClientOption) (*UpdateLinkPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateLinkPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateLinkPolicy",
+		Method:             "PUT",
+		PathPattern:        "/link-policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UpdateLinkPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateLinkPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for updateLinkPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
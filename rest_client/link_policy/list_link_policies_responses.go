@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// ListLinkPoliciesReader is a Reader for the ListLinkPolicies structure.
+type ListLinkPoliciesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListLinkPoliciesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListLinkPoliciesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListLinkPoliciesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListLinkPoliciesOK creates a ListLinkPoliciesOK with default headers values
+func NewListLinkPoliciesOK() *ListLinkPoliciesOK {
+	return &ListLinkPoliciesOK{}
+}
+
+/*
+ListLinkPoliciesOK describes a response with status code 200, with default header values.
+
+A list of link policies
+*/
+type ListLinkPoliciesOK struct {
+	Payload *rest_model.ListLinkPoliciesEnvelope
+}
+
+// IsSuccess returns true when this list link policies o k response has a 2xx status code
+func (o *ListLinkPoliciesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list link policies o k response has a 3xx status code
+func (o *ListLinkPoliciesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list link policies o k response has a 4xx status code
+func (o *ListLinkPoliciesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list link policies o k response has a 5xx status code
+func (o *ListLinkPoliciesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list link policies o k response a status code equal to that given
+func (o *ListLinkPoliciesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *ListLinkPoliciesOK) Error() string {
+	return fmt.Sprintf("[GET /link-policies][%d] listLinkPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListLinkPoliciesOK) String() string {
+	return fmt.Sprintf("[GET /link-policies][%d] listLinkPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListLinkPoliciesOK) GetPayload() *rest_model.ListLinkPoliciesEnvelope {
+	return o.Payload
+}
+
+func (o *ListLinkPoliciesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.ListLinkPoliciesEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListLinkPoliciesUnauthorized creates a ListLinkPoliciesUnauthorized with default headers values
+func NewListLinkPoliciesUnauthorized() *ListLinkPoliciesUnauthorized {
+	return &ListLinkPoliciesUnauthorized{}
+}
+
+/*
+ListLinkPoliciesUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type ListLinkPoliciesUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this list link policies unauthorized response has a 2xx status code
+func (o *ListLinkPoliciesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list link policies unauthorized response has a 3xx status code
+func (o *ListLinkPoliciesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list link policies unauthorized response has a 4xx status code
+func (o *ListLinkPoliciesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list link policies unauthorized response has a 5xx status code
+func (o *ListLinkPoliciesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list link policies unauthorized response a status code equal to that given
+func (o *ListLinkPoliciesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *ListLinkPoliciesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /link-policies][%d] listLinkPoliciesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListLinkPoliciesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /link-policies][%d] listLinkPoliciesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListLinkPoliciesUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListLinkPoliciesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PatchLinkPolicyReader is a Reader for the PatchLinkPolicy structure.
+type PatchLinkPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PatchLinkPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPatchLinkPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPatchLinkPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewPatchLinkPolicyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPatchLinkPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewPatchLinkPolicyOK creates a PatchLinkPolicyOK with default headers values
+func NewPatchLinkPolicyOK() *PatchLinkPolicyOK {
+	return &PatchLinkPolicyOK{}
+}
+
+/*
+PatchLinkPolicyOK describes a response with status code 200, with default header values.
+
+The patch request was successful and the resource has been altered
+*/
+type PatchLinkPolicyOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this patch link policy o k response has a 2xx status code
+func (o *PatchLinkPolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this patch link policy o k response has a 3xx status code
+func (o *PatchLinkPolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link policy o k response has a 4xx status code
+func (o *PatchLinkPolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this patch link policy o k response has a 5xx status code
+func (o *PatchLinkPolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link policy o k response a status code equal to that given
+func (o *PatchLinkPolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *PatchLinkPolicyOK) Error() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *PatchLinkPolicyOK) String() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *PatchLinkPolicyOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *PatchLinkPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkPolicyBadRequest creates a PatchLinkPolicyBadRequest with default headers values
+func NewPatchLinkPolicyBadRequest() *PatchLinkPolicyBadRequest {
+	return &PatchLinkPolicyBadRequest{}
+}
+
+/*
+PatchLinkPolicyBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type PatchLinkPolicyBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link policy bad request response has a 2xx status code
+func (o *PatchLinkPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link policy bad request response has a 3xx status code
+func (o *PatchLinkPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link policy bad request response has a 4xx status code
+func (o *PatchLinkPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link policy bad request response has a 5xx status code
+func (o *PatchLinkPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link policy bad request response a status code equal to that given
+func (o *PatchLinkPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *PatchLinkPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PatchLinkPolicyBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PatchLinkPolicyBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkPolicyUnauthorized creates a PatchLinkPolicyUnauthorized with default headers values
+func NewPatchLinkPolicyUnauthorized() *PatchLinkPolicyUnauthorized {
+	return &PatchLinkPolicyUnauthorized{}
+}
+
+/*
+PatchLinkPolicyUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type PatchLinkPolicyUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link policy unauthorized response has a 2xx status code
+func (o *PatchLinkPolicyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link policy unauthorized response has a 3xx status code
+func (o *PatchLinkPolicyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link policy unauthorized response has a 4xx status code
+func (o *PatchLinkPolicyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link policy unauthorized response has a 5xx status code
+func (o *PatchLinkPolicyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link policy unauthorized response a status code equal to that given
+func (o *PatchLinkPolicyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *PatchLinkPolicyUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PatchLinkPolicyUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PatchLinkPolicyUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkPolicyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkPolicyNotFound creates a PatchLinkPolicyNotFound with default headers values
+func NewPatchLinkPolicyNotFound() *PatchLinkPolicyNotFound {
+	return &PatchLinkPolicyNotFound{}
+}
+
+/*
+PatchLinkPolicyNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type PatchLinkPolicyNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link policy not found response has a 2xx status code
+func (o *PatchLinkPolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link policy not found response has a 3xx status code
+func (o *PatchLinkPolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link policy not found response has a 4xx status code
+func (o *PatchLinkPolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link policy not found response has a 5xx status code
+func (o *PatchLinkPolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link policy not found response a status code equal to that given
+func (o *PatchLinkPolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *PatchLinkPolicyNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PatchLinkPolicyNotFound) String() string {
+	return fmt.Sprintf("[PATCH /link-policies/{id}][%d] patchLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PatchLinkPolicyNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// NewPreviewLinkPoliciesParams creates a new PreviewLinkPoliciesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPreviewLinkPoliciesParams() *PreviewLinkPoliciesParams {
+	return &PreviewLinkPoliciesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPreviewLinkPoliciesParamsWithTimeout creates a new PreviewLinkPoliciesParams object
+// with the ability to set a timeout on a request.
+func NewPreviewLinkPoliciesParamsWithTimeout(timeout time.Duration) *PreviewLinkPoliciesParams {
+	return &PreviewLinkPoliciesParams{
+		timeout: timeout,
+	}
+}
+
+// NewPreviewLinkPoliciesParamsWithContext creates a new PreviewLinkPoliciesParams object
+// with the ability to set a context for a request.
+func NewPreviewLinkPoliciesParamsWithContext(ctx context.Context) *PreviewLinkPoliciesParams {
+	return &PreviewLinkPoliciesParams{
+		Context: ctx,
+	}
+}
+
+// NewPreviewLinkPoliciesParamsWithHTTPClient creates a new PreviewLinkPoliciesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPreviewLinkPoliciesParamsWithHTTPClient(client *http.Client) *PreviewLinkPoliciesParams {
+	return &PreviewLinkPoliciesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PreviewLinkPoliciesParams contains all the parameters to send to the API endpoint
+
+	for the preview link policies operation.
+
+	Typically these are written to a http.Request.
+*/
+type PreviewLinkPoliciesParams struct {
+
+	/* Preview.
+
+	   The link policies to evaluate
+	*/
+	Preview *rest_model.LinkPolicyPreviewRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the preview link policies params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewLinkPoliciesParams) WithDefaults() *PreviewLinkPoliciesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the preview link policies params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewLinkPoliciesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the preview link policies params
+func (o *PreviewLinkPoliciesParams) WithTimeout(timeout time.Duration) *PreviewLinkPoliciesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the preview link policies params
+func (o *PreviewLinkPoliciesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the preview link policies params
+func (o *PreviewLinkPoliciesParams) WithContext(ctx context.Context) *PreviewLinkPoliciesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the preview link policies params
+func (o *PreviewLinkPoliciesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the preview link policies params
+func (o *PreviewLinkPoliciesParams) WithHTTPClient(client *http.Client) *PreviewLinkPoliciesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the preview link policies params
+func (o *PreviewLinkPoliciesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithPreview adds the preview to the preview link policies params
+func (o *PreviewLinkPoliciesParams) WithPreview(preview *rest_model.LinkPolicyPreviewRequest) *PreviewLinkPoliciesParams {
+	o.SetPreview(preview)
+	return o
+}
+
+// SetPreview adds the preview to the preview link policies params
+func (o *PreviewLinkPoliciesParams) SetPreview(preview *rest_model.LinkPolicyPreviewRequest) {
+	o.Preview = preview
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PreviewLinkPoliciesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Preview != nil {
+		if err := r.SetBodyParam(o.Preview); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PreviewLinkPoliciesReader is a Reader for the PreviewLinkPolicies structure.
+type PreviewLinkPoliciesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PreviewLinkPoliciesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPreviewLinkPoliciesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPreviewLinkPoliciesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewPreviewLinkPoliciesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewPreviewLinkPoliciesOK creates a PreviewLinkPoliciesOK with default headers values
+func NewPreviewLinkPoliciesOK() *PreviewLinkPoliciesOK {
+	return &PreviewLinkPoliciesOK{}
+}
+
+/*
+PreviewLinkPoliciesOK describes a response with status code 200, with default header values.
+
+The links produced by a set of link policies
+*/
+type PreviewLinkPoliciesOK struct {
+	Payload *rest_model.LinkPolicyPreviewEnvelope
+}
+
+// IsSuccess returns true when this preview link policies o k response has a 2xx status code
+func (o *PreviewLinkPoliciesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this preview link policies o k response has a 3xx status code
+func (o *PreviewLinkPoliciesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview link policies o k response has a 4xx status code
+func (o *PreviewLinkPoliciesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview link policies o k response has a 5xx status code
+func (o *PreviewLinkPoliciesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview link policies o k response a status code equal to that given
+func (o *PreviewLinkPoliciesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *PreviewLinkPoliciesOK) Error() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesOK) String() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesOK) GetPayload() *rest_model.LinkPolicyPreviewEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewLinkPoliciesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.LinkPolicyPreviewEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewLinkPoliciesBadRequest creates a PreviewLinkPoliciesBadRequest with default headers values
+func NewPreviewLinkPoliciesBadRequest() *PreviewLinkPoliciesBadRequest {
+	return &PreviewLinkPoliciesBadRequest{}
+}
+
+/*
+PreviewLinkPoliciesBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type PreviewLinkPoliciesBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this preview link policies bad request response has a 2xx status code
+func (o *PreviewLinkPoliciesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview link policies bad request response has a 3xx status code
+func (o *PreviewLinkPoliciesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview link policies bad request response has a 4xx status code
+func (o *PreviewLinkPoliciesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview link policies bad request response has a 5xx status code
+func (o *PreviewLinkPoliciesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview link policies bad request response a status code equal to that given
+func (o *PreviewLinkPoliciesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *PreviewLinkPoliciesBadRequest) Error() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesBadRequest) String() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewLinkPoliciesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewLinkPoliciesUnauthorized creates a PreviewLinkPoliciesUnauthorized with default headers values
+func NewPreviewLinkPoliciesUnauthorized() *PreviewLinkPoliciesUnauthorized {
+	return &PreviewLinkPoliciesUnauthorized{}
+}
+
+/*
+PreviewLinkPoliciesUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type PreviewLinkPoliciesUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this preview link policies unauthorized response has a 2xx status code
+func (o *PreviewLinkPoliciesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview link policies unauthorized response has a 3xx status code
+func (o *PreviewLinkPoliciesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview link policies unauthorized response has a 4xx status code
+func (o *PreviewLinkPoliciesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview link policies unauthorized response has a 5xx status code
+func (o *PreviewLinkPoliciesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview link policies unauthorized response a status code equal to that given
+func (o *PreviewLinkPoliciesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *PreviewLinkPoliciesUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesUnauthorized) String() string {
+	return fmt.Sprintf("[POST /link-policies/preview][%d] previewLinkPoliciesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PreviewLinkPoliciesUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewLinkPoliciesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
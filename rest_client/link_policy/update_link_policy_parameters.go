@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// NewUpdateLinkPolicyParams creates a new UpdateLinkPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateLinkPolicyParams() *UpdateLinkPolicyParams {
+	return &UpdateLinkPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateLinkPolicyParamsWithTimeout creates a new UpdateLinkPolicyParams object
+// with the ability to set a timeout on a request.
+func NewUpdateLinkPolicyParamsWithTimeout(timeout time.Duration) *UpdateLinkPolicyParams {
+	return &UpdateLinkPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateLinkPolicyParamsWithContext creates a new UpdateLinkPolicyParams object
+// with the ability to set a context for a request.
+func NewUpdateLinkPolicyParamsWithContext(ctx context.Context) *UpdateLinkPolicyParams {
+	return &UpdateLinkPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateLinkPolicyParamsWithHTTPClient creates a new UpdateLinkPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateLinkPolicyParamsWithHTTPClient(client *http.Client) *UpdateLinkPolicyParams {
+	return &UpdateLinkPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateLinkPolicyParams contains all the parameters to send to the API endpoint
+
+	for the update link policy operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateLinkPolicyParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	/* LinkPolicy.
+
+	   A link policy update object
+	*/
+	LinkPolicy *rest_model.LinkPolicyUpdate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateLinkPolicyParams) WithDefaults() *UpdateLinkPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update link policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateLinkPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update link policy params
+func (o *UpdateLinkPolicyParams) WithTimeout(timeout time.Duration) *UpdateLinkPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update link policy params
+func (o *UpdateLinkPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update link policy params
+func (o *UpdateLinkPolicyParams) WithContext(ctx context.Context) *UpdateLinkPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update link policy params
+func (o *UpdateLinkPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update link policy params
+func (o *UpdateLinkPolicyParams) WithHTTPClient(client *http.Client) *UpdateLinkPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update link policy params
+func (o *UpdateLinkPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the update link policy params
+func (o *UpdateLinkPolicyParams) WithID(id string) *UpdateLinkPolicyParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update link policy params
+func (o *UpdateLinkPolicyParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLinkPolicy adds the linkPolicy to the update link policy params
+func (o *UpdateLinkPolicyParams) WithLinkPolicy(linkPolicy *rest_model.LinkPolicyUpdate) *UpdateLinkPolicyParams {
+	o.SetLinkPolicy(linkPolicy)
+	return o
+}
+
+// SetLinkPolicy adds the linkPolicy to the update link policy params
+func (o *UpdateLinkPolicyParams) SetLinkPolicy(linkPolicy *rest_model.LinkPolicyUpdate) {
+	o.LinkPolicy = linkPolicy
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateLinkPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+	if o.LinkPolicy != nil {
+		if err := r.SetBodyParam(o.LinkPolicy); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
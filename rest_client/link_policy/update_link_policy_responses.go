@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// UpdateLinkPolicyReader is a Reader for the UpdateLinkPolicy structure.
+type UpdateLinkPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateLinkPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateLinkPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateLinkPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewUpdateLinkPolicyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateLinkPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewUpdateLinkPolicyOK creates a UpdateLinkPolicyOK with default headers values
+func NewUpdateLinkPolicyOK() *UpdateLinkPolicyOK {
+	return &UpdateLinkPolicyOK{}
+}
+
+/*
+UpdateLinkPolicyOK describes a response with status code 200, with default header values.
+
+The update request was successful and the resource has been altered
+*/
+type UpdateLinkPolicyOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this update link policy o k response has a 2xx status code
+func (o *UpdateLinkPolicyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update link policy o k response has a 3xx status code
+func (o *UpdateLinkPolicyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link policy o k response has a 4xx status code
+func (o *UpdateLinkPolicyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update link policy o k response has a 5xx status code
+func (o *UpdateLinkPolicyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link policy o k response a status code equal to that given
+func (o *UpdateLinkPolicyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *UpdateLinkPolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateLinkPolicyOK) String() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateLinkPolicyOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *UpdateLinkPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkPolicyBadRequest creates a UpdateLinkPolicyBadRequest with default headers values
+func NewUpdateLinkPolicyBadRequest() *UpdateLinkPolicyBadRequest {
+	return &UpdateLinkPolicyBadRequest{}
+}
+
+/*
+UpdateLinkPolicyBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type UpdateLinkPolicyBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link policy bad request response has a 2xx status code
+func (o *UpdateLinkPolicyBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link policy bad request response has a 3xx status code
+func (o *UpdateLinkPolicyBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link policy bad request response has a 4xx status code
+func (o *UpdateLinkPolicyBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link policy bad request response has a 5xx status code
+func (o *UpdateLinkPolicyBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link policy bad request response a status code equal to that given
+func (o *UpdateLinkPolicyBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *UpdateLinkPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateLinkPolicyBadRequest) String() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateLinkPolicyBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkPolicyUnauthorized creates a UpdateLinkPolicyUnauthorized with default headers values
+func NewUpdateLinkPolicyUnauthorized() *UpdateLinkPolicyUnauthorized {
+	return &UpdateLinkPolicyUnauthorized{}
+}
+
+/*
+UpdateLinkPolicyUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type UpdateLinkPolicyUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link policy unauthorized response has a 2xx status code
+func (o *UpdateLinkPolicyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link policy unauthorized response has a 3xx status code
+func (o *UpdateLinkPolicyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link policy unauthorized response has a 4xx status code
+func (o *UpdateLinkPolicyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link policy unauthorized response has a 5xx status code
+func (o *UpdateLinkPolicyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link policy unauthorized response a status code equal to that given
+func (o *UpdateLinkPolicyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *UpdateLinkPolicyUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateLinkPolicyUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateLinkPolicyUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkPolicyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkPolicyNotFound creates a UpdateLinkPolicyNotFound with default headers values
+func NewUpdateLinkPolicyNotFound() *UpdateLinkPolicyNotFound {
+	return &UpdateLinkPolicyNotFound{}
+}
+
+/*
+UpdateLinkPolicyNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type UpdateLinkPolicyNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link policy not found response has a 2xx status code
+func (o *UpdateLinkPolicyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link policy not found response has a 3xx status code
+func (o *UpdateLinkPolicyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link policy not found response has a 4xx status code
+func (o *UpdateLinkPolicyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link policy not found response has a 5xx status code
+func (o *UpdateLinkPolicyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link policy not found response a status code equal to that given
+func (o *UpdateLinkPolicyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *UpdateLinkPolicyNotFound) Error() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateLinkPolicyNotFound) String() string {
+	return fmt.Sprintf("[PUT /link-policies/{id}][%d] updateLinkPolicyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateLinkPolicyNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
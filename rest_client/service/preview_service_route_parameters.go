@@ -0,0 +1,270 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPreviewServiceRouteParams creates a new PreviewServiceRouteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPreviewServiceRouteParams() *PreviewServiceRouteParams {
+	return &PreviewServiceRouteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPreviewServiceRouteParamsWithTimeout creates a new PreviewServiceRouteParams object
+// with the ability to set a timeout on a request.
+func NewPreviewServiceRouteParamsWithTimeout(timeout time.Duration) *PreviewServiceRouteParams {
+	return &PreviewServiceRouteParams{
+		timeout: timeout,
+	}
+}
+
+// NewPreviewServiceRouteParamsWithContext creates a new PreviewServiceRouteParams object
+// with the ability to set a context for a request.
+func NewPreviewServiceRouteParamsWithContext(ctx context.Context) *PreviewServiceRouteParams {
+	return &PreviewServiceRouteParams{
+		Context: ctx,
+	}
+}
+
+// NewPreviewServiceRouteParamsWithHTTPClient creates a new PreviewServiceRouteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPreviewServiceRouteParamsWithHTTPClient(client *http.Client) *PreviewServiceRouteParams {
+	return &PreviewServiceRouteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PreviewServiceRouteParams contains all the parameters to send to the API endpoint
+
+	for the preview service route operation.
+
+	Typically these are written to a http.Request.
+*/
+type PreviewServiceRouteParams struct {
+
+	/* ClientID.
+
+	   The client id to pass to the terminator strategy, if any
+	*/
+	ClientID *string
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	/* InstanceID.
+
+	   The instance id being dialed, if any
+	*/
+	InstanceID *string
+
+	/* SourceRouter.
+
+	   The id of the router the circuit would originate from
+	*/
+	SourceRouter string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the preview service route params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewServiceRouteParams) WithDefaults() *PreviewServiceRouteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the preview service route params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PreviewServiceRouteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the preview service route params
+func (o *PreviewServiceRouteParams) WithTimeout(timeout time.Duration) *PreviewServiceRouteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the preview service route params
+func (o *PreviewServiceRouteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the preview service route params
+func (o *PreviewServiceRouteParams) WithContext(ctx context.Context) *PreviewServiceRouteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the preview service route params
+func (o *PreviewServiceRouteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the preview service route params
+func (o *PreviewServiceRouteParams) WithHTTPClient(client *http.Client) *PreviewServiceRouteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the preview service route params
+func (o *PreviewServiceRouteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClientID adds the clientID to the preview service route params
+func (o *PreviewServiceRouteParams) WithClientID(clientID *string) *PreviewServiceRouteParams {
+	o.SetClientID(clientID)
+	return o
+}
+
+// SetClientID adds the clientId to the preview service route params
+func (o *PreviewServiceRouteParams) SetClientID(clientID *string) {
+	o.ClientID = clientID
+}
+
+// WithID adds the id to the preview service route params
+func (o *PreviewServiceRouteParams) WithID(id string) *PreviewServiceRouteParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the preview service route params
+func (o *PreviewServiceRouteParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithInstanceID adds the instanceID to the preview service route params
+func (o *PreviewServiceRouteParams) WithInstanceID(instanceID *string) *PreviewServiceRouteParams {
+	o.SetInstanceID(instanceID)
+	return o
+}
+
+// SetInstanceID adds the instanceId to the preview service route params
+func (o *PreviewServiceRouteParams) SetInstanceID(instanceID *string) {
+	o.InstanceID = instanceID
+}
+
+// WithSourceRouter adds the sourceRouter to the preview service route params
+func (o *PreviewServiceRouteParams) WithSourceRouter(sourceRouter string) *PreviewServiceRouteParams {
+	o.SetSourceRouter(sourceRouter)
+	return o
+}
+
+// SetSourceRouter adds the sourceRouter to the preview service route params
+func (o *PreviewServiceRouteParams) SetSourceRouter(sourceRouter string) {
+	o.SourceRouter = sourceRouter
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PreviewServiceRouteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ClientID != nil {
+
+		// query param clientId
+		var qrClientID string
+
+		if o.ClientID != nil {
+			qrClientID = *o.ClientID
+		}
+		qClientID := qrClientID
+		if qClientID != "" {
+
+			if err := r.SetQueryParam("clientId", qClientID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.InstanceID != nil {
+
+		// query param instanceId
+		var qrInstanceID string
+
+		if o.InstanceID != nil {
+			qrInstanceID = *o.InstanceID
+		}
+		qInstanceID := qrInstanceID
+		if qInstanceID != "" {
+
+			if err := r.SetQueryParam("instanceId", qInstanceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param sourceRouter
+	qrSourceRouter := o.SourceRouter
+	qSourceRouter := qrSourceRouter
+	if qSourceRouter != "" {
+
+		if err := r.SetQueryParam("sourceRouter", qSourceRouter); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
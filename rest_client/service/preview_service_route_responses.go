@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PreviewServiceRouteReader is a Reader for the PreviewServiceRoute structure.
+type PreviewServiceRouteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PreviewServiceRouteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPreviewServiceRouteOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPreviewServiceRouteBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewPreviewServiceRouteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPreviewServiceRouteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewPreviewServiceRouteOK creates a PreviewServiceRouteOK with default headers values
+func NewPreviewServiceRouteOK() *PreviewServiceRouteOK {
+	return &PreviewServiceRouteOK{}
+}
+
+/*
+PreviewServiceRouteOK describes a response with status code 200, with default header values.
+
+A preview of terminator and path selection for a service
+*/
+type PreviewServiceRouteOK struct {
+	Payload *rest_model.RoutePreviewEnvelope
+}
+
+// IsSuccess returns true when this preview service route o k response has a 2xx status code
+func (o *PreviewServiceRouteOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this preview service route o k response has a 3xx status code
+func (o *PreviewServiceRouteOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview service route o k response has a 4xx status code
+func (o *PreviewServiceRouteOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this preview service route o k response has a 5xx status code
+func (o *PreviewServiceRouteOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview service route o k response a status code equal to that given
+func (o *PreviewServiceRouteOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *PreviewServiceRouteOK) Error() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewServiceRouteOK) String() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewServiceRouteOK) GetPayload() *rest_model.RoutePreviewEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewServiceRouteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.RoutePreviewEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewServiceRouteBadRequest creates a PreviewServiceRouteBadRequest with default headers values
+func NewPreviewServiceRouteBadRequest() *PreviewServiceRouteBadRequest {
+	return &PreviewServiceRouteBadRequest{}
+}
+
+/*
+PreviewServiceRouteBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type PreviewServiceRouteBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this preview service route bad request response has a 2xx status code
+func (o *PreviewServiceRouteBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview service route bad request response has a 3xx status code
+func (o *PreviewServiceRouteBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview service route bad request response has a 4xx status code
+func (o *PreviewServiceRouteBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview service route bad request response has a 5xx status code
+func (o *PreviewServiceRouteBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview service route bad request response a status code equal to that given
+func (o *PreviewServiceRouteBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *PreviewServiceRouteBadRequest) Error() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewServiceRouteBadRequest) String() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PreviewServiceRouteBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewServiceRouteBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewServiceRouteUnauthorized creates a PreviewServiceRouteUnauthorized with default headers values
+func NewPreviewServiceRouteUnauthorized() *PreviewServiceRouteUnauthorized {
+	return &PreviewServiceRouteUnauthorized{}
+}
+
+/*
+PreviewServiceRouteUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type PreviewServiceRouteUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this preview service route unauthorized response has a 2xx status code
+func (o *PreviewServiceRouteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview service route unauthorized response has a 3xx status code
+func (o *PreviewServiceRouteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview service route unauthorized response has a 4xx status code
+func (o *PreviewServiceRouteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview service route unauthorized response has a 5xx status code
+func (o *PreviewServiceRouteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview service route unauthorized response a status code equal to that given
+func (o *PreviewServiceRouteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *PreviewServiceRouteUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PreviewServiceRouteUnauthorized) String() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PreviewServiceRouteUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewServiceRouteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPreviewServiceRouteNotFound creates a PreviewServiceRouteNotFound with default headers values
+func NewPreviewServiceRouteNotFound() *PreviewServiceRouteNotFound {
+	return &PreviewServiceRouteNotFound{}
+}
+
+/*
+PreviewServiceRouteNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type PreviewServiceRouteNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this preview service route not found response has a 2xx status code
+func (o *PreviewServiceRouteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this preview service route not found response has a 3xx status code
+func (o *PreviewServiceRouteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this preview service route not found response has a 4xx status code
+func (o *PreviewServiceRouteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this preview service route not found response has a 5xx status code
+func (o *PreviewServiceRouteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this preview service route not found response a status code equal to that given
+func (o *PreviewServiceRouteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *PreviewServiceRouteNotFound) Error() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewServiceRouteNotFound) String() string {
+	return fmt.Sprintf("[GET /services/{id}/route-preview][%d] previewServiceRouteNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PreviewServiceRouteNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PreviewServiceRouteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
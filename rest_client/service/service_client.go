@@ -66,6 +66,8 @@ type ClientService interface {
 
 	PatchService(params *PatchServiceParams, opts ...ClientOption) (*PatchServiceOK, error)
 
+	PreviewServiceRoute(params *PreviewServiceRouteParams, opts ...ClientOption) (*PreviewServiceRouteOK, error)
+
 	UpdateService(params *UpdateServiceParams, opts ...ClientOption) (*UpdateServiceOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -313,6 +315,49 @@ func (a *Client) PatchService(params *PatchServiceParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+	PreviewServiceRoute previews terminator and path selection for a service
+
+	Runs terminator and path selection for the service, as would be done when creating a circuit, without
+
+creating a circuit. Returns each terminator with its cost breakdown, the reason any terminators were skipped,
+the terminator the service's strategy would pick and the path to it. Requires admin access.
+*/
+func (a *Client) PreviewServiceRoute(params *PreviewServiceRouteParams, opts ...ClientOption) (*PreviewServiceRouteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPreviewServiceRouteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "previewServiceRoute",
+		Method:             "GET",
+		PathPattern:        "/services/{id}/route-preview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PreviewServiceRouteReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PreviewServiceRouteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for previewServiceRoute: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   UpdateService updates all fields on a service
 
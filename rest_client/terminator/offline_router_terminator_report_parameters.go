@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewOfflineRouterTerminatorReportParams creates a new OfflineRouterTerminatorReportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewOfflineRouterTerminatorReportParams() *OfflineRouterTerminatorReportParams {
+	return &OfflineRouterTerminatorReportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewOfflineRouterTerminatorReportParamsWithTimeout creates a new OfflineRouterTerminatorReportParams object
+// with the ability to set a timeout on a request.
+func NewOfflineRouterTerminatorReportParamsWithTimeout(timeout time.Duration) *OfflineRouterTerminatorReportParams {
+	return &OfflineRouterTerminatorReportParams{
+		timeout: timeout,
+	}
+}
+
+// NewOfflineRouterTerminatorReportParamsWithContext creates a new OfflineRouterTerminatorReportParams object
+// with the ability to set a context for a request.
+func NewOfflineRouterTerminatorReportParamsWithContext(ctx context.Context) *OfflineRouterTerminatorReportParams {
+	return &OfflineRouterTerminatorReportParams{
+		Context: ctx,
+	}
+}
+
+// NewOfflineRouterTerminatorReportParamsWithHTTPClient creates a new OfflineRouterTerminatorReportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewOfflineRouterTerminatorReportParamsWithHTTPClient(client *http.Client) *OfflineRouterTerminatorReportParams {
+	return &OfflineRouterTerminatorReportParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+OfflineRouterTerminatorReportParams contains all the parameters to send to the API endpoint
+
+	for the offline router terminator report operation.
+
+	Typically these are written to a http.Request.
+*/
+type OfflineRouterTerminatorReportParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the offline router terminator report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *OfflineRouterTerminatorReportParams) WithDefaults() *OfflineRouterTerminatorReportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the offline router terminator report params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *OfflineRouterTerminatorReportParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) WithTimeout(timeout time.Duration) *OfflineRouterTerminatorReportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) WithContext(ctx context.Context) *OfflineRouterTerminatorReportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) WithHTTPClient(client *http.Client) *OfflineRouterTerminatorReportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the offline router terminator report params
+func (o *OfflineRouterTerminatorReportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *OfflineRouterTerminatorReportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// OfflineRouterTerminatorReportReader is a Reader for the OfflineRouterTerminatorReport structure.
+type OfflineRouterTerminatorReportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *OfflineRouterTerminatorReportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewOfflineRouterTerminatorReportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewOfflineRouterTerminatorReportUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewOfflineRouterTerminatorReportOK creates a OfflineRouterTerminatorReportOK with default headers values
+func NewOfflineRouterTerminatorReportOK() *OfflineRouterTerminatorReportOK {
+	return &OfflineRouterTerminatorReportOK{}
+}
+
+/*
+OfflineRouterTerminatorReportOK describes a response with status code 200, with default header values.
+
+The terminators of routers which have been offline past the cleanup threshold
+*/
+type OfflineRouterTerminatorReportOK struct {
+	Payload *rest_model.OfflineRouterTerminatorReportEnvelope
+}
+
+// IsSuccess returns true when this offline router terminator report o k response has a 2xx status code
+func (o *OfflineRouterTerminatorReportOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this offline router terminator report o k response has a 3xx status code
+func (o *OfflineRouterTerminatorReportOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this offline router terminator report o k response has a 4xx status code
+func (o *OfflineRouterTerminatorReportOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this offline router terminator report o k response has a 5xx status code
+func (o *OfflineRouterTerminatorReportOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this offline router terminator report o k response a status code equal to that given
+func (o *OfflineRouterTerminatorReportOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *OfflineRouterTerminatorReportOK) Error() string {
+	return fmt.Sprintf("[GET /terminators/offline-router-report][%d] offlineRouterTerminatorReportOK  %+v", 200, o.Payload)
+}
+
+func (o *OfflineRouterTerminatorReportOK) String() string {
+	return fmt.Sprintf("[GET /terminators/offline-router-report][%d] offlineRouterTerminatorReportOK  %+v", 200, o.Payload)
+}
+
+func (o *OfflineRouterTerminatorReportOK) GetPayload() *rest_model.OfflineRouterTerminatorReportEnvelope {
+	return o.Payload
+}
+
+func (o *OfflineRouterTerminatorReportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.OfflineRouterTerminatorReportEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewOfflineRouterTerminatorReportUnauthorized creates a OfflineRouterTerminatorReportUnauthorized with default headers values
+func NewOfflineRouterTerminatorReportUnauthorized() *OfflineRouterTerminatorReportUnauthorized {
+	return &OfflineRouterTerminatorReportUnauthorized{}
+}
+
+/*
+OfflineRouterTerminatorReportUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type OfflineRouterTerminatorReportUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this offline router terminator report unauthorized response has a 2xx status code
+func (o *OfflineRouterTerminatorReportUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this offline router terminator report unauthorized response has a 3xx status code
+func (o *OfflineRouterTerminatorReportUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this offline router terminator report unauthorized response has a 4xx status code
+func (o *OfflineRouterTerminatorReportUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this offline router terminator report unauthorized response has a 5xx status code
+func (o *OfflineRouterTerminatorReportUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this offline router terminator report unauthorized response a status code equal to that given
+func (o *OfflineRouterTerminatorReportUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *OfflineRouterTerminatorReportUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /terminators/offline-router-report][%d] offlineRouterTerminatorReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *OfflineRouterTerminatorReportUnauthorized) String() string {
+	return fmt.Sprintf("[GET /terminators/offline-router-report][%d] offlineRouterTerminatorReportUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *OfflineRouterTerminatorReportUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *OfflineRouterTerminatorReportUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -62,6 +62,8 @@ type ClientService interface {
 
 	ListTerminators(params *ListTerminatorsParams, opts ...ClientOption) (*ListTerminatorsOK, error)
 
+	OfflineRouterTerminatorReport(params *OfflineRouterTerminatorReportParams, opts ...ClientOption) (*OfflineRouterTerminatorReportOK, error)
+
 	PatchTerminator(params *PatchTerminatorParams, opts ...ClientOption) (*PatchTerminatorOK, error)
 
 	UpdateTerminator(params *UpdateTerminatorParams, opts ...ClientOption) (*UpdateTerminatorOK, error)
@@ -230,6 +232,49 @@ func (a *Client) ListTerminators(params *ListTerminatorsParams, opts ...ClientOp
 	panic(msg)
 }
 
+/*
+	OfflineRouterTerminatorReport reports the terminators of routers which have been offline past the cleanup threshold
+
+	Lists the terminators which offline terminator cleanup acts on, without changing anything. Terminators are
+
+included if their router has been disconnected for longer than the configured threshold. The report is
+generated whether or not cleanup is enabled. Requires admin access.
+*/
+func (a *Client) OfflineRouterTerminatorReport(params *OfflineRouterTerminatorReportParams, opts ...ClientOption) (*OfflineRouterTerminatorReportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOfflineRouterTerminatorReportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "offlineRouterTerminatorReport",
+		Method:             "GET",
+		PathPattern:        "/terminators/offline-router-report",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &OfflineRouterTerminatorReportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OfflineRouterTerminatorReportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for offlineRouterTerminatorReport: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   PatchTerminator updates the supplied fields on a terminator
 
@@ -60,10 +60,16 @@ type CircuitDetail struct {
 	// Required: true
 	Path *CircuitDetailPath `json:"path"`
 
+	// pinned
+	Pinned bool `json:"pinned,omitempty"`
+
 	// service
 	// Required: true
 	Service *EntityRef `json:"service"`
 
+	// standby path
+	StandbyPath *CircuitDetailStandbyPath `json:"standbyPath,omitempty"`
+
 	// terminator
 	// Required: true
 	Terminator *EntityRef `json:"terminator"`
@@ -89,6 +95,10 @@ func (m *CircuitDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStandbyPath(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTerminator(formats); err != nil {
 		res = append(res, err)
 	}
@@ -161,6 +171,25 @@ func (m *CircuitDetail) validateService(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CircuitDetail) validateStandbyPath(formats strfmt.Registry) error {
+	if swag.IsZero(m.StandbyPath) { // not required
+		return nil
+	}
+
+	if m.StandbyPath != nil {
+		if err := m.StandbyPath.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("standbyPath")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("standbyPath")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CircuitDetail) validateTerminator(formats strfmt.Registry) error {
 
 	if err := validate.Required("terminator", "body", m.Terminator); err != nil {
@@ -193,6 +222,10 @@ func (m *CircuitDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStandbyPath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTerminator(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -235,6 +268,22 @@ func (m *CircuitDetail) contextValidateService(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *CircuitDetail) contextValidateStandbyPath(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StandbyPath != nil {
+		if err := m.StandbyPath.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("standbyPath")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("standbyPath")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CircuitDetail) contextValidateTerminator(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Terminator != nil {
@@ -426,3 +475,161 @@ func (m *CircuitDetailPath) UnmarshalBinary(b []byte) error {
 	*m = res
 	return nil
 }
+
+// CircuitDetailStandbyPath circuit detail standby path
+//
+// swagger:model CircuitDetailStandbyPath
+type CircuitDetailStandbyPath struct {
+
+	// links
+	Links []*EntityRef `json:"links"`
+
+	// nodes
+	Nodes []*EntityRef `json:"nodes"`
+}
+
+// Validate validates this circuit detail standby path
+func (m *CircuitDetailStandbyPath) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLinks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDetailStandbyPath) validateLinks(formats strfmt.Registry) error {
+	if swag.IsZero(m.Links) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Links); i++ {
+		if swag.IsZero(m.Links[i]) { // not required
+			continue
+		}
+
+		if m.Links[i] != nil {
+			if err := m.Links[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("standbyPath" + "." + "links" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("standbyPath" + "." + "links" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CircuitDetailStandbyPath) validateNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("standbyPath" + "." + "nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("standbyPath" + "." + "nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this circuit detail standby path based on the context it is used
+func (m *CircuitDetailStandbyPath) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLinks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNodes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDetailStandbyPath) contextValidateLinks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Links); i++ {
+
+		if m.Links[i] != nil {
+			if err := m.Links[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("standbyPath" + "." + "links" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("standbyPath" + "." + "links" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CircuitDetailStandbyPath) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Nodes); i++ {
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("standbyPath" + "." + "nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("standbyPath" + "." + "nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CircuitDetailStandbyPath) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CircuitDetailStandbyPath) UnmarshalBinary(b []byte) error {
+	var res CircuitDetailStandbyPath
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
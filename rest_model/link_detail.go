@@ -47,6 +47,9 @@ type LinkDetail struct {
 	// Required: true
 	Cost *int64 `json:"cost"`
 
+	// cost tags
+	CostTags []string `json:"costTags"`
+
 	// dest latency
 	// Required: true
 	DestLatency *int64 `json:"destLatency"`
@@ -59,6 +62,21 @@ type LinkDetail struct {
 	// Required: true
 	Down *bool `json:"down"`
 
+	// excluded by cost tags
+	ExcludedByCostTags bool `json:"excludedByCostTags,omitempty"`
+
+	// flap penalty
+	FlapPenalty int64 `json:"flapPenalty,omitempty"`
+
+	// flap reuse threshold
+	FlapReuseThreshold int64 `json:"flapReuseThreshold,omitempty"`
+
+	// flap suppress threshold
+	FlapSuppressThreshold int64 `json:"flapSuppressThreshold,omitempty"`
+
+	// flap suppressed
+	FlapSuppressed bool `json:"flapSuppressed,omitempty"`
+
 	// id
 	// Required: true
 	ID *string `json:"id"`
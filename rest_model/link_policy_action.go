@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// LinkPolicyAction link policy action
+//
+// swagger:model linkPolicyAction
+type LinkPolicyAction string
+
+func NewLinkPolicyAction(value LinkPolicyAction) *LinkPolicyAction {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated LinkPolicyAction.
+func (m LinkPolicyAction) Pointer() *LinkPolicyAction {
+	return &m
+}
+
+const (
+
+	// LinkPolicyActionAllow captures enum value "allow"
+	LinkPolicyActionAllow LinkPolicyAction = "allow"
+
+	// LinkPolicyActionDeny captures enum value "deny"
+	LinkPolicyActionDeny LinkPolicyAction = "deny"
+)
+
+// for schema
+var linkPolicyActionEnum []interface{}
+
+func init() {
+	var res []LinkPolicyAction
+	if err := json.Unmarshal([]byte(`["allow","deny"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		linkPolicyActionEnum = append(linkPolicyActionEnum, v)
+	}
+}
+
+func (m LinkPolicyAction) validateLinkPolicyActionEnum(path, location string, value LinkPolicyAction) error {
+	if err := validate.EnumCase(path, location, value, linkPolicyActionEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this link policy action
+func (m LinkPolicyAction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateLinkPolicyActionEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this link policy action based on context it is used
+func (m LinkPolicyAction) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LinkPolicyPreviewLink link policy preview link
+//
+// swagger:model linkPolicyPreviewLink
+type LinkPolicyPreviewLink struct {
+
+	// dest router
+	// Required: true
+	DestRouter *EntityRef `json:"destRouter"`
+
+	// Set if the routers are connected and already have a usable link between them
+	// Required: true
+	Linked *bool `json:"linked"`
+
+	// The allow policies which produce the link. Empty if no link policies were evaluated.
+	Policies []*EntityRef `json:"policies"`
+
+	// source router
+	// Required: true
+	SourceRouter *EntityRef `json:"sourceRouter"`
+}
+
+// Validate validates this link policy preview link
+func (m *LinkPolicyPreviewLink) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDestRouter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLinked(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceRouter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) validateDestRouter(formats strfmt.Registry) error {
+
+	if err := validate.Required("destRouter", "body", m.DestRouter); err != nil {
+		return err
+	}
+
+	if m.DestRouter != nil {
+		if err := m.DestRouter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("destRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("destRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) validateLinked(formats strfmt.Registry) error {
+
+	if err := validate.Required("linked", "body", m.Linked); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) validatePolicies(formats strfmt.Registry) error {
+	if swag.IsZero(m.Policies) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Policies); i++ {
+		if swag.IsZero(m.Policies[i]) { // not required
+			continue
+		}
+
+		if m.Policies[i] != nil {
+			if err := m.Policies[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) validateSourceRouter(formats strfmt.Registry) error {
+
+	if err := validate.Required("sourceRouter", "body", m.SourceRouter); err != nil {
+		return err
+	}
+
+	if m.SourceRouter != nil {
+		if err := m.SourceRouter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourceRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourceRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this link policy preview link based on the context it is used
+func (m *LinkPolicyPreviewLink) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDestRouter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePolicies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSourceRouter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) contextValidateDestRouter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DestRouter != nil {
+		if err := m.DestRouter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("destRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("destRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) contextValidatePolicies(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Policies); i++ {
+
+		if m.Policies[i] != nil {
+			if err := m.Policies[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("policies" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *LinkPolicyPreviewLink) contextValidateSourceRouter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SourceRouter != nil {
+		if err := m.SourceRouter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourceRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourceRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LinkPolicyPreviewLink) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LinkPolicyPreviewLink) UnmarshalBinary(b []byte) error {
+	var res LinkPolicyPreviewLink
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
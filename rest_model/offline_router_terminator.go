@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OfflineRouterTerminator offline router terminator
+//
+// swagger:model offlineRouterTerminator
+type OfflineRouterTerminator struct {
+
+	// offline since
+	// Required: true
+	// Format: date-time
+	OfflineSince *strfmt.DateTime `json:"offlineSince"`
+
+	// router
+	// Required: true
+	Router *EntityRef `json:"router"`
+
+	// service
+	// Required: true
+	Service *EntityRef `json:"service"`
+
+	// terminator
+	// Required: true
+	Terminator *EntityRef `json:"terminator"`
+}
+
+// Validate validates this offline router terminator
+func (m *OfflineRouterTerminator) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOfflineSince(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRouter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateService(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTerminator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OfflineRouterTerminator) validateOfflineSince(formats strfmt.Registry) error {
+
+	if err := validate.Required("offlineSince", "body", m.OfflineSince); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("offlineSince", "body", "date-time", m.OfflineSince.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminator) validateRouter(formats strfmt.Registry) error {
+
+	if err := validate.Required("router", "body", m.Router); err != nil {
+		return err
+	}
+
+	if m.Router != nil {
+		if err := m.Router.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("router")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("router")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminator) validateService(formats strfmt.Registry) error {
+
+	if err := validate.Required("service", "body", m.Service); err != nil {
+		return err
+	}
+
+	if m.Service != nil {
+		if err := m.Service.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("service")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("service")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminator) validateTerminator(formats strfmt.Registry) error {
+
+	if err := validate.Required("terminator", "body", m.Terminator); err != nil {
+		return err
+	}
+
+	if m.Terminator != nil {
+		if err := m.Terminator.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("terminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("terminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this offline router terminator based on the context it is used
+func (m *OfflineRouterTerminator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRouter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateService(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTerminator(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OfflineRouterTerminator) contextValidateRouter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Router != nil {
+		if err := m.Router.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("router")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("router")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminator) contextValidateService(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Service != nil {
+		if err := m.Service.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("service")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("service")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminator) contextValidateTerminator(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Terminator != nil {
+		if err := m.Terminator.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("terminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("terminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfflineRouterTerminator) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfflineRouterTerminator) UnmarshalBinary(b []byte) error {
+	var res OfflineRouterTerminator
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
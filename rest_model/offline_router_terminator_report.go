@@ -0,0 +1,230 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OfflineRouterTerminatorReport offline router terminator report
+//
+// swagger:model offlineRouterTerminatorReport
+type OfflineRouterTerminatorReport struct {
+
+	// action
+	// Required: true
+	// Enum: [delete fail]
+	Action *string `json:"action"`
+
+	// Set if offline terminator cleanup is enabled
+	// Required: true
+	Enabled *bool `json:"enabled"`
+
+	// terminators
+	// Required: true
+	Terminators []*OfflineRouterTerminator `json:"terminators"`
+
+	// How long a router must be offline before its terminators are cleaned up, in milliseconds
+	// Required: true
+	Threshold *int64 `json:"threshold"`
+}
+
+// Validate validates this offline router terminator report
+func (m *OfflineRouterTerminatorReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTerminators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var offlineRouterTerminatorReportTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["delete","fail"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		offlineRouterTerminatorReportTypeActionPropEnum = append(offlineRouterTerminatorReportTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// OfflineRouterTerminatorReportActionDelete captures enum value "delete"
+	OfflineRouterTerminatorReportActionDelete string = "delete"
+
+	// OfflineRouterTerminatorReportActionFail captures enum value "fail"
+	OfflineRouterTerminatorReportActionFail string = "fail"
+)
+
+// prop value enum
+func (m *OfflineRouterTerminatorReport) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, offlineRouterTerminatorReportTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OfflineRouterTerminatorReport) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminatorReport) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("enabled", "body", m.Enabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminatorReport) validateTerminators(formats strfmt.Registry) error {
+
+	if err := validate.Required("terminators", "body", m.Terminators); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Terminators); i++ {
+		if swag.IsZero(m.Terminators[i]) { // not required
+			continue
+		}
+
+		if m.Terminators[i] != nil {
+			if err := m.Terminators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("terminators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("terminators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *OfflineRouterTerminatorReport) validateThreshold(formats strfmt.Registry) error {
+
+	if err := validate.Required("threshold", "body", m.Threshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this offline router terminator report based on the context it is used
+func (m *OfflineRouterTerminatorReport) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTerminators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OfflineRouterTerminatorReport) contextValidateTerminators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Terminators); i++ {
+
+		if m.Terminators[i] != nil {
+			if err := m.Terminators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("terminators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("terminators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfflineRouterTerminatorReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfflineRouterTerminatorReport) UnmarshalBinary(b []byte) error {
+	var res OfflineRouterTerminatorReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,354 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RoutePreview route preview
+//
+// swagger:model routePreview
+type RoutePreview struct {
+
+	// candidates
+	// Required: true
+	Candidates []*RoutePreviewCandidate `json:"candidates"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// instance Id
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// path
+	Path *RoutePreviewPath `json:"path,omitempty"`
+
+	// selected terminator
+	SelectedTerminator *EntityRef `json:"selectedTerminator,omitempty"`
+
+	// service
+	// Required: true
+	Service *EntityRef `json:"service"`
+
+	// source router
+	// Required: true
+	SourceRouter *EntityRef `json:"sourceRouter"`
+
+	// strategy
+	// Required: true
+	Strategy *string `json:"strategy"`
+}
+
+// Validate validates this route preview
+func (m *RoutePreview) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCandidates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSelectedTerminator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateService(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceRouter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RoutePreview) validateCandidates(formats strfmt.Registry) error {
+
+	if err := validate.Required("candidates", "body", m.Candidates); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Candidates); i++ {
+		if swag.IsZero(m.Candidates[i]) { // not required
+			continue
+		}
+
+		if m.Candidates[i] != nil {
+			if err := m.Candidates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("candidates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("candidates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) validatePath(formats strfmt.Registry) error {
+	if swag.IsZero(m.Path) { // not required
+		return nil
+	}
+
+	if m.Path != nil {
+		if err := m.Path.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("path")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("path")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) validateSelectedTerminator(formats strfmt.Registry) error {
+	if swag.IsZero(m.SelectedTerminator) { // not required
+		return nil
+	}
+
+	if m.SelectedTerminator != nil {
+		if err := m.SelectedTerminator.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("selectedTerminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("selectedTerminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) validateService(formats strfmt.Registry) error {
+
+	if err := validate.Required("service", "body", m.Service); err != nil {
+		return err
+	}
+
+	if m.Service != nil {
+		if err := m.Service.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("service")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("service")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) validateSourceRouter(formats strfmt.Registry) error {
+
+	if err := validate.Required("sourceRouter", "body", m.SourceRouter); err != nil {
+		return err
+	}
+
+	if m.SourceRouter != nil {
+		if err := m.SourceRouter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourceRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourceRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) validateStrategy(formats strfmt.Registry) error {
+
+	if err := validate.Required("strategy", "body", m.Strategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this route preview based on the context it is used
+func (m *RoutePreview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCandidates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSelectedTerminator(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateService(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSourceRouter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RoutePreview) contextValidateCandidates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Candidates); i++ {
+
+		if m.Candidates[i] != nil {
+			if err := m.Candidates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("candidates" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("candidates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) contextValidatePath(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Path != nil {
+		if err := m.Path.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("path")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("path")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) contextValidateSelectedTerminator(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SelectedTerminator != nil {
+		if err := m.SelectedTerminator.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("selectedTerminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("selectedTerminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) contextValidateService(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Service != nil {
+		if err := m.Service.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("service")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("service")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreview) contextValidateSourceRouter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SourceRouter != nil {
+		if err := m.SourceRouter.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourceRouter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourceRouter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RoutePreview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RoutePreview) UnmarshalBinary(b []byte) error {
+	var res RoutePreview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,493 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RoutePreviewCandidate route preview candidate
+//
+// swagger:model routePreviewCandidate
+type RoutePreviewCandidate struct {
+
+	// dynamic cost
+	// Required: true
+	DynamicCost *TerminatorCost `json:"dynamicCost"`
+
+	// instance Id
+	// Required: true
+	InstanceID *string `json:"instanceId"`
+
+	// path
+	Path []*EntityRef `json:"path"`
+
+	// path cost
+	PathCost int64 `json:"pathCost,omitempty"`
+
+	// precedence
+	// Required: true
+	Precedence *TerminatorPrecedence `json:"precedence"`
+
+	// route cost
+	RouteCost int64 `json:"routeCost,omitempty"`
+
+	// router
+	// Required: true
+	Router *EntityRef `json:"router"`
+
+	// selected
+	// Required: true
+	Selected *bool `json:"selected"`
+
+	// skip detail
+	SkipDetail string `json:"skipDetail,omitempty"`
+
+	// skip reason
+	// Enum: [INSTANCE_MISMATCH ROUTER_OFFLINE NO_PATH DRAINING]
+	SkipReason string `json:"skipReason,omitempty"`
+
+	// static cost
+	// Required: true
+	StaticCost *TerminatorCost `json:"staticCost"`
+
+	// terminator
+	// Required: true
+	Terminator *EntityRef `json:"terminator"`
+}
+
+// Validate validates this route preview candidate
+func (m *RoutePreviewCandidate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDynamicCost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstanceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrecedence(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRouter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSelected(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSkipReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStaticCost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTerminator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateDynamicCost(formats strfmt.Registry) error {
+
+	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
+		return err
+	}
+
+	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
+		return err
+	}
+
+	if m.DynamicCost != nil {
+		if err := m.DynamicCost.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dynamicCost")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dynamicCost")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateInstanceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("instanceId", "body", m.InstanceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validatePath(formats strfmt.Registry) error {
+	if swag.IsZero(m.Path) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Path); i++ {
+		if swag.IsZero(m.Path[i]) { // not required
+			continue
+		}
+
+		if m.Path[i] != nil {
+			if err := m.Path[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validatePrecedence(formats strfmt.Registry) error {
+
+	if err := validate.Required("precedence", "body", m.Precedence); err != nil {
+		return err
+	}
+
+	if err := validate.Required("precedence", "body", m.Precedence); err != nil {
+		return err
+	}
+
+	if m.Precedence != nil {
+		if err := m.Precedence.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("precedence")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("precedence")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateRouter(formats strfmt.Registry) error {
+
+	if err := validate.Required("router", "body", m.Router); err != nil {
+		return err
+	}
+
+	if m.Router != nil {
+		if err := m.Router.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("router")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("router")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateSelected(formats strfmt.Registry) error {
+
+	if err := validate.Required("selected", "body", m.Selected); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var routePreviewCandidateTypeSkipReasonPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["INSTANCE_MISMATCH","ROUTER_OFFLINE","NO_PATH","DRAINING"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		routePreviewCandidateTypeSkipReasonPropEnum = append(routePreviewCandidateTypeSkipReasonPropEnum, v)
+	}
+}
+
+const (
+
+	// RoutePreviewCandidateSkipReasonINSTANCEMISMATCH captures enum value "INSTANCE_MISMATCH"
+	RoutePreviewCandidateSkipReasonINSTANCEMISMATCH string = "INSTANCE_MISMATCH"
+
+	// RoutePreviewCandidateSkipReasonROUTEROFFLINE captures enum value "ROUTER_OFFLINE"
+	RoutePreviewCandidateSkipReasonROUTEROFFLINE string = "ROUTER_OFFLINE"
+
+	// RoutePreviewCandidateSkipReasonNOPATH captures enum value "NO_PATH"
+	RoutePreviewCandidateSkipReasonNOPATH string = "NO_PATH"
+
+	// RoutePreviewCandidateSkipReasonDRAINING captures enum value "DRAINING"
+	RoutePreviewCandidateSkipReasonDRAINING string = "DRAINING"
+)
+
+// prop value enum
+func (m *RoutePreviewCandidate) validateSkipReasonEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, routePreviewCandidateTypeSkipReasonPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateSkipReason(formats strfmt.Registry) error {
+	if swag.IsZero(m.SkipReason) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSkipReasonEnum("skipReason", "body", m.SkipReason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateStaticCost(formats strfmt.Registry) error {
+
+	if err := validate.Required("staticCost", "body", m.StaticCost); err != nil {
+		return err
+	}
+
+	if err := validate.Required("staticCost", "body", m.StaticCost); err != nil {
+		return err
+	}
+
+	if m.StaticCost != nil {
+		if err := m.StaticCost.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("staticCost")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("staticCost")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) validateTerminator(formats strfmt.Registry) error {
+
+	if err := validate.Required("terminator", "body", m.Terminator); err != nil {
+		return err
+	}
+
+	if m.Terminator != nil {
+		if err := m.Terminator.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("terminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("terminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this route preview candidate based on the context it is used
+func (m *RoutePreviewCandidate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDynamicCost(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRouter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStaticCost(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTerminator(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidateDynamicCost(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DynamicCost != nil {
+		if err := m.DynamicCost.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dynamicCost")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dynamicCost")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidatePath(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Path); i++ {
+
+		if m.Path[i] != nil {
+			if err := m.Path[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Precedence != nil {
+		if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("precedence")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("precedence")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidateRouter(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Router != nil {
+		if err := m.Router.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("router")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("router")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidateStaticCost(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StaticCost != nil {
+		if err := m.StaticCost.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("staticCost")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("staticCost")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RoutePreviewCandidate) contextValidateTerminator(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Terminator != nil {
+		if err := m.Terminator.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("terminator")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("terminator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RoutePreviewCandidate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RoutePreviewCandidate) UnmarshalBinary(b []byte) error {
+	var res RoutePreviewCandidate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
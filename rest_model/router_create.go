@@ -49,6 +49,9 @@ type RouterCreate struct {
 	// Minimum: 0
 	Cost *int64 `json:"cost"`
 
+	// draining
+	Draining bool `json:"draining,omitempty"`
+
 	// fingerprint
 	Fingerprint *string `json:"fingerprint,omitempty"`
 
@@ -64,6 +67,9 @@ type RouterCreate struct {
 	// Required: true
 	NoTraversal *bool `json:"noTraversal"`
 
+	// role attributes
+	RoleAttributes []string `json:"roleAttributes"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 }
@@ -55,10 +55,20 @@ type RouterDetail struct {
 	// Minimum: 0
 	Cost *int64 `json:"cost"`
 
+	// The number of circuits still using the router, only reported while the router is draining
+	DrainRemainingCircuits *int64 `json:"drainRemainingCircuits,omitempty"`
+
+	// draining
+	Draining bool `json:"draining,omitempty"`
+
 	// fingerprint
 	// Required: true
 	Fingerprint *string `json:"fingerprint"`
 
+	// When the router last disconnected. Not set while the router is connected
+	// Format: date-time
+	LastDisconnected *strfmt.DateTime `json:"lastDisconnected,omitempty"`
+
 	// listener addresses
 	ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
@@ -70,6 +80,9 @@ type RouterDetail struct {
 	// Required: true
 	NoTraversal *bool `json:"noTraversal"`
 
+	// role attributes
+	RoleAttributes []string `json:"roleAttributes"`
+
 	// version info
 	VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
 }
@@ -89,14 +102,22 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 
 		Cost *int64 `json:"cost"`
 
+		DrainRemainingCircuits *int64 `json:"drainRemainingCircuits,omitempty"`
+
+		Draining bool `json:"draining,omitempty"`
+
 		Fingerprint *string `json:"fingerprint"`
 
+		LastDisconnected *strfmt.DateTime `json:"lastDisconnected,omitempty"`
+
 		ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
 		Name *string `json:"name"`
 
 		NoTraversal *bool `json:"noTraversal"`
 
+		RoleAttributes []string `json:"roleAttributes"`
+
 		VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
@@ -107,14 +128,22 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 
 	m.Cost = dataAO1.Cost
 
+	m.DrainRemainingCircuits = dataAO1.DrainRemainingCircuits
+
+	m.Draining = dataAO1.Draining
+
 	m.Fingerprint = dataAO1.Fingerprint
 
+	m.LastDisconnected = dataAO1.LastDisconnected
+
 	m.ListenerAddresses = dataAO1.ListenerAddresses
 
 	m.Name = dataAO1.Name
 
 	m.NoTraversal = dataAO1.NoTraversal
 
+	m.RoleAttributes = dataAO1.RoleAttributes
+
 	m.VersionInfo = dataAO1.VersionInfo
 
 	return nil
@@ -134,14 +163,22 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 
 		Cost *int64 `json:"cost"`
 
+		DrainRemainingCircuits *int64 `json:"drainRemainingCircuits,omitempty"`
+
+		Draining bool `json:"draining,omitempty"`
+
 		Fingerprint *string `json:"fingerprint"`
 
+		LastDisconnected *strfmt.DateTime `json:"lastDisconnected,omitempty"`
+
 		ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
 		Name *string `json:"name"`
 
 		NoTraversal *bool `json:"noTraversal"`
 
+		RoleAttributes []string `json:"roleAttributes"`
+
 		VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
 	}
 
@@ -149,14 +186,22 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Cost = m.Cost
 
+	dataAO1.DrainRemainingCircuits = m.DrainRemainingCircuits
+
+	dataAO1.Draining = m.Draining
+
 	dataAO1.Fingerprint = m.Fingerprint
 
+	dataAO1.LastDisconnected = m.LastDisconnected
+
 	dataAO1.ListenerAddresses = m.ListenerAddresses
 
 	dataAO1.Name = m.Name
 
 	dataAO1.NoTraversal = m.NoTraversal
 
+	dataAO1.RoleAttributes = m.RoleAttributes
+
 	dataAO1.VersionInfo = m.VersionInfo
 
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
@@ -188,6 +233,10 @@ func (m *RouterDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastDisconnected(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateListenerAddresses(formats); err != nil {
 		res = append(res, err)
 	}
@@ -245,6 +294,19 @@ func (m *RouterDetail) validateFingerprint(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RouterDetail) validateLastDisconnected(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastDisconnected) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastDisconnected", "body", "date-time", m.LastDisconnected.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RouterDetail) validateListenerAddresses(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ListenerAddresses) { // not required
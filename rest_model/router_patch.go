@@ -48,6 +48,9 @@ type RouterPatch struct {
 	// Minimum: 0
 	Cost *int64 `json:"cost,omitempty"`
 
+	// draining
+	Draining *bool `json:"draining,omitempty"`
+
 	// fingerprint
 	Fingerprint *string `json:"fingerprint,omitempty"`
 
@@ -57,6 +60,9 @@ type RouterPatch struct {
 	// no traversal
 	NoTraversal *bool `json:"noTraversal,omitempty"`
 
+	// role attributes
+	RoleAttributes []string `json:"roleAttributes"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 }
@@ -49,6 +49,9 @@ type RouterUpdate struct {
 	// Minimum: 0
 	Cost *int64 `json:"cost"`
 
+	// draining
+	Draining bool `json:"draining,omitempty"`
+
 	// fingerprint
 	// Required: true
 	Fingerprint *string `json:"fingerprint"`
@@ -61,6 +64,9 @@ type RouterUpdate struct {
 	// Required: true
 	NoTraversal *bool `json:"noTraversal"`
 
+	// role attributes
+	RoleAttributes []string `json:"roleAttributes"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 }
@@ -43,15 +43,48 @@ import (
 // swagger:model serviceCreate
 type ServiceCreate struct {
 
+	// allowed routers
+	AllowedRouters []string `json:"allowedRouters"`
+
+	// circuit rate burst
+	CircuitRateBurst int64 `json:"circuitRateBurst,omitempty"`
+
+	// circuit rate limit
+	CircuitRateLimit int64 `json:"circuitRateLimit,omitempty"`
+
+	// denied routers
+	DeniedRouters []string `json:"deniedRouters"`
+
+	// max circuits
+	MaxCircuits int64 `json:"maxCircuits,omitempty"`
+
+	// max circuits per client
+	MaxCircuitsPerClient int64 `json:"maxCircuitsPerClient,omitempty"`
+
+	// max link count
+	MaxLinkCount int64 `json:"maxLinkCount,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// pinned path
+	PinnedPath []string `json:"pinnedPath"`
+
+	// pinned path fallback
+	PinnedPathFallback bool `json:"pinnedPathFallback,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
 	// terminator strategy
 	TerminatorStrategy string `json:"terminatorStrategy,omitempty"`
+
+	// traffic split
+	TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+	// waypoint routers
+	WaypointRouters []string `json:"waypointRouters"`
 }
 
 // Validate validates this service create
@@ -66,6 +99,10 @@ func (m *ServiceCreate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTrafficSplit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -100,6 +137,25 @@ func (m *ServiceCreate) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceCreate) validateTrafficSplit(formats strfmt.Registry) error {
+	if swag.IsZero(m.TrafficSplit) { // not required
+		return nil
+	}
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this service create based on the context it is used
 func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -108,6 +164,10 @@ func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTrafficSplit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -130,6 +190,22 @@ func (m *ServiceCreate) contextValidateTags(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *ServiceCreate) contextValidateTrafficSplit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceCreate) MarshalBinary() ([]byte, error) {
 	if m == nil {
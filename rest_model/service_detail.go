@@ -44,13 +44,46 @@ import (
 type ServiceDetail struct {
 	BaseEntity
 
+	// allowed routers
+	AllowedRouters []string `json:"allowedRouters"`
+
+	// circuit rate burst
+	CircuitRateBurst int64 `json:"circuitRateBurst,omitempty"`
+
+	// circuit rate limit
+	CircuitRateLimit int64 `json:"circuitRateLimit,omitempty"`
+
+	// denied routers
+	DeniedRouters []string `json:"deniedRouters"`
+
+	// max circuits
+	MaxCircuits int64 `json:"maxCircuits,omitempty"`
+
+	// max circuits per client
+	MaxCircuitsPerClient int64 `json:"maxCircuitsPerClient,omitempty"`
+
+	// max link count
+	MaxLinkCount int64 `json:"maxLinkCount,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// pinned path
+	PinnedPath []string `json:"pinnedPath"`
+
+	// pinned path fallback
+	PinnedPathFallback bool `json:"pinnedPathFallback,omitempty"`
+
 	// terminator strategy
 	// Required: true
 	TerminatorStrategy *string `json:"terminatorStrategy"`
+
+	// traffic split
+	TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+	// waypoint routers
+	WaypointRouters []string `json:"waypointRouters"`
 }
 
 // UnmarshalJSON unmarshals this object from a JSON structure
@@ -64,18 +97,62 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		AllowedRouters []string `json:"allowedRouters"`
+
+		CircuitRateBurst int64 `json:"circuitRateBurst,omitempty"`
+
+		CircuitRateLimit int64 `json:"circuitRateLimit,omitempty"`
+
+		DeniedRouters []string `json:"deniedRouters"`
+
+		MaxCircuits int64 `json:"maxCircuits,omitempty"`
+
+		MaxCircuitsPerClient int64 `json:"maxCircuitsPerClient,omitempty"`
+
+		MaxLinkCount int64 `json:"maxLinkCount,omitempty"`
+
 		Name *string `json:"name"`
 
+		PinnedPath []string `json:"pinnedPath"`
+
+		PinnedPathFallback bool `json:"pinnedPathFallback,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
+
+		TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+		WaypointRouters []string `json:"waypointRouters"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
 	}
 
+	m.AllowedRouters = dataAO1.AllowedRouters
+
+	m.CircuitRateBurst = dataAO1.CircuitRateBurst
+
+	m.CircuitRateLimit = dataAO1.CircuitRateLimit
+
+	m.DeniedRouters = dataAO1.DeniedRouters
+
+	m.MaxCircuits = dataAO1.MaxCircuits
+
+	m.MaxCircuitsPerClient = dataAO1.MaxCircuitsPerClient
+
+	m.MaxLinkCount = dataAO1.MaxLinkCount
+
 	m.Name = dataAO1.Name
 
+	m.PinnedPath = dataAO1.PinnedPath
+
+	m.PinnedPathFallback = dataAO1.PinnedPathFallback
+
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
 
+	m.TrafficSplit = dataAO1.TrafficSplit
+
+	m.WaypointRouters = dataAO1.WaypointRouters
+
 	return nil
 }
 
@@ -89,15 +166,59 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		AllowedRouters []string `json:"allowedRouters"`
+
+		CircuitRateBurst int64 `json:"circuitRateBurst,omitempty"`
+
+		CircuitRateLimit int64 `json:"circuitRateLimit,omitempty"`
+
+		DeniedRouters []string `json:"deniedRouters"`
+
+		MaxCircuits int64 `json:"maxCircuits,omitempty"`
+
+		MaxCircuitsPerClient int64 `json:"maxCircuitsPerClient,omitempty"`
+
+		MaxLinkCount int64 `json:"maxLinkCount,omitempty"`
+
 		Name *string `json:"name"`
 
+		PinnedPath []string `json:"pinnedPath"`
+
+		PinnedPathFallback bool `json:"pinnedPathFallback,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
+
+		TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+		WaypointRouters []string `json:"waypointRouters"`
 	}
 
+	dataAO1.AllowedRouters = m.AllowedRouters
+
+	dataAO1.CircuitRateBurst = m.CircuitRateBurst
+
+	dataAO1.CircuitRateLimit = m.CircuitRateLimit
+
+	dataAO1.DeniedRouters = m.DeniedRouters
+
+	dataAO1.MaxCircuits = m.MaxCircuits
+
+	dataAO1.MaxCircuitsPerClient = m.MaxCircuitsPerClient
+
+	dataAO1.MaxLinkCount = m.MaxLinkCount
+
 	dataAO1.Name = m.Name
 
+	dataAO1.PinnedPath = m.PinnedPath
+
+	dataAO1.PinnedPathFallback = m.PinnedPathFallback
+
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
 
+	dataAO1.TrafficSplit = m.TrafficSplit
+
+	dataAO1.WaypointRouters = m.WaypointRouters
+
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
 	if errAO1 != nil {
 		return nil, errAO1
@@ -123,6 +244,10 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTrafficSplit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -147,6 +272,26 @@ func (m *ServiceDetail) validateTerminatorStrategy(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *ServiceDetail) validateTrafficSplit(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TrafficSplit) { // not required
+		return nil
+	}
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this service detail based on the context it is used
 func (m *ServiceDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -156,12 +301,32 @@ func (m *ServiceDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTrafficSplit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ServiceDetail) contextValidateTrafficSplit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
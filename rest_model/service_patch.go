@@ -42,14 +42,47 @@ import (
 // swagger:model servicePatch
 type ServicePatch struct {
 
+	// allowed routers
+	AllowedRouters []string `json:"allowedRouters"`
+
+	// circuit rate burst
+	CircuitRateBurst int64 `json:"circuitRateBurst,omitempty"`
+
+	// circuit rate limit
+	CircuitRateLimit int64 `json:"circuitRateLimit,omitempty"`
+
+	// denied routers
+	DeniedRouters []string `json:"deniedRouters"`
+
+	// max circuits
+	MaxCircuits int64 `json:"maxCircuits,omitempty"`
+
+	// max circuits per client
+	MaxCircuitsPerClient int64 `json:"maxCircuitsPerClient,omitempty"`
+
+	// max link count
+	MaxLinkCount int64 `json:"maxLinkCount,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
+	// pinned path
+	PinnedPath []string `json:"pinnedPath"`
+
+	// pinned path fallback
+	PinnedPathFallback bool `json:"pinnedPathFallback,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
 	// terminator strategy
 	TerminatorStrategy string `json:"terminatorStrategy,omitempty"`
+
+	// traffic split
+	TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty"`
+
+	// waypoint routers
+	WaypointRouters []string `json:"waypointRouters"`
 }
 
 // Validate validates this service patch
@@ -60,6 +93,10 @@ func (m *ServicePatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTrafficSplit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -85,6 +122,25 @@ func (m *ServicePatch) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePatch) validateTrafficSplit(formats strfmt.Registry) error {
+	if swag.IsZero(m.TrafficSplit) { // not required
+		return nil
+	}
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this service patch based on the context it is used
 func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -93,6 +149,10 @@ func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTrafficSplit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -115,6 +175,22 @@ func (m *ServicePatch) contextValidateTags(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *ServicePatch) contextValidateTrafficSplit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TrafficSplit != nil {
+		if err := m.TrafficSplit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("trafficSplit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("trafficSplit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServicePatch) MarshalBinary() ([]byte, error) {
 	if m == nil {
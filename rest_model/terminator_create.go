@@ -54,6 +54,9 @@ type TerminatorCreate struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -95,6 +98,10 @@ func (m *TerminatorCreate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -154,6 +161,25 @@ func (m *TerminatorCreate) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorCreate) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -216,6 +242,10 @@ func (m *TerminatorCreate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -246,6 +276,22 @@ func (m *TerminatorCreate) contextValidateCost(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorCreate) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
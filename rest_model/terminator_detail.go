@@ -56,10 +56,24 @@ type TerminatorDetail struct {
 	// Required: true
 	Cost *TerminatorCost `json:"cost"`
 
+	// drain deadline
+	// Format: date-time
+	DrainDeadline *strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+	// draining
+	// Required: true
+	Draining *bool `json:"draining"`
+
 	// dynamic cost
 	// Required: true
 	DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+	// health status
+	HealthStatus *TerminatorHealthStatus `json:"healthStatus,omitempty"`
+
 	// host Id
 	// Required: true
 	HostID *string `json:"hostId"`
@@ -68,6 +82,13 @@ type TerminatorDetail struct {
 	// Required: true
 	InstanceID *string `json:"instanceId"`
 
+	// lease expires at
+	// Format: date-time
+	LeaseExpiresAt *strfmt.DateTime `json:"leaseExpiresAt,omitempty"`
+
+	// Lease time to live in milliseconds. Leased terminators are deleted unless their router renews the lease in time
+	LeaseTTL int64 `json:"leaseTtl,omitempty"`
+
 	// precedence
 	// Required: true
 	Precedence *TerminatorPrecedence `json:"precedence"`
@@ -106,12 +127,24 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline *strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+		Draining *bool `json:"draining"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+		HealthStatus *TerminatorHealthStatus `json:"healthStatus,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
 
+		LeaseExpiresAt *strfmt.DateTime `json:"leaseExpiresAt,omitempty"`
+
+		LeaseTTL int64 `json:"leaseTtl,omitempty"`
+
 		Precedence *TerminatorPrecedence `json:"precedence"`
 
 		Router *EntityRef `json:"router"`
@@ -132,12 +165,24 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 	m.Cost = dataAO1.Cost
 
+	m.DrainDeadline = dataAO1.DrainDeadline
+
+	m.Draining = dataAO1.Draining
+
 	m.DynamicCost = dataAO1.DynamicCost
 
+	m.HealthCheck = dataAO1.HealthCheck
+
+	m.HealthStatus = dataAO1.HealthStatus
+
 	m.HostID = dataAO1.HostID
 
 	m.InstanceID = dataAO1.InstanceID
 
+	m.LeaseExpiresAt = dataAO1.LeaseExpiresAt
+
+	m.LeaseTTL = dataAO1.LeaseTTL
+
 	m.Precedence = dataAO1.Precedence
 
 	m.Router = dataAO1.Router
@@ -167,12 +212,24 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline *strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+		Draining *bool `json:"draining"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+		HealthStatus *TerminatorHealthStatus `json:"healthStatus,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
 
+		LeaseExpiresAt *strfmt.DateTime `json:"leaseExpiresAt,omitempty"`
+
+		LeaseTTL int64 `json:"leaseTtl,omitempty"`
+
 		Precedence *TerminatorPrecedence `json:"precedence"`
 
 		Router *EntityRef `json:"router"`
@@ -190,12 +247,24 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Cost = m.Cost
 
+	dataAO1.DrainDeadline = m.DrainDeadline
+
+	dataAO1.Draining = m.Draining
+
 	dataAO1.DynamicCost = m.DynamicCost
 
+	dataAO1.HealthCheck = m.HealthCheck
+
+	dataAO1.HealthStatus = m.HealthStatus
+
 	dataAO1.HostID = m.HostID
 
 	dataAO1.InstanceID = m.InstanceID
 
+	dataAO1.LeaseExpiresAt = m.LeaseExpiresAt
+
+	dataAO1.LeaseTTL = m.LeaseTTL
+
 	dataAO1.Precedence = m.Precedence
 
 	dataAO1.Router = m.Router
@@ -235,10 +304,26 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainDeadline(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDraining(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDynamicCost(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHealthStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -247,6 +332,10 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLeaseExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -315,6 +404,28 @@ func (m *TerminatorDetail) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateDrainDeadline(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DrainDeadline) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("drainDeadline", "body", "date-time", m.DrainDeadline.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TerminatorDetail) validateDraining(formats strfmt.Registry) error {
+
+	if err := validate.Required("draining", "body", m.Draining); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 
 	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
@@ -339,6 +450,46 @@ func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateHealthCheck(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *TerminatorDetail) validateHealthStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HealthStatus) { // not required
+		return nil
+	}
+
+	if m.HealthStatus != nil {
+		if err := m.HealthStatus.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateHostID(formats strfmt.Registry) error {
 
 	if err := validate.Required("hostId", "body", m.HostID); err != nil {
@@ -357,6 +508,19 @@ func (m *TerminatorDetail) validateInstanceID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateLeaseExpiresAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LeaseExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("leaseExpiresAt", "body", "date-time", m.LeaseExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validatePrecedence(formats strfmt.Registry) error {
 
 	if err := validate.Required("precedence", "body", m.Precedence); err != nil {
@@ -456,6 +620,14 @@ func (m *TerminatorDetail) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHealthStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -506,6 +678,38 @@ func (m *TerminatorDetail) contextValidateDynamicCost(ctx context.Context, forma
 	return nil
 }
 
+func (m *TerminatorDetail) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *TerminatorDetail) contextValidateHealthStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthStatus != nil {
+		if err := m.HealthStatus.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Precedence != nil {
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TerminatorHealthCheck terminator health check
+//
+// swagger:model terminatorHealthCheck
+type TerminatorHealthCheck struct {
+
+	// The address to check. tcp and binding checks default to the terminator address. Required for http checks
+	Address string `json:"address,omitempty"`
+
+	// The expected http status code for http checks. Defaults to 200
+	ExpectedStatus int64 `json:"expectedStatus,omitempty"`
+
+	// Time between checks, in milliseconds. Defaults to 30 seconds
+	Interval int64 `json:"interval,omitempty"`
+
+	// Time to wait for a check to complete, in milliseconds. Defaults to 5 seconds
+	Timeout int64 `json:"timeout,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [tcp http binding]
+	Type *string `json:"type"`
+}
+
+// Validate validates this terminator health check
+func (m *TerminatorHealthCheck) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var terminatorHealthCheckTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["tcp","http","binding"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		terminatorHealthCheckTypeTypePropEnum = append(terminatorHealthCheckTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// TerminatorHealthCheckTypeTCP captures enum value "tcp"
+	TerminatorHealthCheckTypeTCP string = "tcp"
+
+	// TerminatorHealthCheckTypeHTTP captures enum value "http"
+	TerminatorHealthCheckTypeHTTP string = "http"
+
+	// TerminatorHealthCheckTypeBinding captures enum value "binding"
+	TerminatorHealthCheckTypeBinding string = "binding"
+)
+
+// prop value enum
+func (m *TerminatorHealthCheck) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, terminatorHealthCheckTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TerminatorHealthCheck) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this terminator health check based on context it is used
+func (m *TerminatorHealthCheck) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TerminatorHealthCheck) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TerminatorHealthCheck) UnmarshalBinary(b []byte) error {
+	var res TerminatorHealthCheck
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TerminatorHealthStatus terminator health status
+//
+// swagger:model terminatorHealthStatus
+type TerminatorHealthStatus struct {
+
+	// checked at
+	// Required: true
+	// Format: date-time
+	CheckedAt *strfmt.DateTime `json:"checkedAt"`
+
+	// healthy
+	// Required: true
+	Healthy *bool `json:"healthy"`
+
+	// message
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this terminator health status
+func (m *TerminatorHealthStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCheckedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHealthy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TerminatorHealthStatus) validateCheckedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("checkedAt", "body", m.CheckedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("checkedAt", "body", "date-time", m.CheckedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TerminatorHealthStatus) validateHealthy(formats strfmt.Registry) error {
+
+	if err := validate.Required("healthy", "body", m.Healthy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this terminator health status based on the context it is used
+func (m *TerminatorHealthStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TerminatorHealthStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TerminatorHealthStatus) UnmarshalBinary(b []byte) error {
+	var res TerminatorHealthStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
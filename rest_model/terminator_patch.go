@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // TerminatorPatch terminator patch
@@ -51,6 +52,16 @@ type TerminatorPatch struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// Optional time in milliseconds after which circuits still using a draining terminator are closed
+	// Minimum: 0
+	DrainTimeout *int64 `json:"drainTimeout,omitempty"`
+
+	// draining
+	Draining bool `json:"draining,omitempty"`
+
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -75,6 +86,14 @@ func (m *TerminatorPatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +127,37 @@ func (m *TerminatorPatch) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorPatch) validateDrainTimeout(formats strfmt.Registry) error {
+	if swag.IsZero(m.DrainTimeout) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("drainTimeout", "body", *m.DrainTimeout, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TerminatorPatch) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -152,6 +202,10 @@ func (m *TerminatorPatch) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +236,22 @@ func (m *TerminatorPatch) contextValidateCost(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *TerminatorPatch) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
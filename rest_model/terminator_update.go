@@ -54,6 +54,16 @@ type TerminatorUpdate struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// Optional time in milliseconds after which circuits still using a draining terminator are closed
+	// Minimum: 0
+	DrainTimeout *int64 `json:"drainTimeout,omitempty"`
+
+	// draining
+	Draining bool `json:"draining,omitempty"`
+
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -88,6 +98,14 @@ func (m *TerminatorUpdate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainTimeout(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -147,6 +165,37 @@ func (m *TerminatorUpdate) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorUpdate) validateDrainTimeout(formats strfmt.Registry) error {
+	if swag.IsZero(m.DrainTimeout) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("drainTimeout", "body", *m.DrainTimeout, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TerminatorUpdate) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -209,6 +258,10 @@ func (m *TerminatorUpdate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -239,6 +292,22 @@ func (m *TerminatorUpdate) contextValidateCost(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorUpdate) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
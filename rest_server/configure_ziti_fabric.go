@@ -39,6 +39,7 @@ import (
 	"github.com/openziti/fabric/rest_server/operations/database"
 	"github.com/openziti/fabric/rest_server/operations/inspect"
 	"github.com/openziti/fabric/rest_server/operations/link"
+	"github.com/openziti/fabric/rest_server/operations/link_policy"
 	"github.com/openziti/fabric/rest_server/operations/router"
 	"github.com/openziti/fabric/rest_server/operations/service"
 	"github.com/openziti/fabric/rest_server/operations/terminator"
@@ -78,6 +79,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyCreateLinkPolicyHandler == nil {
+		api.LinkPolicyCreateLinkPolicyHandler = link_policy.CreateLinkPolicyHandlerFunc(func(params link_policy.CreateLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.CreateLinkPolicy has not yet been implemented")
+		})
+	}
 	if api.RouterCreateRouterHandler == nil {
 		api.RouterCreateRouterHandler = router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
@@ -108,6 +114,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyDeleteLinkPolicyHandler == nil {
+		api.LinkPolicyDeleteLinkPolicyHandler = link_policy.DeleteLinkPolicyHandlerFunc(func(params link_policy.DeleteLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.DeleteLinkPolicy has not yet been implemented")
+		})
+	}
 	if api.RouterDeleteRouterHandler == nil {
 		api.RouterDeleteRouterHandler = router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
@@ -133,6 +144,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyDetailLinkPolicyHandler == nil {
+		api.LinkPolicyDetailLinkPolicyHandler = link_policy.DetailLinkPolicyHandlerFunc(func(params link_policy.DetailLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.DetailLinkPolicy has not yet been implemented")
+		})
+	}
 	if api.RouterDetailRouterHandler == nil {
 		api.RouterDetailRouterHandler = router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
@@ -163,6 +179,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyListLinkPoliciesHandler == nil {
+		api.LinkPolicyListLinkPoliciesHandler = link_policy.ListLinkPoliciesHandlerFunc(func(params link_policy.ListLinkPoliciesParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.ListLinkPolicies has not yet been implemented")
+		})
+	}
 	if api.LinkListLinksHandler == nil {
 		api.LinkListLinksHandler = link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
@@ -193,11 +214,21 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		})
 	}
+	if api.TerminatorOfflineRouterTerminatorReportHandler == nil {
+		api.TerminatorOfflineRouterTerminatorReportHandler = terminator.OfflineRouterTerminatorReportHandlerFunc(func(params terminator.OfflineRouterTerminatorReportParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.OfflineRouterTerminatorReport has not yet been implemented")
+		})
+	}
 	if api.LinkPatchLinkHandler == nil {
 		api.LinkPatchLinkHandler = link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyPatchLinkPolicyHandler == nil {
+		api.LinkPolicyPatchLinkPolicyHandler = link_policy.PatchLinkPolicyHandlerFunc(func(params link_policy.PatchLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.PatchLinkPolicy has not yet been implemented")
+		})
+	}
 	if api.RouterPatchRouterHandler == nil {
 		api.RouterPatchRouterHandler = router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
@@ -213,6 +244,26 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		})
 	}
+	if api.LinkPolicyPreviewLinkPoliciesHandler == nil {
+		api.LinkPolicyPreviewLinkPoliciesHandler = link_policy.PreviewLinkPoliciesHandlerFunc(func(params link_policy.PreviewLinkPoliciesParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.PreviewLinkPolicies has not yet been implemented")
+		})
+	}
+	if api.ServicePreviewServiceRouteHandler == nil {
+		api.ServicePreviewServiceRouteHandler = service.PreviewServiceRouteHandlerFunc(func(params service.PreviewServiceRouteParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.PreviewServiceRoute has not yet been implemented")
+		})
+	}
+	if api.CircuitRerouteCircuitHandler == nil {
+		api.CircuitRerouteCircuitHandler = circuit.RerouteCircuitHandlerFunc(func(params circuit.RerouteCircuitParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.RerouteCircuit has not yet been implemented")
+		})
+	}
+	if api.LinkPolicyUpdateLinkPolicyHandler == nil {
+		api.LinkPolicyUpdateLinkPolicyHandler = link_policy.UpdateLinkPolicyHandlerFunc(func(params link_policy.UpdateLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.UpdateLinkPolicy has not yet been implemented")
+		})
+	}
 	if api.RouterUpdateRouterHandler == nil {
 		api.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
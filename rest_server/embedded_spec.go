@@ -136,6 +136,46 @@ func init() {
         }
       ]
     },
+    "/circuits/{id}/reroute": {
+      "post": {
+        "description": "Reroutes the circuit onto a path visiting the given routers in order. The circuit's initiating and terminating\nrouters may be omitted from the path. If pin is set, the circuit is exempt from smart rerouting until it's\nmoved again. An empty path with pin unset unpins the circuit, leaving it on its current path. Requires admin\naccess.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Move a circuit onto an explicit path",
+        "operationId": "rerouteCircuit",
+        "parameters": [
+          {
+            "description": "The path to move the circuit to",
+            "name": "reroute",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitReroute"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailCircuit"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/database": {
       "post": {
         "security": [
@@ -266,6 +306,207 @@ func init() {
         }
       }
     },
+    "/link-policies": {
+      "get": {
+        "description": "Retrieves a list of link policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "List link policies",
+        "operationId": "listLinkPolicies",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listLinkPolicies"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "Create a link policy resource. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Create a link policy resource",
+        "operationId": "createLinkPolicy",
+        "parameters": [
+          {
+            "description": "A link policy to create",
+            "name": "linkPolicy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPolicyCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/link-policies/preview": {
+      "post": {
+        "description": "Evaluates a set of link policies against all routers and returns the links the controller would dial. If no\npolicies are given, the stored link policies are evaluated. An empty list of policies previews the default\nbehavior, where all routers are linked. Router listeners are only known for connected routers, so they aren't\ntaken into account. Requires admin access.\n",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Preview the links produced by a set of link policies",
+        "operationId": "previewLinkPolicies",
+        "parameters": [
+          {
+            "description": "The link policies to evaluate",
+            "name": "preview",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/linkPolicyPreviewRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/linkPolicyPreview"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/link-policies/{id}": {
+      "get": {
+        "description": "Retrieves a single link policy by id. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Retrieves a single link policy",
+        "operationId": "detailLinkPolicy",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailLinkPolicy"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a link policy by id. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Update all fields on a link policy",
+        "operationId": "updateLinkPolicy",
+        "parameters": [
+          {
+            "description": "A link policy update object",
+            "name": "linkPolicy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPolicyUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link policy by id. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Delete a link policy",
+        "operationId": "deleteLinkPolicy",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link policy. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Update the supplied fields on a link policy",
+        "operationId": "patchLinkPolicy",
+        "parameters": [
+          {
+            "description": "A link policy patch object",
+            "name": "linkPolicy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPolicyPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/links": {
       "get": {
         "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
@@ -745,6 +986,56 @@ func init() {
         }
       ]
     },
+    "/services/{id}/route-preview": {
+      "get": {
+        "description": "Runs terminator and path selection for the service, as would be done when creating a circuit, without\ncreating a circuit. Returns each terminator with its cost breakdown, the reason any terminators were skipped,\nthe terminator the service's strategy would pick and the path to it. Requires admin access.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Preview terminator and path selection for a service",
+        "operationId": "previewServiceRoute",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the router the circuit would originate from",
+            "name": "sourceRouter",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The instance id being dialed, if any",
+            "name": "instanceId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The client id to pass to the terminator strategy, if any",
+            "name": "clientId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/routePreview"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/services/{id}/terminators": {
       "get": {
         "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
@@ -844,6 +1135,24 @@ func init() {
         }
       }
     },
+    "/terminators/offline-router-report": {
+      "get": {
+        "description": "Lists the terminators which offline terminator cleanup acts on, without changing anything. Terminators are\nincluded if their router has been disconnected for longer than the configured threshold. The report is\ngenerated whether or not cleanup is enabled. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Report the terminators of routers which have been offline past the cleanup threshold",
+        "operationId": "offlineRouterTerminatorReport",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/offlineRouterTerminatorReport"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/terminators/{id}": {
       "get": {
         "description": "Retrieves a single terminator by id. Requires admin access.",
@@ -1112,9 +1421,29 @@ func init() {
             }
           }
         },
+        "pinned": {
+          "type": "boolean"
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
+        "standbyPath": {
+          "type": "object",
+          "properties": {
+            "links": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/entityRef"
+              }
+            },
+            "nodes": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/entityRef"
+              }
+            }
+          }
+        },
         "terminator": {
           "$ref": "#/definitions/entityRef"
         }
@@ -1126,6 +1455,20 @@ func init() {
         "$ref": "#/definitions/circuitDetail"
       }
     },
+    "circuitReroute": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pin": {
+          "type": "boolean"
+        }
+      }
+    },
     "createEnvelope": {
       "type": "object",
       "properties": {
@@ -1251,6 +1594,21 @@ func init() {
         }
       }
     },
+    "detailLinkPolicyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkPolicyDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailRouterEnvelope": {
       "type": "object",
       "required": [
@@ -1432,6 +1790,12 @@ func init() {
         "cost": {
           "type": "integer"
         },
+        "costTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "destLatency": {
           "type": "integer"
         },
@@ -1441,6 +1805,21 @@ func init() {
         "down": {
           "type": "boolean"
         },
+        "excludedByCostTags": {
+          "type": "boolean"
+        },
+        "flapPenalty": {
+          "type": "integer"
+        },
+        "flapReuseThreshold": {
+          "type": "integer"
+        },
+        "flapSuppressThreshold": {
+          "type": "integer"
+        },
+        "flapSuppressed": {
+          "type": "boolean"
+        },
         "id": {
           "type": "string"
         },
@@ -1478,6 +1857,229 @@ func init() {
         }
       }
     },
+    "linkPolicyAction": {
+      "type": "string",
+      "enum": [
+        "allow",
+        "deny"
+      ]
+    },
+    "linkPolicyCreate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
+        },
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkPolicyDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "action"
+          ],
+          "properties": {
+            "action": {
+              "$ref": "#/definitions/linkPolicyAction"
+            },
+            "destinationRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "matchTags": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "name": {
+              "type": "string"
+            },
+            "sourceRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      ]
+    },
+    "linkPolicyList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkPolicyDetail"
+      }
+    },
+    "linkPolicyPatch": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
+        },
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkPolicyPreview": {
+      "type": "object",
+      "required": [
+        "links"
+      ],
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkPolicyPreviewLink"
+          }
+        }
+      }
+    },
+    "linkPolicyPreviewEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkPolicyPreview"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "linkPolicyPreviewLink": {
+      "type": "object",
+      "required": [
+        "sourceRouter",
+        "destRouter",
+        "linked"
+      ],
+      "properties": {
+        "destRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "linked": {
+          "description": "Set if the routers are connected and already have a usable link between them",
+          "type": "boolean"
+        },
+        "policies": {
+          "description": "The allow policies which produce the link. Empty if no link policies were evaluated.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "linkPolicyPreviewRequest": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkPolicyCreate"
+          }
+        }
+      }
+    },
+    "linkPolicyUpdate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
+        },
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
     "links": {
       "description": "A map of named links",
       "type": "object",
@@ -1501,6 +2103,21 @@ func init() {
         }
       }
     },
+    "listLinkPoliciesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkPolicyList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listLinksEnvelope": {
       "type": "object",
       "required": [
@@ -1582,32 +2199,235 @@ func init() {
         }
       }
     },
-    "pagination": {
+    "offlineRouterTerminator": {
       "type": "object",
       "required": [
-        "limit",
-        "offset",
-        "totalCount"
+        "terminator",
+        "service",
+        "router",
+        "offlineSince"
       ],
       "properties": {
-        "limit": {
-          "type": "number",
-          "format": "int64"
+        "offlineSince": {
+          "type": "string",
+          "format": "date-time"
         },
-        "offset": {
-          "type": "number",
-          "format": "int64"
+        "router": {
+          "$ref": "#/definitions/entityRef"
         },
-        "totalCount": {
-          "type": "number",
-          "format": "int64"
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "terminator": {
+          "$ref": "#/definitions/entityRef"
         }
       }
     },
-    "routerCreate": {
+    "offlineRouterTerminatorReport": {
       "type": "object",
       "required": [
-        "id",
+        "enabled",
+        "action",
+        "threshold",
+        "terminators"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "fail"
+          ]
+        },
+        "enabled": {
+          "description": "Set if offline terminator cleanup is enabled",
+          "type": "boolean"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/offlineRouterTerminator"
+          }
+        },
+        "threshold": {
+          "description": "How long a router must be offline before its terminators are cleaned up, in milliseconds",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "offlineRouterTerminatorReportEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/offlineRouterTerminatorReport"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "pagination": {
+      "type": "object",
+      "required": [
+        "limit",
+        "offset",
+        "totalCount"
+      ],
+      "properties": {
+        "limit": {
+          "type": "number",
+          "format": "int64"
+        },
+        "offset": {
+          "type": "number",
+          "format": "int64"
+        },
+        "totalCount": {
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
+    "routePreview": {
+      "type": "object",
+      "required": [
+        "service",
+        "sourceRouter",
+        "strategy",
+        "candidates"
+      ],
+      "properties": {
+        "candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routePreviewCandidate"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "path": {
+          "$ref": "#/definitions/routePreviewPath"
+        },
+        "selectedTerminator": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "strategy": {
+          "type": "string"
+        }
+      }
+    },
+    "routePreviewCandidate": {
+      "type": "object",
+      "required": [
+        "terminator",
+        "router",
+        "instanceId",
+        "precedence",
+        "staticCost",
+        "dynamicCost",
+        "selected"
+      ],
+      "properties": {
+        "dynamicCost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "pathCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "routeCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "router": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "selected": {
+          "type": "boolean"
+        },
+        "skipDetail": {
+          "type": "string"
+        },
+        "skipReason": {
+          "type": "string",
+          "enum": [
+            "INSTANCE_MISMATCH",
+            "ROUTER_OFFLINE",
+            "NO_PATH",
+            "DRAINING"
+          ]
+        },
+        "staticCost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "terminator": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "routePreviewEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routePreview"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "routePreviewPath": {
+      "type": "object",
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        }
+      }
+    },
+    "routerCreate": {
+      "type": "object",
+      "required": [
+        "id",
         "name",
         "cost",
         "noTraversal"
@@ -1617,6 +2437,9 @@ func init() {
           "type": "integer",
           "maximum": 65535
         },
+        "draining": {
+          "type": "boolean"
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -1630,6 +2453,12 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -1658,9 +2487,23 @@ func init() {
               "type": "integer",
               "maximum": 65535
             },
+            "drainRemainingCircuits": {
+              "description": "The number of circuits still using the router, only reported while the router is draining",
+              "type": "integer",
+              "x-nullable": true
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "fingerprint": {
               "type": "string"
             },
+            "lastDisconnected": {
+              "description": "When the router last disconnected. Not set while the router is connected",
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
             "listenerAddresses": {
               "type": "array",
               "items": {
@@ -1673,6 +2516,12 @@ func init() {
             "noTraversal": {
               "type": "boolean"
             },
+            "roleAttributes": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
             "versionInfo": {
               "$ref": "#/definitions/versionInfo"
             }
@@ -1709,6 +2558,10 @@ func init() {
           "maximum": 65535,
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -1720,6 +2573,12 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -1738,6 +2597,9 @@ func init() {
           "type": "integer",
           "maximum": 65535
         },
+        "draining": {
+          "type": "boolean"
+        },
         "fingerprint": {
           "type": "string"
         },
@@ -1747,6 +2609,12 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -1758,14 +2626,59 @@ func init() {
         "name"
       ],
       "properties": {
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -1782,11 +2695,56 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "allowedRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "circuitRateBurst": {
+              "type": "integer"
+            },
+            "circuitRateLimit": {
+              "type": "integer"
+            },
+            "deniedRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "maxCircuits": {
+              "type": "integer"
+            },
+            "maxCircuitsPerClient": {
+              "type": "integer"
+            },
+            "maxLinkCount": {
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
+            "pinnedPath": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "pinnedPathFallback": {
+              "type": "boolean"
+            },
             "terminatorStrategy": {
               "type": "string"
+            },
+            "trafficSplit": {
+              "$ref": "#/definitions/trafficSplit"
+            },
+            "waypointRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
         }
@@ -1801,14 +2759,59 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
-        "name": {
-          "type": "string"
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -1818,14 +2821,59 @@ func init() {
         "name"
       ],
       "properties": {
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -1872,6 +2920,9 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -1915,7 +2966,8 @@ func init() {
             "cost",
             "precedence",
             "dynamicCost",
-            "hostId"
+            "hostId",
+            "draining"
           ],
           "properties": {
             "address": {
@@ -1927,15 +2979,39 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "healthCheck": {
+              "$ref": "#/definitions/terminatorHealthCheck"
+            },
+            "healthStatus": {
+              "$ref": "#/definitions/terminatorHealthStatus"
+            },
             "hostId": {
               "type": "string"
             },
             "instanceId": {
               "type": "string"
             },
+            "leaseExpiresAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "leaseTtl": {
+              "description": "Lease time to live in milliseconds. Leased terminators are deleted unless their router renews the lease in time",
+              "type": "integer",
+              "format": "int64"
+            },
             "precedence": {
               "$ref": "#/definitions/terminatorPrecedence"
             },
@@ -1955,6 +3031,60 @@ func init() {
         }
       ]
     },
+    "terminatorHealthCheck": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address to check. tcp and binding checks default to the terminator address. Required for http checks",
+          "type": "string"
+        },
+        "expectedStatus": {
+          "description": "The expected http status code for http checks. Defaults to 200",
+          "type": "integer"
+        },
+        "interval": {
+          "description": "Time between checks, in milliseconds. Defaults to 30 seconds",
+          "type": "integer"
+        },
+        "timeout": {
+          "description": "Time to wait for a check to complete, in milliseconds. Defaults to 5 seconds",
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "tcp",
+            "http",
+            "binding"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "terminatorHealthStatus": {
+      "type": "object",
+      "required": [
+        "healthy",
+        "checkedAt"
+      ],
+      "properties": {
+        "checkedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "healthy": {
+          "type": "boolean"
+        },
+        "message": {
+          "type": "string"
+        }
+      },
+      "x-nullable": true,
+      "readOnly": true
+    },
     "terminatorList": {
       "type": "array",
       "items": {
@@ -1973,6 +3103,17 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeout": {
+          "description": "Optional time in milliseconds after which circuits still using a draining terminator are closed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -2022,6 +3163,17 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeout": {
+          "description": "Optional time in milliseconds after which circuits still using a draining terminator are closed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -2039,6 +3191,48 @@ func init() {
         }
       }
     },
+    "trafficSplit": {
+      "description": "Divides circuits between weighted groups of terminators. Used by the split terminator strategy",
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/trafficSplitGroup"
+          }
+        },
+        "stickyClients": {
+          "description": "If true, circuits from a given client are consistently sent to the same group",
+          "type": "boolean"
+        }
+      },
+      "x-nullable": true
+    },
+    "trafficSplitGroup": {
+      "type": "object",
+      "required": [
+        "name",
+        "weight"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "terminatorTags": {
+          "description": "Terminators with all of these tags belong to the group. Terminators belong to the first matching group",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "weight": {
+          "type": "integer"
+        }
+      }
+    },
     "versionInfo": {
       "description": "Application build information",
       "type": "object",
@@ -2178,6 +3372,12 @@ func init() {
         "$ref": "#/definitions/detailLinkEnvelope"
       }
     },
+    "detailLinkPolicy": {
+      "description": "A single link policy",
+      "schema": {
+        "$ref": "#/definitions/detailLinkPolicyEnvelope"
+      }
+    },
     "detailRouter": {
       "description": "A single router",
       "schema": {
@@ -2232,12 +3432,24 @@ func init() {
         }
       }
     },
+    "linkPolicyPreview": {
+      "description": "The links produced by a set of link policies",
+      "schema": {
+        "$ref": "#/definitions/linkPolicyPreviewEnvelope"
+      }
+    },
     "listCircuits": {
       "description": "A list of circuits",
       "schema": {
         "$ref": "#/definitions/listCircuitsEnvelope"
       }
     },
+    "listLinkPolicies": {
+      "description": "A list of link policies",
+      "schema": {
+        "$ref": "#/definitions/listLinkPoliciesEnvelope"
+      }
+    },
     "listLinks": {
       "description": "A list of links",
       "schema": {
@@ -2288,6 +3500,12 @@ func init() {
         }
       }
     },
+    "offlineRouterTerminatorReport": {
+      "description": "The terminators of routers which have been offline past the cleanup threshold",
+      "schema": {
+        "$ref": "#/definitions/offlineRouterTerminatorReportEnvelope"
+      }
+    },
     "patchResponse": {
       "description": "The patch request was successful and the resource has been altered",
       "schema": {
@@ -2317,6 +3535,12 @@ func init() {
         }
       }
     },
+    "routePreview": {
+      "description": "A preview of terminator and path selection for a service",
+      "schema": {
+        "$ref": "#/definitions/routePreviewEnvelope"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
@@ -2599,24 +3823,67 @@ func init() {
         }
       ]
     },
-    "/database": {
+    "/circuits/{id}/reroute": {
       "post": {
-        "security": [
+        "description": "Reroutes the circuit onto a path visiting the given routers in order. The circuit's initiating and terminating\nrouters may be omitted from the path. If pin is set, the circuit is exempt from smart rerouting until it's\nmoved again. An empty path with pin unset unpins the circuit, leaving it on its current path. Requires admin\naccess.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Move a circuit onto an explicit path",
+        "operationId": "rerouteCircuit",
+        "parameters": [
           {
-            "ztSession": []
+            "description": "The path to move the circuit to",
+            "name": "reroute",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitReroute"
+            }
           }
         ],
-        "description": "Create a new database snapshot. Requires admin access.",
-        "tags": [
-          "Database"
-        ],
-        "summary": "Create a new database snapshot",
-        "operationId": "createDatabaseSnapshot",
         "responses": {
           "200": {
-            "description": "Base empty response",
+            "description": "A single circuit",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/detailCircuitEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "401": {
@@ -2643,8 +3910,8 @@ func init() {
               }
             }
           },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -2652,11 +3919,14 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {}
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
                   },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -2667,21 +3937,100 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/database/check-data-integrity": {
+    "/database": {
       "post": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
+        "description": "Create a new database snapshot. Requires admin access.",
         "tags": [
           "Database"
         ],
-        "summary": "Starts a data integrity scan on the datastore",
-        "operationId": "checkDataIntegrity",
+        "summary": "Create a new database snapshot",
+        "operationId": "createDatabaseSnapshot",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/check-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Starts a data integrity scan on the datastore. Requires admin access. Only once instance may run at a time, including runs of fixDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Starts a data integrity scan on the datastore",
+        "operationId": "checkDataIntegrity",
         "responses": {
           "202": {
             "description": "Base empty response",
@@ -2909,19 +4258,36 @@ func init() {
         }
       }
     },
-    "/links": {
+    "/link-policies": {
       "get": {
-        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "description": "Retrieves a list of link policy resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Link"
+          "LinkPolicy"
+        ],
+        "summary": "List link policies",
+        "operationId": "listLinkPolicies",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
         ],
-        "summary": "List links",
-        "operationId": "listLinks",
         "responses": {
           "200": {
-            "description": "A list of links",
+            "description": "A list of link policies",
             "schema": {
-              "$ref": "#/definitions/listLinksEnvelope"
+              "$ref": "#/definitions/listLinkPoliciesEnvelope"
             }
           },
           "401": {
@@ -2949,25 +4315,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/links/{id}": {
-      "get": {
-        "description": "Retrieves a single link by id. Requires admin access.",
+      },
+      "post": {
+        "description": "Create a link policy resource. Requires admin access.",
         "tags": [
-          "Link"
+          "LinkPolicy"
+        ],
+        "summary": "Create a link policy resource",
+        "operationId": "createLinkPolicy",
+        "parameters": [
+          {
+            "description": "A link policy to create",
+            "name": "linkPolicy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPolicyCreate"
+            }
+          }
         ],
-        "summary": "Retrieves a single link",
-        "operationId": "detailLink",
         "responses": {
-          "200": {
-            "description": "A single link",
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
             "schema": {
-              "$ref": "#/definitions/detailLinkEnvelope"
+              "$ref": "#/definitions/createEnvelope"
             }
           },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -2977,11 +4352,24 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -2990,8 +4378,8 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -2999,15 +4387,13 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
+                    "urlVars": {}
                   },
-                  "cause": null,
+                  "cause": "",
                   "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -3017,19 +4403,31 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Delete a link by id. Requires admin access.",
+      }
+    },
+    "/link-policies/preview": {
+      "post": {
+        "description": "Evaluates a set of link policies against all routers and returns the links the controller would dial. If no\npolicies are given, the stored link policies are evaluated. An empty list of policies previews the default\nbehavior, where all routers are linked. Router listeners are only known for connected routers, so they aren't\ntaken into account. Requires admin access.\n",
         "tags": [
-          "Link"
+          "LinkPolicy"
+        ],
+        "summary": "Preview the links produced by a set of link policies",
+        "operationId": "previewLinkPolicies",
+        "parameters": [
+          {
+            "description": "The link policies to evaluate",
+            "name": "preview",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/linkPolicyPreviewRequest"
+            }
+          }
         ],
-        "summary": "Delete a link",
-        "operationId": "deleteLink",
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "The links produced by a set of link policies",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/linkPolicyPreviewEnvelope"
             }
           },
           "400": {
@@ -3094,28 +4492,96 @@ func init() {
             }
           }
         }
+      }
+    },
+    "/link-policies/{id}": {
+      "get": {
+        "description": "Retrieves a single link policy by id. Requires admin access.",
+        "tags": [
+          "LinkPolicy"
+        ],
+        "summary": "Retrieves a single link policy",
+        "operationId": "detailLinkPolicy",
+        "responses": {
+          "200": {
+            "description": "A single link policy",
+            "schema": {
+              "$ref": "#/definitions/detailLinkPolicyEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
       },
-      "patch": {
-        "description": "Update the supplied fields on a link. Requires admin access.",
+      "put": {
+        "description": "Update all fields on a link policy by id. Requires admin access.",
         "tags": [
-          "Link"
+          "LinkPolicy"
         ],
-        "summary": "Update the supplied fields on a link",
-        "operationId": "patchLink",
+        "summary": "Update all fields on a link policy",
+        "operationId": "updateLinkPolicy",
         "parameters": [
           {
-            "description": "A link patch object",
-            "name": "link",
+            "description": "A link policy update object",
+            "name": "linkPolicy",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/linkPatch"
+              "$ref": "#/definitions/linkPolicyUpdate"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "The update request was successful and the resource has been altered",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -3209,46 +4675,55 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/routers": {
-      "get": {
-        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+      "delete": {
+        "description": "Delete a link policy by id. Requires admin access.",
         "tags": [
-          "Router"
-        ],
-        "summary": "List routers",
-        "operationId": "listRouters",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
+          "LinkPolicy"
         ],
+        "summary": "Delete a link policy",
+        "operationId": "deleteLinkPolicy",
         "responses": {
           "200": {
-            "description": "A list of routers",
+            "description": "The delete request was successful and the resource has been removed",
             "schema": {
-              "$ref": "#/definitions/listRoutersEnvelope"
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "401": {
@@ -3277,29 +4752,29 @@ func init() {
           }
         }
       },
-      "post": {
-        "description": "Create a router resource. Requires admin access.",
+      "patch": {
+        "description": "Update the supplied fields on a link policy. Requires admin access.",
         "tags": [
-          "Router"
+          "LinkPolicy"
         ],
-        "summary": "Create a router resource",
-        "operationId": "createRouter",
+        "summary": "Update the supplied fields on a link policy",
+        "operationId": "patchLinkPolicy",
         "parameters": [
           {
-            "description": "A router to create",
-            "name": "router",
+            "description": "A link policy patch object",
+            "name": "linkPolicy",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/routerCreate"
+              "$ref": "#/definitions/linkPolicyPatch"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "The create request was successful and the resource has been added at the following location",
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
             "schema": {
-              "$ref": "#/definitions/createEnvelope"
+              "$ref": "#/definitions/empty"
             }
           },
           "400": {
@@ -3339,48 +4814,6 @@ func init() {
               }
             }
           },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
-        }
-      }
-    },
-    "/routers/{id}": {
-      "get": {
-        "description": "Retrieves a single router by id. Requires admin access.",
-        "tags": [
-          "Router"
-        ],
-        "summary": "Retrieves a single router",
-        "operationId": "detailRouter",
-        "responses": {
-          "200": {
-            "description": "A single router",
-            "schema": {
-              "$ref": "#/definitions/detailRouterEnvelope"
-            }
-          },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
@@ -3433,33 +4866,33 @@ func init() {
           }
         }
       },
-      "put": {
-        "description": "Update all fields on a router by id. Requires admin access.",
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/links": {
+      "get": {
+        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
         "tags": [
-          "Router"
-        ],
-        "summary": "Update all fields on a router",
-        "operationId": "updateRouter",
-        "parameters": [
-          {
-            "description": "A router update object",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerUpdate"
-            }
-          }
+          "Link"
         ],
+        "summary": "List links",
+        "operationId": "listLinks",
         "responses": {
           "200": {
-            "description": "The update request was successful and the resource has been altered",
+            "description": "A list of links",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/listLinksEnvelope"
             }
           },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -3469,24 +4902,11 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -3494,6 +4914,24 @@ func init() {
                 }
               }
             }
+          }
+        }
+      }
+    },
+    "/links/{id}": {
+      "get": {
+        "description": "Retrieves a single link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Retrieves a single link",
+        "operationId": "detailLink",
+        "responses": {
+          "200": {
+            "description": "A single link",
+            "schema": {
+              "$ref": "#/definitions/detailLinkEnvelope"
+            }
           },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
@@ -3548,12 +4986,12 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete a router by id. Requires admin access.",
+        "description": "Delete a link by id. Requires admin access.",
         "tags": [
-          "Router"
+          "Link"
         ],
-        "summary": "Delete a router",
-        "operationId": "deleteRouter",
+        "summary": "Delete a link",
+        "operationId": "deleteLink",
         "responses": {
           "200": {
             "description": "The delete request was successful and the resource has been removed",
@@ -3621,49 +5059,24 @@ func init() {
                 }
               }
             }
-          },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
       },
       "patch": {
-        "description": "Update the supplied fields on a router. Requires admin access.",
+        "description": "Update the supplied fields on a link. Requires admin access.",
         "tags": [
-          "Router"
+          "Link"
         ],
-        "summary": "Update the supplied fields on a router",
-        "operationId": "patchRouter",
+        "summary": "Update the supplied fields on a link",
+        "operationId": "patchLink",
         "parameters": [
           {
-            "description": "A router patch object",
-            "name": "router",
+            "description": "A link patch object",
+            "name": "link",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/routerPatch"
+              "$ref": "#/definitions/linkPatch"
             }
           }
         ],
@@ -3773,119 +5186,14 @@ func init() {
         }
       ]
     },
-    "/routers/{id}/terminators": {
+    "/routers": {
       "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
+        "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
           "Router"
         ],
-        "summary": "List of terminators assigned to a router",
-        "operationId": "listRouterTerminators",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "filter",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A list of terminators",
-            "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "The currently supplied session does not have the correct access rights to request this resource",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": "",
-                  "causeMessage": "",
-                  "code": "UNAUTHORIZED",
-                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
-                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          }
-        }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/services": {
-      "get": {
-        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
-        "tags": [
-          "Service"
-        ],
-        "summary": "List services",
-        "operationId": "listServices",
+        "summary": "List routers",
+        "operationId": "listRouters",
         "parameters": [
           {
             "type": "integer",
@@ -3905,9 +5213,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "A list of services",
+            "description": "A list of routers",
             "schema": {
-              "$ref": "#/definitions/listServicesEnvelope"
+              "$ref": "#/definitions/listRoutersEnvelope"
             }
           },
           "401": {
@@ -3937,20 +5245,20 @@ func init() {
         }
       },
       "post": {
-        "description": "Create a service resource. Requires admin access.",
+        "description": "Create a router resource. Requires admin access.",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "Create a service resource",
-        "operationId": "createService",
+        "summary": "Create a router resource",
+        "operationId": "createRouter",
         "parameters": [
           {
-            "description": "A service to create",
-            "name": "service",
+            "description": "A router to create",
+            "name": "router",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceCreate"
+              "$ref": "#/definitions/routerCreate"
             }
           }
         ],
@@ -4025,19 +5333,19 @@ func init() {
         }
       }
     },
-    "/services/{id}": {
+    "/routers/{id}": {
       "get": {
-        "description": "Retrieves a single service by id. Requires admin access.",
+        "description": "Retrieves a single router by id. Requires admin access.",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "Retrieves a single service",
-        "operationId": "detailService",
+        "summary": "Retrieves a single router",
+        "operationId": "detailRouter",
         "responses": {
           "200": {
-            "description": "A single service",
+            "description": "A single router",
             "schema": {
-              "$ref": "#/definitions/detailServiceEnvelope"
+              "$ref": "#/definitions/detailRouterEnvelope"
             }
           },
           "401": {
@@ -4093,20 +5401,20 @@ func init() {
         }
       },
       "put": {
-        "description": "Update all fields on a service by id. Requires admin access.",
+        "description": "Update all fields on a router by id. Requires admin access.",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "Update all fields on a service",
-        "operationId": "updateService",
+        "summary": "Update all fields on a router",
+        "operationId": "updateRouter",
         "parameters": [
           {
-            "description": "A service update object",
-            "name": "service",
+            "description": "A router update object",
+            "name": "router",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceUpdate"
+              "$ref": "#/definitions/routerUpdate"
             }
           }
         ],
@@ -4207,12 +5515,12 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete a service by id. Requires admin access.",
+        "description": "Delete a router by id. Requires admin access.",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "Delete a service",
-        "operationId": "deleteService",
+        "summary": "Delete a router",
+        "operationId": "deleteRouter",
         "responses": {
           "200": {
             "description": "The delete request was successful and the resource has been removed",
@@ -4309,20 +5617,20 @@ func init() {
         }
       },
       "patch": {
-        "description": "Update the supplied fields on a service. Requires admin access.",
+        "description": "Update the supplied fields on a router. Requires admin access.",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "Update the supplied fields on a service",
-        "operationId": "patchService",
+        "summary": "Update the supplied fields on a router",
+        "operationId": "patchRouter",
         "parameters": [
           {
-            "description": "A service patch object",
-            "name": "service",
+            "description": "A router patch object",
+            "name": "router",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/servicePatch"
+              "$ref": "#/definitions/routerPatch"
             }
           }
         ],
@@ -4432,14 +5740,14 @@ func init() {
         }
       ]
     },
-    "/services/{id}/terminators": {
+    "/routers/{id}/terminators": {
       "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
+        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
         "tags": [
-          "Service"
+          "Router"
         ],
-        "summary": "List of terminators assigned to a service",
-        "operationId": "listServiceTerminators",
+        "summary": "List of terminators assigned to a router",
+        "operationId": "listRouterTerminators",
         "parameters": [
           {
             "type": "integer",
@@ -4537,14 +5845,14 @@ func init() {
         }
       ]
     },
-    "/terminators": {
+    "/services": {
       "get": {
-        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "List terminators",
-        "operationId": "listTerminators",
+        "summary": "List services",
+        "operationId": "listServices",
         "parameters": [
           {
             "type": "integer",
@@ -4564,46 +5872,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "A list of terminators",
-            "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "description": "A list of services",
             "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
+              "$ref": "#/definitions/listServicesEnvelope"
             }
           },
           "401": {
@@ -4633,20 +5904,20 @@ func init() {
         }
       },
       "post": {
-        "description": "Create a terminator resource. Requires admin access.",
+        "description": "Create a service resource. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "Create a terminator resource",
-        "operationId": "createTerminator",
+        "summary": "Create a service resource",
+        "operationId": "createService",
         "parameters": [
           {
-            "description": "A terminator to create",
-            "name": "terminator",
+            "description": "A service to create",
+            "name": "service",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/terminatorCreate"
+              "$ref": "#/definitions/serviceCreate"
             }
           }
         ],
@@ -4721,19 +5992,19 @@ func init() {
         }
       }
     },
-    "/terminators/{id}": {
+    "/services/{id}": {
       "get": {
-        "description": "Retrieves a single terminator by id. Requires admin access.",
+        "description": "Retrieves a single service by id. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "Retrieves a single terminator",
-        "operationId": "detailTerminator",
+        "summary": "Retrieves a single service",
+        "operationId": "detailService",
         "responses": {
           "200": {
-            "description": "A single terminator",
+            "description": "A single service",
             "schema": {
-              "$ref": "#/definitions/detailTerminatorEnvelope"
+              "$ref": "#/definitions/detailServiceEnvelope"
             }
           },
           "401": {
@@ -4789,20 +6060,20 @@ func init() {
         }
       },
       "put": {
-        "description": "Update all fields on a terminator by id. Requires admin access.",
+        "description": "Update all fields on a service by id. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "Update all fields on a terminator",
-        "operationId": "updateTerminator",
+        "summary": "Update all fields on a service",
+        "operationId": "updateService",
         "parameters": [
           {
-            "description": "A terminator update object",
-            "name": "terminator",
+            "description": "A service update object",
+            "name": "service",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/terminatorUpdate"
+              "$ref": "#/definitions/serviceUpdate"
             }
           }
         ],
@@ -4903,12 +6174,12 @@ func init() {
         }
       },
       "delete": {
-        "description": "Delete a terminator by id. Requires admin access.",
+        "description": "Delete a service by id. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "Delete a terminator",
-        "operationId": "deleteTerminator",
+        "summary": "Delete a service",
+        "operationId": "deleteService",
         "responses": {
           "200": {
             "description": "The delete request was successful and the resource has been removed",
@@ -5005,20 +6276,20 @@ func init() {
         }
       },
       "patch": {
-        "description": "Update the supplied fields on a terminator. Requires admin access.",
+        "description": "Update the supplied fields on a service. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Service"
         ],
-        "summary": "Update the supplied fields on a terminator",
-        "operationId": "patchTerminator",
+        "summary": "Update the supplied fields on a service",
+        "operationId": "patchService",
         "parameters": [
           {
-            "description": "A terminator patch object",
-            "name": "terminator",
+            "description": "A service patch object",
+            "name": "service",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/terminatorPatch"
+              "$ref": "#/definitions/servicePatch"
             }
           }
         ],
@@ -5127,105 +6398,995 @@ func init() {
           "required": true
         }
       ]
-    }
-  },
-  "definitions": {
-    "CircuitDetailPath": {
-      "type": "object",
-      "properties": {
-        "links": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
-          }
-        },
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/entityRef"
-          }
-        }
-      }
-    },
-    "apiError": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "$ref": "#/definitions/apiErrorArgs"
-        },
-        "cause": {
-          "$ref": "#/definitions/apiErrorCause"
-        },
-        "causeMessage": {
-          "type": "string"
-        },
-        "code": {
-          "type": "string"
-        },
-        "data": {
-          "type": "object",
-          "additionalProperties": true
-        },
-        "message": {
-          "type": "string"
-        },
-        "requestId": {
-          "type": "string"
-        }
-      }
     },
-    "apiErrorArgs": {
-      "type": "object",
-      "properties": {
-        "urlVars": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/services/{id}/route-preview": {
+      "get": {
+        "description": "Runs terminator and path selection for the service, as would be done when creating a circuit, without\ncreating a circuit. Returns each terminator with its cost breakdown, the reason any terminators were skipped,\nthe terminator the service's strategy would pick and the path to it. Requires admin access.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Preview terminator and path selection for a service",
+        "operationId": "previewServiceRoute",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the router the circuit would originate from",
+            "name": "sourceRouter",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The instance id being dialed, if any",
+            "name": "instanceId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The client id to pass to the terminator strategy, if any",
+            "name": "clientId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A preview of terminator and path selection for a service",
+            "schema": {
+              "$ref": "#/definitions/routePreviewEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
-      }
-    },
-    "apiErrorCause": {
-      "allOf": [
-        {
-          "$ref": "#/definitions/apiFieldError"
-        },
+      },
+      "parameters": [
         {
-          "$ref": "#/definitions/apiError"
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
         }
       ]
     },
-    "apiErrorEnvelope": {
-      "type": "object",
-      "required": [
-        "meta",
-        "error"
-      ],
-      "properties": {
-        "error": {
-          "$ref": "#/definitions/apiError"
-        },
-        "meta": {
-          "$ref": "#/definitions/meta"
-        }
-      }
-    },
-    "apiFieldError": {
-      "type": "object",
-      "properties": {
-        "field": {
-          "type": "string"
-        },
-        "reason": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
-        }
-      }
-    },
-    "baseEntity": {
-      "description": "Fields shared by all Edge API entities",
+    "/services/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific service; supports filtering, sorting, and pagination.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "List of terminators assigned to a service",
+        "operationId": "listServiceTerminators",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of terminators",
+            "schema": {
+              "$ref": "#/definitions/listTerminatorsEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "List terminators",
+        "operationId": "listTerminators",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of terminators",
+            "schema": {
+              "$ref": "#/definitions/listTerminatorsEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a terminator resource. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Create a terminator resource",
+        "operationId": "createTerminator",
+        "parameters": [
+          {
+            "description": "A terminator to create",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/terminators/offline-router-report": {
+      "get": {
+        "description": "Lists the terminators which offline terminator cleanup acts on, without changing anything. Terminators are\nincluded if their router has been disconnected for longer than the configured threshold. The report is\ngenerated whether or not cleanup is enabled. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Report the terminators of routers which have been offline past the cleanup threshold",
+        "operationId": "offlineRouterTerminatorReport",
+        "responses": {
+          "200": {
+            "description": "The terminators of routers which have been offline past the cleanup threshold",
+            "schema": {
+              "$ref": "#/definitions/offlineRouterTerminatorReportEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/terminators/{id}": {
+      "get": {
+        "description": "Retrieves a single terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Retrieves a single terminator",
+        "operationId": "detailTerminator",
+        "responses": {
+          "200": {
+            "description": "A single terminator",
+            "schema": {
+              "$ref": "#/definitions/detailTerminatorEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Update all fields on a terminator",
+        "operationId": "updateTerminator",
+        "parameters": [
+          {
+            "description": "A terminator update object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Delete a terminator",
+        "operationId": "deleteTerminator",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a terminator. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Update the supplied fields on a terminator",
+        "operationId": "patchTerminator",
+        "parameters": [
+          {
+            "description": "A terminator patch object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "CircuitDetailPath": {
+      "type": "object",
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        }
+      }
+    },
+    "CircuitDetailStandbyPath": {
+      "type": "object",
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        }
+      }
+    },
+    "apiError": {
+      "type": "object",
+      "properties": {
+        "args": {
+          "$ref": "#/definitions/apiErrorArgs"
+        },
+        "cause": {
+          "$ref": "#/definitions/apiErrorCause"
+        },
+        "causeMessage": {
+          "type": "string"
+        },
+        "code": {
+          "type": "string"
+        },
+        "data": {
+          "type": "object",
+          "additionalProperties": true
+        },
+        "message": {
+          "type": "string"
+        },
+        "requestId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiErrorArgs": {
+      "type": "object",
+      "properties": {
+        "urlVars": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiErrorCause": {
+      "allOf": [
+        {
+          "$ref": "#/definitions/apiFieldError"
+        },
+        {
+          "$ref": "#/definitions/apiError"
+        }
+      ]
+    },
+    "apiErrorEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/apiError"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "apiFieldError": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "baseEntity": {
+      "description": "Fields shared by all Edge API entities",
       "type": "object",
       "required": [
         "id",
@@ -5299,9 +7460,29 @@ func init() {
             }
           }
         },
+        "pinned": {
+          "type": "boolean"
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
+        "standbyPath": {
+          "type": "object",
+          "properties": {
+            "links": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/entityRef"
+              }
+            },
+            "nodes": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/entityRef"
+              }
+            }
+          }
+        },
         "terminator": {
           "$ref": "#/definitions/entityRef"
         }
@@ -5313,6 +7494,20 @@ func init() {
         "$ref": "#/definitions/circuitDetail"
       }
     },
+    "circuitReroute": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pin": {
+          "type": "boolean"
+        }
+      }
+    },
     "createEnvelope": {
       "type": "object",
       "properties": {
@@ -5438,6 +7633,21 @@ func init() {
         }
       }
     },
+    "detailLinkPolicyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkPolicyDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailRouterEnvelope": {
       "type": "object",
       "required": [
@@ -5582,86 +7792,330 @@ func init() {
         }
       }
     },
-    "link": {
-      "description": "A link to another resource",
+    "link": {
+      "description": "A link to another resource",
+      "type": "object",
+      "required": [
+        "href"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "href": {
+          "type": "string",
+          "format": "uri"
+        },
+        "method": {
+          "type": "string"
+        }
+      }
+    },
+    "linkDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "protocol",
+        "sourceRouter",
+        "destRouter",
+        "state",
+        "staticCost",
+        "sourceLatency",
+        "destLatency",
+        "cost",
+        "down"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer"
+        },
+        "costTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "destLatency": {
+          "type": "integer"
+        },
+        "destRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "excludedByCostTags": {
+          "type": "boolean"
+        },
+        "flapPenalty": {
+          "type": "integer"
+        },
+        "flapReuseThreshold": {
+          "type": "integer"
+        },
+        "flapSuppressThreshold": {
+          "type": "integer"
+        },
+        "flapSuppressed": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "sourceLatency": {
+          "type": "integer"
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "linkList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkDetail"
+      }
+    },
+    "linkPatch": {
+      "type": "object",
+      "properties": {
+        "down": {
+          "type": "boolean"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "linkPolicyAction": {
+      "type": "string",
+      "enum": [
+        "allow",
+        "deny"
+      ]
+    },
+    "linkPolicyCreate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
+        },
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkPolicyDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "action"
+          ],
+          "properties": {
+            "action": {
+              "$ref": "#/definitions/linkPolicyAction"
+            },
+            "destinationRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "matchTags": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "name": {
+              "type": "string"
+            },
+            "sourceRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      ]
+    },
+    "linkPolicyList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkPolicyDetail"
+      }
+    },
+    "linkPolicyPatch": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
+        },
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkPolicyPreview": {
+      "type": "object",
+      "required": [
+        "links"
+      ],
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkPolicyPreviewLink"
+          }
+        }
+      }
+    },
+    "linkPolicyPreviewEnvelope": {
       "type": "object",
       "required": [
-        "href"
+        "meta",
+        "data"
       ],
       "properties": {
-        "comment": {
-          "type": "string"
-        },
-        "href": {
-          "type": "string",
-          "format": "uri"
+        "data": {
+          "$ref": "#/definitions/linkPolicyPreview"
         },
-        "method": {
-          "type": "string"
+        "meta": {
+          "$ref": "#/definitions/meta"
         }
       }
     },
-    "linkDetail": {
+    "linkPolicyPreviewLink": {
       "type": "object",
       "required": [
-        "id",
-        "protocol",
         "sourceRouter",
         "destRouter",
-        "state",
-        "staticCost",
-        "sourceLatency",
-        "destLatency",
-        "cost",
-        "down"
+        "linked"
       ],
       "properties": {
-        "cost": {
-          "type": "integer"
-        },
-        "destLatency": {
-          "type": "integer"
-        },
         "destRouter": {
           "$ref": "#/definitions/entityRef"
         },
-        "down": {
+        "linked": {
+          "description": "Set if the routers are connected and already have a usable link between them",
           "type": "boolean"
         },
-        "id": {
-          "type": "string"
-        },
-        "protocol": {
-          "type": "string"
-        },
-        "sourceLatency": {
-          "type": "integer"
+        "policies": {
+          "description": "The allow policies which produce the link. Empty if no link policies were evaluated.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
         },
         "sourceRouter": {
           "$ref": "#/definitions/entityRef"
-        },
-        "state": {
-          "type": "string"
-        },
-        "staticCost": {
-          "type": "integer"
         }
       }
     },
-    "linkList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/linkDetail"
+    "linkPolicyPreviewRequest": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkPolicyCreate"
+          }
+        }
       }
     },
-    "linkPatch": {
+    "linkPolicyUpdate": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "down": {
-          "type": "boolean"
+        "action": {
+          "$ref": "#/definitions/linkPolicyAction"
         },
-        "staticCost": {
-          "type": "integer"
+        "destinationRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matchTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "sourceRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
@@ -5688,6 +8142,21 @@ func init() {
         }
       }
     },
+    "listLinkPoliciesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkPolicyList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listLinksEnvelope": {
       "type": "object",
       "required": [
@@ -5769,6 +8238,78 @@ func init() {
         }
       }
     },
+    "offlineRouterTerminator": {
+      "type": "object",
+      "required": [
+        "terminator",
+        "service",
+        "router",
+        "offlineSince"
+      ],
+      "properties": {
+        "offlineSince": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "router": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "terminator": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "offlineRouterTerminatorReport": {
+      "type": "object",
+      "required": [
+        "enabled",
+        "action",
+        "threshold",
+        "terminators"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "delete",
+            "fail"
+          ]
+        },
+        "enabled": {
+          "description": "Set if offline terminator cleanup is enabled",
+          "type": "boolean"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/offlineRouterTerminator"
+          }
+        },
+        "threshold": {
+          "description": "How long a router must be offline before its terminators are cleaned up, in milliseconds",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "offlineRouterTerminatorReportEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/offlineRouterTerminatorReport"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "pagination": {
       "type": "object",
       "required": [
@@ -5791,6 +8332,137 @@ func init() {
         }
       }
     },
+    "routePreview": {
+      "type": "object",
+      "required": [
+        "service",
+        "sourceRouter",
+        "strategy",
+        "candidates"
+      ],
+      "properties": {
+        "candidates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routePreviewCandidate"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "path": {
+          "$ref": "#/definitions/routePreviewPath"
+        },
+        "selectedTerminator": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "service": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "sourceRouter": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "strategy": {
+          "type": "string"
+        }
+      }
+    },
+    "routePreviewCandidate": {
+      "type": "object",
+      "required": [
+        "terminator",
+        "router",
+        "instanceId",
+        "precedence",
+        "staticCost",
+        "dynamicCost",
+        "selected"
+      ],
+      "properties": {
+        "dynamicCost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "pathCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "routeCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "router": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "selected": {
+          "type": "boolean"
+        },
+        "skipDetail": {
+          "type": "string"
+        },
+        "skipReason": {
+          "type": "string",
+          "enum": [
+            "INSTANCE_MISMATCH",
+            "ROUTER_OFFLINE",
+            "NO_PATH",
+            "DRAINING"
+          ]
+        },
+        "staticCost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "terminator": {
+          "$ref": "#/definitions/entityRef"
+        }
+      }
+    },
+    "routePreviewEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routePreview"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "routePreviewPath": {
+      "type": "object",
+      "properties": {
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityRef"
+          }
+        }
+      }
+    },
     "routerCreate": {
       "type": "object",
       "required": [
@@ -5805,6 +8477,9 @@ func init() {
           "maximum": 65535,
           "minimum": 0
         },
+        "draining": {
+          "type": "boolean"
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -5818,6 +8493,12 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -5847,9 +8528,23 @@ func init() {
               "maximum": 65535,
               "minimum": 0
             },
+            "drainRemainingCircuits": {
+              "description": "The number of circuits still using the router, only reported while the router is draining",
+              "type": "integer",
+              "x-nullable": true
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "fingerprint": {
               "type": "string"
             },
+            "lastDisconnected": {
+              "description": "When the router last disconnected. Not set while the router is connected",
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
             "listenerAddresses": {
               "type": "array",
               "items": {
@@ -5862,6 +8557,12 @@ func init() {
             "noTraversal": {
               "type": "boolean"
             },
+            "roleAttributes": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
             "versionInfo": {
               "$ref": "#/definitions/versionInfo"
             }
@@ -5899,6 +8600,10 @@ func init() {
           "minimum": 0,
           "x-nullable": true
         },
+        "draining": {
+          "type": "boolean",
+          "x-nullable": true
+        },
         "fingerprint": {
           "type": "string",
           "x-nullable": true
@@ -5910,6 +8615,12 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -5929,6 +8640,9 @@ func init() {
           "maximum": 65535,
           "minimum": 0
         },
+        "draining": {
+          "type": "boolean"
+        },
         "fingerprint": {
           "type": "string"
         },
@@ -5938,6 +8652,12 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "roleAttributes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         }
@@ -5949,14 +8669,59 @@ func init() {
         "name"
       ],
       "properties": {
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -5973,11 +8738,56 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "allowedRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "circuitRateBurst": {
+              "type": "integer"
+            },
+            "circuitRateLimit": {
+              "type": "integer"
+            },
+            "deniedRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "maxCircuits": {
+              "type": "integer"
+            },
+            "maxCircuitsPerClient": {
+              "type": "integer"
+            },
+            "maxLinkCount": {
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
+            "pinnedPath": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            },
+            "pinnedPathFallback": {
+              "type": "boolean"
+            },
             "terminatorStrategy": {
               "type": "string"
+            },
+            "trafficSplit": {
+              "$ref": "#/definitions/trafficSplit"
+            },
+            "waypointRouters": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
         }
@@ -5992,14 +8802,59 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -6009,14 +8864,59 @@ func init() {
         "name"
       ],
       "properties": {
+        "allowedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "circuitRateBurst": {
+          "type": "integer"
+        },
+        "circuitRateLimit": {
+          "type": "integer"
+        },
+        "deniedRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "maxCircuits": {
+          "type": "integer"
+        },
+        "maxCircuitsPerClient": {
+          "type": "integer"
+        },
+        "maxLinkCount": {
+          "type": "integer"
+        },
         "name": {
           "type": "string"
         },
+        "pinnedPath": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "pinnedPathFallback": {
+          "type": "boolean"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
         "terminatorStrategy": {
           "type": "string"
+        },
+        "trafficSplit": {
+          "$ref": "#/definitions/trafficSplit"
+        },
+        "waypointRouters": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -6064,6 +8964,9 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -6107,7 +9010,8 @@ func init() {
             "cost",
             "precedence",
             "dynamicCost",
-            "hostId"
+            "hostId",
+            "draining"
           ],
           "properties": {
             "address": {
@@ -6119,15 +9023,39 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "draining": {
+              "type": "boolean"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "healthCheck": {
+              "$ref": "#/definitions/terminatorHealthCheck"
+            },
+            "healthStatus": {
+              "$ref": "#/definitions/terminatorHealthStatus"
+            },
             "hostId": {
               "type": "string"
             },
             "instanceId": {
               "type": "string"
             },
+            "leaseExpiresAt": {
+              "type": "string",
+              "format": "date-time",
+              "x-nullable": true
+            },
+            "leaseTtl": {
+              "description": "Lease time to live in milliseconds. Leased terminators are deleted unless their router renews the lease in time",
+              "type": "integer",
+              "format": "int64"
+            },
             "precedence": {
               "$ref": "#/definitions/terminatorPrecedence"
             },
@@ -6147,6 +9075,60 @@ func init() {
         }
       ]
     },
+    "terminatorHealthCheck": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "address": {
+          "description": "The address to check. tcp and binding checks default to the terminator address. Required for http checks",
+          "type": "string"
+        },
+        "expectedStatus": {
+          "description": "The expected http status code for http checks. Defaults to 200",
+          "type": "integer"
+        },
+        "interval": {
+          "description": "Time between checks, in milliseconds. Defaults to 30 seconds",
+          "type": "integer"
+        },
+        "timeout": {
+          "description": "Time to wait for a check to complete, in milliseconds. Defaults to 5 seconds",
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "tcp",
+            "http",
+            "binding"
+          ]
+        }
+      },
+      "x-nullable": true
+    },
+    "terminatorHealthStatus": {
+      "type": "object",
+      "required": [
+        "healthy",
+        "checkedAt"
+      ],
+      "properties": {
+        "checkedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "healthy": {
+          "type": "boolean"
+        },
+        "message": {
+          "type": "string"
+        }
+      },
+      "x-nullable": true,
+      "readOnly": true
+    },
     "terminatorList": {
       "type": "array",
       "items": {
@@ -6165,6 +9147,18 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeout": {
+          "description": "Optional time in milliseconds after which circuits still using a draining terminator are closed",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -6214,6 +9208,18 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeout": {
+          "description": "Optional time in milliseconds after which circuits still using a draining terminator are closed",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "draining": {
+          "type": "boolean"
+        },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -6231,6 +9237,49 @@ func init() {
         }
       }
     },
+    "trafficSplit": {
+      "description": "Divides circuits between weighted groups of terminators. Used by the split terminator strategy",
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/trafficSplitGroup"
+          }
+        },
+        "stickyClients": {
+          "description": "If true, circuits from a given client are consistently sent to the same group",
+          "type": "boolean"
+        }
+      },
+      "x-nullable": true
+    },
+    "trafficSplitGroup": {
+      "type": "object",
+      "required": [
+        "name",
+        "weight"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "terminatorTags": {
+          "description": "Terminators with all of these tags belong to the group. Terminators belong to the first matching group",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "weight": {
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
     "versionInfo": {
       "description": "Application build information",
       "type": "object",
@@ -6370,6 +9419,12 @@ func init() {
         "$ref": "#/definitions/detailLinkEnvelope"
       }
     },
+    "detailLinkPolicy": {
+      "description": "A single link policy",
+      "schema": {
+        "$ref": "#/definitions/detailLinkPolicyEnvelope"
+      }
+    },
     "detailRouter": {
       "description": "A single router",
       "schema": {
@@ -6424,12 +9479,24 @@ func init() {
         }
       }
     },
+    "linkPolicyPreview": {
+      "description": "The links produced by a set of link policies",
+      "schema": {
+        "$ref": "#/definitions/linkPolicyPreviewEnvelope"
+      }
+    },
     "listCircuits": {
       "description": "A list of circuits",
       "schema": {
         "$ref": "#/definitions/listCircuitsEnvelope"
       }
     },
+    "listLinkPolicies": {
+      "description": "A list of link policies",
+      "schema": {
+        "$ref": "#/definitions/listLinkPoliciesEnvelope"
+      }
+    },
     "listLinks": {
       "description": "A list of links",
       "schema": {
@@ -6480,6 +9547,12 @@ func init() {
         }
       }
     },
+    "offlineRouterTerminatorReport": {
+      "description": "The terminators of routers which have been offline past the cleanup threshold",
+      "schema": {
+        "$ref": "#/definitions/offlineRouterTerminatorReportEnvelope"
+      }
+    },
     "patchResponse": {
       "description": "The patch request was successful and the resource has been altered",
       "schema": {
@@ -6509,6 +9582,12 @@ func init() {
         }
       }
     },
+    "routePreview": {
+      "description": "A preview of terminator and path selection for a service",
+      "schema": {
+        "$ref": "#/definitions/routePreviewEnvelope"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
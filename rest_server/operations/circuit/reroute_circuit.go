@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package circuit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RerouteCircuitHandlerFunc turns a function with the right signature into a reroute circuit handler
+type RerouteCircuitHandlerFunc func(RerouteCircuitParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RerouteCircuitHandlerFunc) Handle(params RerouteCircuitParams) middleware.Responder {
+	return fn(params)
+}
+
+// RerouteCircuitHandler interface for that can handle valid reroute circuit params
+type RerouteCircuitHandler interface {
+	Handle(RerouteCircuitParams) middleware.Responder
+}
+
+// NewRerouteCircuit creates a new http.Handler for the reroute circuit operation
+func NewRerouteCircuit(ctx *middleware.Context, handler RerouteCircuitHandler) *RerouteCircuit {
+	return &RerouteCircuit{Context: ctx, Handler: handler}
+}
+
+/*
+	RerouteCircuit swagger:route POST /circuits/{id}/reroute Circuit rerouteCircuit
+
+# Move a circuit onto an explicit path
+
+Reroutes the circuit onto a path visiting the given routers in order. The circuit's initiating and terminating
+routers may be omitted from the path. If pin is set, the circuit is exempt from smart rerouting until it's
+moved again. An empty path with pin unset unpins the circuit, leaving it on its current path. Requires admin
+access.
+*/
+type RerouteCircuit struct {
+	Context *middleware.Context
+	Handler RerouteCircuitHandler
+}
+
+func (o *RerouteCircuit) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRerouteCircuitParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package circuit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// RerouteCircuitOKCode is the HTTP code returned for type RerouteCircuitOK
+const RerouteCircuitOKCode int = 200
+
+/*
+RerouteCircuitOK A single circuit
+
+swagger:response rerouteCircuitOK
+*/
+type RerouteCircuitOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.DetailCircuitEnvelope `json:"body,omitempty"`
+}
+
+// NewRerouteCircuitOK creates RerouteCircuitOK with default headers values
+func NewRerouteCircuitOK() *RerouteCircuitOK {
+
+	return &RerouteCircuitOK{}
+}
+
+// WithPayload adds the payload to the reroute circuit o k response
+func (o *RerouteCircuitOK) WithPayload(payload *rest_model.DetailCircuitEnvelope) *RerouteCircuitOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reroute circuit o k response
+func (o *RerouteCircuitOK) SetPayload(payload *rest_model.DetailCircuitEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RerouteCircuitOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RerouteCircuitBadRequestCode is the HTTP code returned for type RerouteCircuitBadRequest
+const RerouteCircuitBadRequestCode int = 400
+
+/*
+RerouteCircuitBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response rerouteCircuitBadRequest
+*/
+type RerouteCircuitBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewRerouteCircuitBadRequest creates RerouteCircuitBadRequest with default headers values
+func NewRerouteCircuitBadRequest() *RerouteCircuitBadRequest {
+
+	return &RerouteCircuitBadRequest{}
+}
+
+// WithPayload adds the payload to the reroute circuit bad request response
+func (o *RerouteCircuitBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *RerouteCircuitBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reroute circuit bad request response
+func (o *RerouteCircuitBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RerouteCircuitBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RerouteCircuitUnauthorizedCode is the HTTP code returned for type RerouteCircuitUnauthorized
+const RerouteCircuitUnauthorizedCode int = 401
+
+/*
+RerouteCircuitUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response rerouteCircuitUnauthorized
+*/
+type RerouteCircuitUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewRerouteCircuitUnauthorized creates RerouteCircuitUnauthorized with default headers values
+func NewRerouteCircuitUnauthorized() *RerouteCircuitUnauthorized {
+
+	return &RerouteCircuitUnauthorized{}
+}
+
+// WithPayload adds the payload to the reroute circuit unauthorized response
+func (o *RerouteCircuitUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *RerouteCircuitUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reroute circuit unauthorized response
+func (o *RerouteCircuitUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RerouteCircuitUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RerouteCircuitNotFoundCode is the HTTP code returned for type RerouteCircuitNotFound
+const RerouteCircuitNotFoundCode int = 404
+
+/*
+RerouteCircuitNotFound The requested resource does not exist
+
+swagger:response rerouteCircuitNotFound
+*/
+type RerouteCircuitNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewRerouteCircuitNotFound creates RerouteCircuitNotFound with default headers values
+func NewRerouteCircuitNotFound() *RerouteCircuitNotFound {
+
+	return &RerouteCircuitNotFound{}
+}
+
+// WithPayload adds the payload to the reroute circuit not found response
+func (o *RerouteCircuitNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *RerouteCircuitNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reroute circuit not found response
+func (o *RerouteCircuitNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RerouteCircuitNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
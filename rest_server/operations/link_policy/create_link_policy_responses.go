@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// CreateLinkPolicyCreatedCode is the HTTP code returned for type CreateLinkPolicyCreated
+const CreateLinkPolicyCreatedCode int = 201
+
+/*
+CreateLinkPolicyCreated The create request was successful and the resource has been added at the following location
+
+swagger:response createLinkPolicyCreated
+*/
+type CreateLinkPolicyCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.CreateEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkPolicyCreated creates CreateLinkPolicyCreated with default headers values
+func NewCreateLinkPolicyCreated() *CreateLinkPolicyCreated {
+
+	return &CreateLinkPolicyCreated{}
+}
+
+// WithPayload adds the payload to the create link policy created response
+func (o *CreateLinkPolicyCreated) WithPayload(payload *rest_model.CreateEnvelope) *CreateLinkPolicyCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link policy created response
+func (o *CreateLinkPolicyCreated) SetPayload(payload *rest_model.CreateEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkPolicyCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLinkPolicyBadRequestCode is the HTTP code returned for type CreateLinkPolicyBadRequest
+const CreateLinkPolicyBadRequestCode int = 400
+
+/*
+CreateLinkPolicyBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response createLinkPolicyBadRequest
+*/
+type CreateLinkPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkPolicyBadRequest creates CreateLinkPolicyBadRequest with default headers values
+func NewCreateLinkPolicyBadRequest() *CreateLinkPolicyBadRequest {
+
+	return &CreateLinkPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the create link policy bad request response
+func (o *CreateLinkPolicyBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateLinkPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link policy bad request response
+func (o *CreateLinkPolicyBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLinkPolicyUnauthorizedCode is the HTTP code returned for type CreateLinkPolicyUnauthorized
+const CreateLinkPolicyUnauthorizedCode int = 401
+
+/*
+CreateLinkPolicyUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response createLinkPolicyUnauthorized
+*/
+type CreateLinkPolicyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkPolicyUnauthorized creates CreateLinkPolicyUnauthorized with default headers values
+func NewCreateLinkPolicyUnauthorized() *CreateLinkPolicyUnauthorized {
+
+	return &CreateLinkPolicyUnauthorized{}
+}
+
+// WithPayload adds the payload to the create link policy unauthorized response
+func (o *CreateLinkPolicyUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateLinkPolicyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link policy unauthorized response
+func (o *CreateLinkPolicyUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkPolicyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// DeleteLinkPolicyOKCode is the HTTP code returned for type DeleteLinkPolicyOK
+const DeleteLinkPolicyOKCode int = 200
+
+/*
+DeleteLinkPolicyOK The delete request was successful and the resource has been removed
+
+swagger:response deleteLinkPolicyOK
+*/
+type DeleteLinkPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewDeleteLinkPolicyOK creates DeleteLinkPolicyOK with default headers values
+func NewDeleteLinkPolicyOK() *DeleteLinkPolicyOK {
+
+	return &DeleteLinkPolicyOK{}
+}
+
+// WithPayload adds the payload to the delete link policy o k response
+func (o *DeleteLinkPolicyOK) WithPayload(payload *rest_model.Empty) *DeleteLinkPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link policy o k response
+func (o *DeleteLinkPolicyOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteLinkPolicyBadRequestCode is the HTTP code returned for type DeleteLinkPolicyBadRequest
+const DeleteLinkPolicyBadRequestCode int = 400
+
+/*
+DeleteLinkPolicyBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response deleteLinkPolicyBadRequest
+*/
+type DeleteLinkPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteLinkPolicyBadRequest creates DeleteLinkPolicyBadRequest with default headers values
+func NewDeleteLinkPolicyBadRequest() *DeleteLinkPolicyBadRequest {
+
+	return &DeleteLinkPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the delete link policy bad request response
+func (o *DeleteLinkPolicyBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteLinkPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link policy bad request response
+func (o *DeleteLinkPolicyBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteLinkPolicyUnauthorizedCode is the HTTP code returned for type DeleteLinkPolicyUnauthorized
+const DeleteLinkPolicyUnauthorizedCode int = 401
+
+/*
+DeleteLinkPolicyUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response deleteLinkPolicyUnauthorized
+*/
+type DeleteLinkPolicyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteLinkPolicyUnauthorized creates DeleteLinkPolicyUnauthorized with default headers values
+func NewDeleteLinkPolicyUnauthorized() *DeleteLinkPolicyUnauthorized {
+
+	return &DeleteLinkPolicyUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete link policy unauthorized response
+func (o *DeleteLinkPolicyUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteLinkPolicyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link policy unauthorized response
+func (o *DeleteLinkPolicyUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkPolicyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// DetailLinkPolicyOKCode is the HTTP code returned for type DetailLinkPolicyOK
+const DetailLinkPolicyOKCode int = 200
+
+/*
+DetailLinkPolicyOK A single link policy
+
+swagger:response detailLinkPolicyOK
+*/
+type DetailLinkPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.DetailLinkPolicyEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkPolicyOK creates DetailLinkPolicyOK with default headers values
+func NewDetailLinkPolicyOK() *DetailLinkPolicyOK {
+
+	return &DetailLinkPolicyOK{}
+}
+
+// WithPayload adds the payload to the detail link policy o k response
+func (o *DetailLinkPolicyOK) WithPayload(payload *rest_model.DetailLinkPolicyEnvelope) *DetailLinkPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link policy o k response
+func (o *DetailLinkPolicyOK) SetPayload(payload *rest_model.DetailLinkPolicyEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DetailLinkPolicyUnauthorizedCode is the HTTP code returned for type DetailLinkPolicyUnauthorized
+const DetailLinkPolicyUnauthorizedCode int = 401
+
+/*
+DetailLinkPolicyUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response detailLinkPolicyUnauthorized
+*/
+type DetailLinkPolicyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkPolicyUnauthorized creates DetailLinkPolicyUnauthorized with default headers values
+func NewDetailLinkPolicyUnauthorized() *DetailLinkPolicyUnauthorized {
+
+	return &DetailLinkPolicyUnauthorized{}
+}
+
+// WithPayload adds the payload to the detail link policy unauthorized response
+func (o *DetailLinkPolicyUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DetailLinkPolicyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link policy unauthorized response
+func (o *DetailLinkPolicyUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkPolicyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DetailLinkPolicyNotFoundCode is the HTTP code returned for type DetailLinkPolicyNotFound
+const DetailLinkPolicyNotFoundCode int = 404
+
+/*
+DetailLinkPolicyNotFound The requested resource does not exist
+
+swagger:response detailLinkPolicyNotFound
+*/
+type DetailLinkPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkPolicyNotFound creates DetailLinkPolicyNotFound with default headers values
+func NewDetailLinkPolicyNotFound() *DetailLinkPolicyNotFound {
+
+	return &DetailLinkPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the detail link policy not found response
+func (o *DetailLinkPolicyNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *DetailLinkPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link policy not found response
+func (o *DetailLinkPolicyNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
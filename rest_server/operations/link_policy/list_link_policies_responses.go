@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// ListLinkPoliciesOKCode is the HTTP code returned for type ListLinkPoliciesOK
+const ListLinkPoliciesOKCode int = 200
+
+/*
+ListLinkPoliciesOK A list of link policies
+
+swagger:response listLinkPoliciesOK
+*/
+type ListLinkPoliciesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.ListLinkPoliciesEnvelope `json:"body,omitempty"`
+}
+
+// NewListLinkPoliciesOK creates ListLinkPoliciesOK with default headers values
+func NewListLinkPoliciesOK() *ListLinkPoliciesOK {
+
+	return &ListLinkPoliciesOK{}
+}
+
+// WithPayload adds the payload to the list link policies o k response
+func (o *ListLinkPoliciesOK) WithPayload(payload *rest_model.ListLinkPoliciesEnvelope) *ListLinkPoliciesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list link policies o k response
+func (o *ListLinkPoliciesOK) SetPayload(payload *rest_model.ListLinkPoliciesEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListLinkPoliciesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListLinkPoliciesUnauthorizedCode is the HTTP code returned for type ListLinkPoliciesUnauthorized
+const ListLinkPoliciesUnauthorizedCode int = 401
+
+/*
+ListLinkPoliciesUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response listLinkPoliciesUnauthorized
+*/
+type ListLinkPoliciesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListLinkPoliciesUnauthorized creates ListLinkPoliciesUnauthorized with default headers values
+func NewListLinkPoliciesUnauthorized() *ListLinkPoliciesUnauthorized {
+
+	return &ListLinkPoliciesUnauthorized{}
+}
+
+// WithPayload adds the payload to the list link policies unauthorized response
+func (o *ListLinkPoliciesUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *ListLinkPoliciesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list link policies unauthorized response
+func (o *ListLinkPoliciesUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListLinkPoliciesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PatchLinkPolicyOKCode is the HTTP code returned for type PatchLinkPolicyOK
+const PatchLinkPolicyOKCode int = 200
+
+/*
+PatchLinkPolicyOK The patch request was successful and the resource has been altered
+
+swagger:response patchLinkPolicyOK
+*/
+type PatchLinkPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewPatchLinkPolicyOK creates PatchLinkPolicyOK with default headers values
+func NewPatchLinkPolicyOK() *PatchLinkPolicyOK {
+
+	return &PatchLinkPolicyOK{}
+}
+
+// WithPayload adds the payload to the patch link policy o k response
+func (o *PatchLinkPolicyOK) WithPayload(payload *rest_model.Empty) *PatchLinkPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link policy o k response
+func (o *PatchLinkPolicyOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkPolicyBadRequestCode is the HTTP code returned for type PatchLinkPolicyBadRequest
+const PatchLinkPolicyBadRequestCode int = 400
+
+/*
+PatchLinkPolicyBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response patchLinkPolicyBadRequest
+*/
+type PatchLinkPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkPolicyBadRequest creates PatchLinkPolicyBadRequest with default headers values
+func NewPatchLinkPolicyBadRequest() *PatchLinkPolicyBadRequest {
+
+	return &PatchLinkPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the patch link policy bad request response
+func (o *PatchLinkPolicyBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link policy bad request response
+func (o *PatchLinkPolicyBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkPolicyUnauthorizedCode is the HTTP code returned for type PatchLinkPolicyUnauthorized
+const PatchLinkPolicyUnauthorizedCode int = 401
+
+/*
+PatchLinkPolicyUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response patchLinkPolicyUnauthorized
+*/
+type PatchLinkPolicyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkPolicyUnauthorized creates PatchLinkPolicyUnauthorized with default headers values
+func NewPatchLinkPolicyUnauthorized() *PatchLinkPolicyUnauthorized {
+
+	return &PatchLinkPolicyUnauthorized{}
+}
+
+// WithPayload adds the payload to the patch link policy unauthorized response
+func (o *PatchLinkPolicyUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkPolicyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link policy unauthorized response
+func (o *PatchLinkPolicyUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkPolicyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkPolicyNotFoundCode is the HTTP code returned for type PatchLinkPolicyNotFound
+const PatchLinkPolicyNotFoundCode int = 404
+
+/*
+PatchLinkPolicyNotFound The requested resource does not exist
+
+swagger:response patchLinkPolicyNotFound
+*/
+type PatchLinkPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkPolicyNotFound creates PatchLinkPolicyNotFound with default headers values
+func NewPatchLinkPolicyNotFound() *PatchLinkPolicyNotFound {
+
+	return &PatchLinkPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the patch link policy not found response
+func (o *PatchLinkPolicyNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link policy not found response
+func (o *PatchLinkPolicyNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PreviewLinkPoliciesHandlerFunc turns a function with the right signature into a preview link policies handler
+type PreviewLinkPoliciesHandlerFunc func(PreviewLinkPoliciesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PreviewLinkPoliciesHandlerFunc) Handle(params PreviewLinkPoliciesParams) middleware.Responder {
+	return fn(params)
+}
+
+// PreviewLinkPoliciesHandler interface for that can handle valid preview link policies params
+type PreviewLinkPoliciesHandler interface {
+	Handle(PreviewLinkPoliciesParams) middleware.Responder
+}
+
+// NewPreviewLinkPolicies creates a new http.Handler for the preview link policies operation
+func NewPreviewLinkPolicies(ctx *middleware.Context, handler PreviewLinkPoliciesHandler) *PreviewLinkPolicies {
+	return &PreviewLinkPolicies{Context: ctx, Handler: handler}
+}
+
+/*
+	PreviewLinkPolicies swagger:route POST /link-policies/preview LinkPolicy previewLinkPolicies
+
+# Preview the links produced by a set of link policies
+
+Evaluates a set of link policies against all routers and returns the links the controller would dial. If no
+policies are given, the stored link policies are evaluated. An empty list of policies previews the default
+behavior, where all routers are linked. Router listeners are only known for connected routers, so they aren't
+taken into account. Requires admin access.
+*/
+type PreviewLinkPolicies struct {
+	Context *middleware.Context
+	Handler PreviewLinkPoliciesHandler
+}
+
+func (o *PreviewLinkPolicies) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPreviewLinkPoliciesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PreviewLinkPoliciesOKCode is the HTTP code returned for type PreviewLinkPoliciesOK
+const PreviewLinkPoliciesOKCode int = 200
+
+/*
+PreviewLinkPoliciesOK The links produced by a set of link policies
+
+swagger:response previewLinkPoliciesOK
+*/
+type PreviewLinkPoliciesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.LinkPolicyPreviewEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewLinkPoliciesOK creates PreviewLinkPoliciesOK with default headers values
+func NewPreviewLinkPoliciesOK() *PreviewLinkPoliciesOK {
+
+	return &PreviewLinkPoliciesOK{}
+}
+
+// WithPayload adds the payload to the preview link policies o k response
+func (o *PreviewLinkPoliciesOK) WithPayload(payload *rest_model.LinkPolicyPreviewEnvelope) *PreviewLinkPoliciesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview link policies o k response
+func (o *PreviewLinkPoliciesOK) SetPayload(payload *rest_model.LinkPolicyPreviewEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewLinkPoliciesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewLinkPoliciesBadRequestCode is the HTTP code returned for type PreviewLinkPoliciesBadRequest
+const PreviewLinkPoliciesBadRequestCode int = 400
+
+/*
+PreviewLinkPoliciesBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response previewLinkPoliciesBadRequest
+*/
+type PreviewLinkPoliciesBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewLinkPoliciesBadRequest creates PreviewLinkPoliciesBadRequest with default headers values
+func NewPreviewLinkPoliciesBadRequest() *PreviewLinkPoliciesBadRequest {
+
+	return &PreviewLinkPoliciesBadRequest{}
+}
+
+// WithPayload adds the payload to the preview link policies bad request response
+func (o *PreviewLinkPoliciesBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *PreviewLinkPoliciesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview link policies bad request response
+func (o *PreviewLinkPoliciesBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewLinkPoliciesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewLinkPoliciesUnauthorizedCode is the HTTP code returned for type PreviewLinkPoliciesUnauthorized
+const PreviewLinkPoliciesUnauthorizedCode int = 401
+
+/*
+PreviewLinkPoliciesUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response previewLinkPoliciesUnauthorized
+*/
+type PreviewLinkPoliciesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewLinkPoliciesUnauthorized creates PreviewLinkPoliciesUnauthorized with default headers values
+func NewPreviewLinkPoliciesUnauthorized() *PreviewLinkPoliciesUnauthorized {
+
+	return &PreviewLinkPoliciesUnauthorized{}
+}
+
+// WithPayload adds the payload to the preview link policies unauthorized response
+func (o *PreviewLinkPoliciesUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *PreviewLinkPoliciesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview link policies unauthorized response
+func (o *PreviewLinkPoliciesUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewLinkPoliciesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link_policy
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// UpdateLinkPolicyOKCode is the HTTP code returned for type UpdateLinkPolicyOK
+const UpdateLinkPolicyOKCode int = 200
+
+/*
+UpdateLinkPolicyOK The update request was successful and the resource has been altered
+
+swagger:response updateLinkPolicyOK
+*/
+type UpdateLinkPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewUpdateLinkPolicyOK creates UpdateLinkPolicyOK with default headers values
+func NewUpdateLinkPolicyOK() *UpdateLinkPolicyOK {
+
+	return &UpdateLinkPolicyOK{}
+}
+
+// WithPayload adds the payload to the update link policy o k response
+func (o *UpdateLinkPolicyOK) WithPayload(payload *rest_model.Empty) *UpdateLinkPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link policy o k response
+func (o *UpdateLinkPolicyOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkPolicyBadRequestCode is the HTTP code returned for type UpdateLinkPolicyBadRequest
+const UpdateLinkPolicyBadRequestCode int = 400
+
+/*
+UpdateLinkPolicyBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response updateLinkPolicyBadRequest
+*/
+type UpdateLinkPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkPolicyBadRequest creates UpdateLinkPolicyBadRequest with default headers values
+func NewUpdateLinkPolicyBadRequest() *UpdateLinkPolicyBadRequest {
+
+	return &UpdateLinkPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the update link policy bad request response
+func (o *UpdateLinkPolicyBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link policy bad request response
+func (o *UpdateLinkPolicyBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkPolicyUnauthorizedCode is the HTTP code returned for type UpdateLinkPolicyUnauthorized
+const UpdateLinkPolicyUnauthorizedCode int = 401
+
+/*
+UpdateLinkPolicyUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response updateLinkPolicyUnauthorized
+*/
+type UpdateLinkPolicyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkPolicyUnauthorized creates UpdateLinkPolicyUnauthorized with default headers values
+func NewUpdateLinkPolicyUnauthorized() *UpdateLinkPolicyUnauthorized {
+
+	return &UpdateLinkPolicyUnauthorized{}
+}
+
+// WithPayload adds the payload to the update link policy unauthorized response
+func (o *UpdateLinkPolicyUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkPolicyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link policy unauthorized response
+func (o *UpdateLinkPolicyUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkPolicyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkPolicyNotFoundCode is the HTTP code returned for type UpdateLinkPolicyNotFound
+const UpdateLinkPolicyNotFoundCode int = 404
+
+/*
+UpdateLinkPolicyNotFound The requested resource does not exist
+
+swagger:response updateLinkPolicyNotFound
+*/
+type UpdateLinkPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkPolicyNotFound creates UpdateLinkPolicyNotFound with default headers values
+func NewUpdateLinkPolicyNotFound() *UpdateLinkPolicyNotFound {
+
+	return &UpdateLinkPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the update link policy not found response
+func (o *UpdateLinkPolicyNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link policy not found response
+func (o *UpdateLinkPolicyNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
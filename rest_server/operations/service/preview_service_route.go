@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PreviewServiceRouteHandlerFunc turns a function with the right signature into a preview service route handler
+type PreviewServiceRouteHandlerFunc func(PreviewServiceRouteParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PreviewServiceRouteHandlerFunc) Handle(params PreviewServiceRouteParams) middleware.Responder {
+	return fn(params)
+}
+
+// PreviewServiceRouteHandler interface for that can handle valid preview service route params
+type PreviewServiceRouteHandler interface {
+	Handle(PreviewServiceRouteParams) middleware.Responder
+}
+
+// NewPreviewServiceRoute creates a new http.Handler for the preview service route operation
+func NewPreviewServiceRoute(ctx *middleware.Context, handler PreviewServiceRouteHandler) *PreviewServiceRoute {
+	return &PreviewServiceRoute{Context: ctx, Handler: handler}
+}
+
+/*
+	PreviewServiceRoute swagger:route GET /services/{id}/route-preview Service previewServiceRoute
+
+# Preview terminator and path selection for a service
+
+Runs terminator and path selection for the service, as would be done when creating a circuit, without
+creating a circuit. Returns each terminator with its cost breakdown, the reason any terminators were skipped,
+the terminator the service's strategy would pick and the path to it. Requires admin access.
+*/
+type PreviewServiceRoute struct {
+	Context *middleware.Context
+	Handler PreviewServiceRouteHandler
+}
+
+func (o *PreviewServiceRoute) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPreviewServiceRouteParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
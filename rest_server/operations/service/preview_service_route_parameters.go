@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPreviewServiceRouteParams creates a new PreviewServiceRouteParams object
+//
+// There are no default values defined in the spec.
+func NewPreviewServiceRouteParams() PreviewServiceRouteParams {
+
+	return PreviewServiceRouteParams{}
+}
+
+// PreviewServiceRouteParams contains all the bound params for the preview service route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters previewServiceRoute
+type PreviewServiceRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The client id to pass to the terminator strategy, if any
+	  In: query
+	*/
+	ClientID *string
+	/*The id of the requested resource
+	  Required: true
+	  In: path
+	*/
+	ID string
+	/*The instance id being dialed, if any
+	  In: query
+	*/
+	InstanceID *string
+	/*The id of the router the circuit would originate from
+	  Required: true
+	  In: query
+	*/
+	SourceRouter string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPreviewServiceRouteParams() beforehand.
+func (o *PreviewServiceRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qClientID, qhkClientID, _ := qs.GetOK("clientId")
+	if err := o.bindClientID(qClientID, qhkClientID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInstanceID, qhkInstanceID, _ := qs.GetOK("instanceId")
+	if err := o.bindInstanceID(qInstanceID, qhkInstanceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSourceRouter, qhkSourceRouter, _ := qs.GetOK("sourceRouter")
+	if err := o.bindSourceRouter(qSourceRouter, qhkSourceRouter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClientID binds and validates parameter ClientID from query.
+func (o *PreviewServiceRouteParams) bindClientID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ClientID = &raw
+
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *PreviewServiceRouteParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ID = raw
+
+	return nil
+}
+
+// bindInstanceID binds and validates parameter InstanceID from query.
+func (o *PreviewServiceRouteParams) bindInstanceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.InstanceID = &raw
+
+	return nil
+}
+
+// bindSourceRouter binds and validates parameter SourceRouter from query.
+func (o *PreviewServiceRouteParams) bindSourceRouter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("sourceRouter", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("sourceRouter", "query", raw); err != nil {
+		return err
+	}
+	o.SourceRouter = raw
+
+	return nil
+}
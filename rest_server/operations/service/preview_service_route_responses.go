@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// PreviewServiceRouteOKCode is the HTTP code returned for type PreviewServiceRouteOK
+const PreviewServiceRouteOKCode int = 200
+
+/*
+PreviewServiceRouteOK A preview of terminator and path selection for a service
+
+swagger:response previewServiceRouteOK
+*/
+type PreviewServiceRouteOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.RoutePreviewEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewServiceRouteOK creates PreviewServiceRouteOK with default headers values
+func NewPreviewServiceRouteOK() *PreviewServiceRouteOK {
+
+	return &PreviewServiceRouteOK{}
+}
+
+// WithPayload adds the payload to the preview service route o k response
+func (o *PreviewServiceRouteOK) WithPayload(payload *rest_model.RoutePreviewEnvelope) *PreviewServiceRouteOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview service route o k response
+func (o *PreviewServiceRouteOK) SetPayload(payload *rest_model.RoutePreviewEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewServiceRouteOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewServiceRouteBadRequestCode is the HTTP code returned for type PreviewServiceRouteBadRequest
+const PreviewServiceRouteBadRequestCode int = 400
+
+/*
+PreviewServiceRouteBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response previewServiceRouteBadRequest
+*/
+type PreviewServiceRouteBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewServiceRouteBadRequest creates PreviewServiceRouteBadRequest with default headers values
+func NewPreviewServiceRouteBadRequest() *PreviewServiceRouteBadRequest {
+
+	return &PreviewServiceRouteBadRequest{}
+}
+
+// WithPayload adds the payload to the preview service route bad request response
+func (o *PreviewServiceRouteBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *PreviewServiceRouteBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview service route bad request response
+func (o *PreviewServiceRouteBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewServiceRouteBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewServiceRouteUnauthorizedCode is the HTTP code returned for type PreviewServiceRouteUnauthorized
+const PreviewServiceRouteUnauthorizedCode int = 401
+
+/*
+PreviewServiceRouteUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response previewServiceRouteUnauthorized
+*/
+type PreviewServiceRouteUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewServiceRouteUnauthorized creates PreviewServiceRouteUnauthorized with default headers values
+func NewPreviewServiceRouteUnauthorized() *PreviewServiceRouteUnauthorized {
+
+	return &PreviewServiceRouteUnauthorized{}
+}
+
+// WithPayload adds the payload to the preview service route unauthorized response
+func (o *PreviewServiceRouteUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *PreviewServiceRouteUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview service route unauthorized response
+func (o *PreviewServiceRouteUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewServiceRouteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PreviewServiceRouteNotFoundCode is the HTTP code returned for type PreviewServiceRouteNotFound
+const PreviewServiceRouteNotFoundCode int = 404
+
+/*
+PreviewServiceRouteNotFound The requested resource does not exist
+
+swagger:response previewServiceRouteNotFound
+*/
+type PreviewServiceRouteNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPreviewServiceRouteNotFound creates PreviewServiceRouteNotFound with default headers values
+func NewPreviewServiceRouteNotFound() *PreviewServiceRouteNotFound {
+
+	return &PreviewServiceRouteNotFound{}
+}
+
+// WithPayload adds the payload to the preview service route not found response
+func (o *PreviewServiceRouteNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *PreviewServiceRouteNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the preview service route not found response
+func (o *PreviewServiceRouteNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PreviewServiceRouteNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
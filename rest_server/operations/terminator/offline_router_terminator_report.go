@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// OfflineRouterTerminatorReportHandlerFunc turns a function with the right signature into a offline router terminator report handler
+type OfflineRouterTerminatorReportHandlerFunc func(OfflineRouterTerminatorReportParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn OfflineRouterTerminatorReportHandlerFunc) Handle(params OfflineRouterTerminatorReportParams) middleware.Responder {
+	return fn(params)
+}
+
+// OfflineRouterTerminatorReportHandler interface for that can handle valid offline router terminator report params
+type OfflineRouterTerminatorReportHandler interface {
+	Handle(OfflineRouterTerminatorReportParams) middleware.Responder
+}
+
+// NewOfflineRouterTerminatorReport creates a new http.Handler for the offline router terminator report operation
+func NewOfflineRouterTerminatorReport(ctx *middleware.Context, handler OfflineRouterTerminatorReportHandler) *OfflineRouterTerminatorReport {
+	return &OfflineRouterTerminatorReport{Context: ctx, Handler: handler}
+}
+
+/*
+	OfflineRouterTerminatorReport swagger:route GET /terminators/offline-router-report Terminator offlineRouterTerminatorReport
+
+# Report the terminators of routers which have been offline past the cleanup threshold
+
+Lists the terminators which offline terminator cleanup acts on, without changing anything. Terminators are
+included if their router has been disconnected for longer than the configured threshold. The report is
+generated whether or not cleanup is enabled. Requires admin access.
+*/
+type OfflineRouterTerminatorReport struct {
+	Context *middleware.Context
+	Handler OfflineRouterTerminatorReportHandler
+}
+
+func (o *OfflineRouterTerminatorReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewOfflineRouterTerminatorReportParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
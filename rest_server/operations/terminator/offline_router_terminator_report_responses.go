@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/rest_model"
+)
+
+// OfflineRouterTerminatorReportOKCode is the HTTP code returned for type OfflineRouterTerminatorReportOK
+const OfflineRouterTerminatorReportOKCode int = 200
+
+/*
+OfflineRouterTerminatorReportOK The terminators of routers which have been offline past the cleanup threshold
+
+swagger:response offlineRouterTerminatorReportOK
+*/
+type OfflineRouterTerminatorReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.OfflineRouterTerminatorReportEnvelope `json:"body,omitempty"`
+}
+
+// NewOfflineRouterTerminatorReportOK creates OfflineRouterTerminatorReportOK with default headers values
+func NewOfflineRouterTerminatorReportOK() *OfflineRouterTerminatorReportOK {
+
+	return &OfflineRouterTerminatorReportOK{}
+}
+
+// WithPayload adds the payload to the offline router terminator report o k response
+func (o *OfflineRouterTerminatorReportOK) WithPayload(payload *rest_model.OfflineRouterTerminatorReportEnvelope) *OfflineRouterTerminatorReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the offline router terminator report o k response
+func (o *OfflineRouterTerminatorReportOK) SetPayload(payload *rest_model.OfflineRouterTerminatorReportEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OfflineRouterTerminatorReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// OfflineRouterTerminatorReportUnauthorizedCode is the HTTP code returned for type OfflineRouterTerminatorReportUnauthorized
+const OfflineRouterTerminatorReportUnauthorizedCode int = 401
+
+/*
+OfflineRouterTerminatorReportUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response offlineRouterTerminatorReportUnauthorized
+*/
+type OfflineRouterTerminatorReportUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewOfflineRouterTerminatorReportUnauthorized creates OfflineRouterTerminatorReportUnauthorized with default headers values
+func NewOfflineRouterTerminatorReportUnauthorized() *OfflineRouterTerminatorReportUnauthorized {
+
+	return &OfflineRouterTerminatorReportUnauthorized{}
+}
+
+// WithPayload adds the payload to the offline router terminator report unauthorized response
+func (o *OfflineRouterTerminatorReportUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *OfflineRouterTerminatorReportUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the offline router terminator report unauthorized response
+func (o *OfflineRouterTerminatorReportUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OfflineRouterTerminatorReportUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
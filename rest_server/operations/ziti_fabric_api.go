@@ -47,6 +47,7 @@ import (
 	"github.com/openziti/fabric/rest_server/operations/database"
 	"github.com/openziti/fabric/rest_server/operations/inspect"
 	"github.com/openziti/fabric/rest_server/operations/link"
+	"github.com/openziti/fabric/rest_server/operations/link_policy"
 	"github.com/openziti/fabric/rest_server/operations/router"
 	"github.com/openziti/fabric/rest_server/operations/service"
 	"github.com/openziti/fabric/rest_server/operations/terminator"
@@ -80,6 +81,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		DatabaseCreateDatabaseSnapshotHandler: database.CreateDatabaseSnapshotHandlerFunc(func(params database.CreateDatabaseSnapshotParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
 		}),
+		LinkPolicyCreateLinkPolicyHandler: link_policy.CreateLinkPolicyHandlerFunc(func(params link_policy.CreateLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.CreateLinkPolicy has not yet been implemented")
+		}),
 		RouterCreateRouterHandler: router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
 		}),
@@ -98,6 +102,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		LinkDeleteLinkHandler: link.DeleteLinkHandlerFunc(func(params link.DeleteLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		}),
+		LinkPolicyDeleteLinkPolicyHandler: link_policy.DeleteLinkPolicyHandlerFunc(func(params link_policy.DeleteLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.DeleteLinkPolicy has not yet been implemented")
+		}),
 		RouterDeleteRouterHandler: router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
 		}),
@@ -113,6 +120,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		LinkDetailLinkHandler: link.DetailLinkHandlerFunc(func(params link.DetailLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		}),
+		LinkPolicyDetailLinkPolicyHandler: link_policy.DetailLinkPolicyHandlerFunc(func(params link_policy.DetailLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.DetailLinkPolicy has not yet been implemented")
+		}),
 		RouterDetailRouterHandler: router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
 		}),
@@ -131,6 +141,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		CircuitListCircuitsHandler: circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		}),
+		LinkPolicyListLinkPoliciesHandler: link_policy.ListLinkPoliciesHandlerFunc(func(params link_policy.ListLinkPoliciesParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.ListLinkPolicies has not yet been implemented")
+		}),
 		LinkListLinksHandler: link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
 		}),
@@ -149,9 +162,15 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorListTerminatorsHandler: terminator.ListTerminatorsHandlerFunc(func(params terminator.ListTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		}),
+		TerminatorOfflineRouterTerminatorReportHandler: terminator.OfflineRouterTerminatorReportHandlerFunc(func(params terminator.OfflineRouterTerminatorReportParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.OfflineRouterTerminatorReport has not yet been implemented")
+		}),
 		LinkPatchLinkHandler: link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		}),
+		LinkPolicyPatchLinkPolicyHandler: link_policy.PatchLinkPolicyHandlerFunc(func(params link_policy.PatchLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.PatchLinkPolicy has not yet been implemented")
+		}),
 		RouterPatchRouterHandler: router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
 		}),
@@ -161,6 +180,18 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorPatchTerminatorHandler: terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		}),
+		LinkPolicyPreviewLinkPoliciesHandler: link_policy.PreviewLinkPoliciesHandlerFunc(func(params link_policy.PreviewLinkPoliciesParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.PreviewLinkPolicies has not yet been implemented")
+		}),
+		ServicePreviewServiceRouteHandler: service.PreviewServiceRouteHandlerFunc(func(params service.PreviewServiceRouteParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.PreviewServiceRoute has not yet been implemented")
+		}),
+		CircuitRerouteCircuitHandler: circuit.RerouteCircuitHandlerFunc(func(params circuit.RerouteCircuitParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.RerouteCircuit has not yet been implemented")
+		}),
+		LinkPolicyUpdateLinkPolicyHandler: link_policy.UpdateLinkPolicyHandlerFunc(func(params link_policy.UpdateLinkPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation link_policy.UpdateLinkPolicy has not yet been implemented")
+		}),
 		RouterUpdateRouterHandler: router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
 		}),
@@ -210,6 +241,8 @@ type ZitiFabricAPI struct {
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
 	// DatabaseCreateDatabaseSnapshotHandler sets the operation handler for the create database snapshot operation
 	DatabaseCreateDatabaseSnapshotHandler database.CreateDatabaseSnapshotHandler
+	// LinkPolicyCreateLinkPolicyHandler sets the operation handler for the create link policy operation
+	LinkPolicyCreateLinkPolicyHandler link_policy.CreateLinkPolicyHandler
 	// RouterCreateRouterHandler sets the operation handler for the create router operation
 	RouterCreateRouterHandler router.CreateRouterHandler
 	// ServiceCreateServiceHandler sets the operation handler for the create service operation
@@ -222,6 +255,8 @@ type ZitiFabricAPI struct {
 	CircuitDeleteCircuitHandler circuit.DeleteCircuitHandler
 	// LinkDeleteLinkHandler sets the operation handler for the delete link operation
 	LinkDeleteLinkHandler link.DeleteLinkHandler
+	// LinkPolicyDeleteLinkPolicyHandler sets the operation handler for the delete link policy operation
+	LinkPolicyDeleteLinkPolicyHandler link_policy.DeleteLinkPolicyHandler
 	// RouterDeleteRouterHandler sets the operation handler for the delete router operation
 	RouterDeleteRouterHandler router.DeleteRouterHandler
 	// ServiceDeleteServiceHandler sets the operation handler for the delete service operation
@@ -232,6 +267,8 @@ type ZitiFabricAPI struct {
 	CircuitDetailCircuitHandler circuit.DetailCircuitHandler
 	// LinkDetailLinkHandler sets the operation handler for the detail link operation
 	LinkDetailLinkHandler link.DetailLinkHandler
+	// LinkPolicyDetailLinkPolicyHandler sets the operation handler for the detail link policy operation
+	LinkPolicyDetailLinkPolicyHandler link_policy.DetailLinkPolicyHandler
 	// RouterDetailRouterHandler sets the operation handler for the detail router operation
 	RouterDetailRouterHandler router.DetailRouterHandler
 	// ServiceDetailServiceHandler sets the operation handler for the detail service operation
@@ -244,6 +281,8 @@ type ZitiFabricAPI struct {
 	InspectInspectHandler inspect.InspectHandler
 	// CircuitListCircuitsHandler sets the operation handler for the list circuits operation
 	CircuitListCircuitsHandler circuit.ListCircuitsHandler
+	// LinkPolicyListLinkPoliciesHandler sets the operation handler for the list link policies operation
+	LinkPolicyListLinkPoliciesHandler link_policy.ListLinkPoliciesHandler
 	// LinkListLinksHandler sets the operation handler for the list links operation
 	LinkListLinksHandler link.ListLinksHandler
 	// RouterListRouterTerminatorsHandler sets the operation handler for the list router terminators operation
@@ -256,14 +295,26 @@ type ZitiFabricAPI struct {
 	ServiceListServicesHandler service.ListServicesHandler
 	// TerminatorListTerminatorsHandler sets the operation handler for the list terminators operation
 	TerminatorListTerminatorsHandler terminator.ListTerminatorsHandler
+	// TerminatorOfflineRouterTerminatorReportHandler sets the operation handler for the offline router terminator report operation
+	TerminatorOfflineRouterTerminatorReportHandler terminator.OfflineRouterTerminatorReportHandler
 	// LinkPatchLinkHandler sets the operation handler for the patch link operation
 	LinkPatchLinkHandler link.PatchLinkHandler
+	// LinkPolicyPatchLinkPolicyHandler sets the operation handler for the patch link policy operation
+	LinkPolicyPatchLinkPolicyHandler link_policy.PatchLinkPolicyHandler
 	// RouterPatchRouterHandler sets the operation handler for the patch router operation
 	RouterPatchRouterHandler router.PatchRouterHandler
 	// ServicePatchServiceHandler sets the operation handler for the patch service operation
 	ServicePatchServiceHandler service.PatchServiceHandler
 	// TerminatorPatchTerminatorHandler sets the operation handler for the patch terminator operation
 	TerminatorPatchTerminatorHandler terminator.PatchTerminatorHandler
+	// LinkPolicyPreviewLinkPoliciesHandler sets the operation handler for the preview link policies operation
+	LinkPolicyPreviewLinkPoliciesHandler link_policy.PreviewLinkPoliciesHandler
+	// ServicePreviewServiceRouteHandler sets the operation handler for the preview service route operation
+	ServicePreviewServiceRouteHandler service.PreviewServiceRouteHandler
+	// CircuitRerouteCircuitHandler sets the operation handler for the reroute circuit operation
+	CircuitRerouteCircuitHandler circuit.RerouteCircuitHandler
+	// LinkPolicyUpdateLinkPolicyHandler sets the operation handler for the update link policy operation
+	LinkPolicyUpdateLinkPolicyHandler link_policy.UpdateLinkPolicyHandler
 	// RouterUpdateRouterHandler sets the operation handler for the update router operation
 	RouterUpdateRouterHandler router.UpdateRouterHandler
 	// ServiceUpdateServiceHandler sets the operation handler for the update service operation
@@ -353,6 +404,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.DatabaseCreateDatabaseSnapshotHandler == nil {
 		unregistered = append(unregistered, "database.CreateDatabaseSnapshotHandler")
 	}
+	if o.LinkPolicyCreateLinkPolicyHandler == nil {
+		unregistered = append(unregistered, "link_policy.CreateLinkPolicyHandler")
+	}
 	if o.RouterCreateRouterHandler == nil {
 		unregistered = append(unregistered, "router.CreateRouterHandler")
 	}
@@ -371,6 +425,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.LinkDeleteLinkHandler == nil {
 		unregistered = append(unregistered, "link.DeleteLinkHandler")
 	}
+	if o.LinkPolicyDeleteLinkPolicyHandler == nil {
+		unregistered = append(unregistered, "link_policy.DeleteLinkPolicyHandler")
+	}
 	if o.RouterDeleteRouterHandler == nil {
 		unregistered = append(unregistered, "router.DeleteRouterHandler")
 	}
@@ -386,6 +443,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.LinkDetailLinkHandler == nil {
 		unregistered = append(unregistered, "link.DetailLinkHandler")
 	}
+	if o.LinkPolicyDetailLinkPolicyHandler == nil {
+		unregistered = append(unregistered, "link_policy.DetailLinkPolicyHandler")
+	}
 	if o.RouterDetailRouterHandler == nil {
 		unregistered = append(unregistered, "router.DetailRouterHandler")
 	}
@@ -404,6 +464,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.CircuitListCircuitsHandler == nil {
 		unregistered = append(unregistered, "circuit.ListCircuitsHandler")
 	}
+	if o.LinkPolicyListLinkPoliciesHandler == nil {
+		unregistered = append(unregistered, "link_policy.ListLinkPoliciesHandler")
+	}
 	if o.LinkListLinksHandler == nil {
 		unregistered = append(unregistered, "link.ListLinksHandler")
 	}
@@ -422,9 +485,15 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorListTerminatorsHandler == nil {
 		unregistered = append(unregistered, "terminator.ListTerminatorsHandler")
 	}
+	if o.TerminatorOfflineRouterTerminatorReportHandler == nil {
+		unregistered = append(unregistered, "terminator.OfflineRouterTerminatorReportHandler")
+	}
 	if o.LinkPatchLinkHandler == nil {
 		unregistered = append(unregistered, "link.PatchLinkHandler")
 	}
+	if o.LinkPolicyPatchLinkPolicyHandler == nil {
+		unregistered = append(unregistered, "link_policy.PatchLinkPolicyHandler")
+	}
 	if o.RouterPatchRouterHandler == nil {
 		unregistered = append(unregistered, "router.PatchRouterHandler")
 	}
@@ -434,6 +503,18 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorPatchTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.PatchTerminatorHandler")
 	}
+	if o.LinkPolicyPreviewLinkPoliciesHandler == nil {
+		unregistered = append(unregistered, "link_policy.PreviewLinkPoliciesHandler")
+	}
+	if o.ServicePreviewServiceRouteHandler == nil {
+		unregistered = append(unregistered, "service.PreviewServiceRouteHandler")
+	}
+	if o.CircuitRerouteCircuitHandler == nil {
+		unregistered = append(unregistered, "circuit.RerouteCircuitHandler")
+	}
+	if o.LinkPolicyUpdateLinkPolicyHandler == nil {
+		unregistered = append(unregistered, "link_policy.UpdateLinkPolicyHandler")
+	}
 	if o.RouterUpdateRouterHandler == nil {
 		unregistered = append(unregistered, "router.UpdateRouterHandler")
 	}
@@ -542,6 +623,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/link-policies"] = link_policy.NewCreateLinkPolicy(o.context, o.LinkPolicyCreateLinkPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/routers"] = router.NewCreateRouter(o.context, o.RouterCreateRouterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -566,6 +651,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/link-policies/{id}"] = link_policy.NewDeleteLinkPolicy(o.context, o.LinkPolicyDeleteLinkPolicyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/routers/{id}"] = router.NewDeleteRouter(o.context, o.RouterDeleteRouterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -586,6 +675,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/link-policies/{id}"] = link_policy.NewDetailLinkPolicy(o.context, o.LinkPolicyDetailLinkPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/routers/{id}"] = router.NewDetailRouter(o.context, o.RouterDetailRouterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -610,6 +703,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/link-policies"] = link_policy.NewListLinkPolicies(o.context, o.LinkPolicyListLinkPoliciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/links"] = link.NewListLinks(o.context, o.LinkListLinksHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -631,6 +728,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/terminators"] = terminator.NewListTerminators(o.context, o.TerminatorListTerminatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/terminators/offline-router-report"] = terminator.NewOfflineRouterTerminatorReport(o.context, o.TerminatorOfflineRouterTerminatorReportHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -638,6 +739,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/link-policies/{id}"] = link_policy.NewPatchLinkPolicy(o.context, o.LinkPolicyPatchLinkPolicyHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/routers/{id}"] = router.NewPatchRouter(o.context, o.RouterPatchRouterHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -647,6 +752,22 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/terminators/{id}"] = terminator.NewPatchTerminator(o.context, o.TerminatorPatchTerminatorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/link-policies/preview"] = link_policy.NewPreviewLinkPolicies(o.context, o.LinkPolicyPreviewLinkPoliciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/services/{id}/route-preview"] = service.NewPreviewServiceRoute(o.context, o.ServicePreviewServiceRouteHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/circuits/{id}/reroute"] = circuit.NewRerouteCircuit(o.context, o.CircuitRerouteCircuitHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/link-policies/{id}"] = link_policy.NewUpdateLinkPolicy(o.context, o.LinkPolicyUpdateLinkPolicyHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -19,6 +19,8 @@ package env
 import (
 	"github.com/openziti/channel"
 	"github.com/openziti/fabric/controller/xctrl"
+	"github.com/openziti/fabric/router/terminator_health"
+	"github.com/openziti/fabric/router/terminator_lease"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/identity"
@@ -35,4 +37,6 @@ type RouterEnv interface {
 	GetXlinkRegistry() xlink.Registry
 	GetCloseNotify() <-chan struct{}
 	GetMetricsRegistry() metrics.UsageRegistry
+	GetTerminatorHealthChecks() *terminator_health.Manager
+	GetTerminatorLeases() *terminator_lease.Manager
 }
@@ -141,10 +141,65 @@ func (forwarder *Forwarder) Route(route *ctrl_pb.Route) error {
 		}
 		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
 	}
+	for _, forward := range route.StandbyForwards {
+		circuitFt.setStandbyForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+	}
+	if route.ServiceId != "" {
+		circuitFt.setCircuitInfo(&circuitInfo{
+			serviceId:    route.ServiceId,
+			terminatorId: route.TerminatorId,
+			clientId:     route.ClientId,
+			tags:         route.Tags,
+			createdAt:    route.CreatedAt,
+		})
+	}
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
 }
 
+// ReportCircuits builds a report of the forward tables for all circuits routed through this router. It's sent
+// to the controller on reconnect, so a controller which has lost its circuit state can rebuild the circuits.
+func (forwarder *Forwarder) ReportCircuits() *ctrl_pb.RouterCircuits {
+	report := &ctrl_pb.RouterCircuits{}
+	for tuple := range forwarder.circuits.circuits.IterBuffered() {
+		circuitId := tuple.Key
+		ft := tuple.Val
+
+		circuit := &ctrl_pb.RouterCircuits_RouterCircuit{CircuitId: circuitId}
+		for forward := range ft.destinations.IterBuffered() {
+			circuit.Forwards = append(circuit.Forwards, &ctrl_pb.RouterCircuits_Forward{
+				SrcAddress: forward.Key,
+				DstAddress: forward.Val,
+			})
+		}
+
+		if info := ft.getCircuitInfo(); info != nil {
+			circuit.ServiceId = info.serviceId
+			circuit.TerminatorId = info.terminatorId
+			circuit.ClientId = info.clientId
+			circuit.Tags = info.tags
+			circuit.CreatedAt = info.createdAt
+		}
+
+		if addresses, found := forwarder.destinations.getAddressesForCircuit(circuitId); found {
+			for _, address := range addresses {
+				if destination, found := forwarder.destinations.getDestination(address); found {
+					if xgDest, ok := destination.(XgressDestination); ok {
+						if xgDest.IsTerminator() {
+							circuit.EgressId = string(address)
+						} else {
+							circuit.IngressId = string(address)
+						}
+					}
+				}
+			}
+		}
+
+		report.Circuits = append(report.Circuits, circuit)
+	}
+	return report
+}
+
 func (forwarder *Forwarder) Unroute(circuitId string, now bool) {
 	if now {
 		forwarder.circuits.removeForwardTable(circuitId)
@@ -154,6 +209,13 @@ func (forwarder *Forwarder) Unroute(circuitId string, now bool) {
 	}
 }
 
+// UnrouteStandby removes the standby forwards of a circuit, leaving its primary forwards in place
+func (forwarder *Forwarder) UnrouteStandby(circuitId string) {
+	if ft, found := forwarder.circuits.getForwardTable(circuitId); found {
+		ft.clearStandby()
+	}
+}
+
 func (forwarder *Forwarder) EndCircuit(circuitId string) {
 	forwarder.UnregisterDestinations(circuitId)
 }
@@ -164,7 +226,7 @@ func (forwarder *Forwarder) ForwardPayload(srcAddr xgress.Address, payload *xgre
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if dstAddr, dst, found := forwarder.getDestination(circuitId, forwardTable, srcAddr, dstAddr); found {
 				if err := dst.SendPayload(payload); err != nil {
 					return err
 				}
@@ -187,7 +249,7 @@ func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, ackno
 	circuitId := acknowledgement.CircuitId
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if dstAddr, dst, found := forwarder.getDestination(circuitId, forwardTable, srcAddr, dstAddr); found {
 				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
 					return err
 				}
@@ -215,7 +277,7 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if dstAddr, dst, found := forwarder.getDestination(circuitId, forwardTable, srcAddr, dstAddr); found {
 				if control.IsTypeTraceRoute() {
 					hops := control.DecrementAndGetHop()
 					if hops == 0 {
@@ -250,6 +312,27 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 	return err
 }
 
+// getDestination returns the destination for the given destination address. If the destination no longer
+// exists and the forward table has a standby destination for the source address, the circuit fails over to
+// the standby destination.
+func (forwarder *Forwarder) getDestination(circuitId string, ft *forwardTable, srcAddr, dstAddr xgress.Address) (xgress.Address, Destination, bool) {
+	if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+		return dstAddr, dst, true
+	}
+	if standbyAddr, found := ft.getStandbyForwardAddress(srcAddr); found {
+		if dst, found := forwarder.destinations.getDestination(standbyAddr); found {
+			ft.promoteStandby(srcAddr, standbyAddr)
+			pfxlog.Logger().WithField("circuitId", circuitId).
+				WithField("src", srcAddr).
+				WithField("dst", dstAddr).
+				WithField("standbyDst", standbyAddr).
+				Info("destination missing, failed over to standby destination")
+			return standbyAddr, dst, true
+		}
+	}
+	return dstAddr, nil, false
+}
+
 func (forwarder *Forwarder) ReportForwardingFault(circuitId string) {
 	if forwarder.faulter != nil {
 		forwarder.faulter.report(circuitId)
@@ -63,15 +63,29 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. Standby destinations are
+// used in place of the primary destination for a source address, if the primary destination goes away.
 type forwardTable struct {
 	last         int64
 	destinations cmap.ConcurrentMap[string]
+	standby      cmap.ConcurrentMap[string]
+	circuitInfo  atomic.Value
+}
+
+// circuitInfo holds the circuit details sent by the controller with the initial route, so they can be reported
+// back if the controller needs to rebuild the circuit
+type circuitInfo struct {
+	serviceId    string
+	terminatorId string
+	clientId     string
+	tags         map[string]string
+	createdAt    int64
 }
 
 func newForwardTable() *forwardTable {
 	return &forwardTable{
 		destinations: cmap.New[string](),
+		standby:      cmap.New[string](),
 	}
 }
 
@@ -86,11 +100,47 @@ func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, b
 	return "", false
 }
 
+func (ft *forwardTable) setStandbyForwardAddress(src, dst xgress.Address) {
+	ft.standby.Set(string(src), string(dst))
+}
+
+func (ft *forwardTable) getStandbyForwardAddress(src xgress.Address) (xgress.Address, bool) {
+	if dst, found := ft.standby.Get(string(src)); found {
+		return xgress.Address(dst), true
+	}
+	return "", false
+}
+
+// promoteStandby makes the standby destination for the given source address the primary destination
+func (ft *forwardTable) promoteStandby(src, dst xgress.Address) {
+	ft.destinations.Set(string(src), string(dst))
+	ft.standby.Remove(string(src))
+}
+
+// clearStandby removes all standby destinations, so the circuit can no longer fail over
+func (ft *forwardTable) clearStandby() {
+	ft.standby.Clear()
+}
+
+func (ft *forwardTable) setCircuitInfo(info *circuitInfo) {
+	ft.circuitInfo.Store(info)
+}
+
+func (ft *forwardTable) getCircuitInfo() *circuitInfo {
+	if info, ok := ft.circuitInfo.Load().(*circuitInfo); ok {
+		return info
+	}
+	return nil
+}
+
 func (ft *forwardTable) debug() string {
 	out := ""
 	for i := range ft.destinations.IterBuffered() {
 		out += fmt.Sprintf("\t\t@/%s -> @/%s\n", i.Key, i.Val)
 	}
+	for i := range ft.standby.IterBuffered() {
+		out += fmt.Sprintf("\t\t@/%s -> @/%s (standby)\n", i.Key, i.Val)
+	}
 	return out
 }
 
@@ -91,14 +91,14 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 		if route.Egress != nil {
 			if rh.forwarder.HasDestination(xgress.Address(route.Egress.Address)) {
 				log.Warnf("destination exists for [%s]", route.Egress.Address)
-				rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+				rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 				return
 			} else {
 				rh.connectEgress(msg, int(route.Attempt), ch, route, ctx, time.Now().Add(time.Duration(route.Timeout)))
 				return
 			}
 		} else {
-			rh.completeRoute(msg, int(route.Attempt), route, nil, log)
+			rh.completeRoute(msg, int(route.Attempt), route, nil, 0, log)
 		}
 	}
 
@@ -110,9 +110,9 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 	}
 }
 
-func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, log *logrus.Entry) {
+func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, dialDuration time.Duration, log *logrus.Entry) {
 	if err := rh.forwarder.Route(route); err != nil {
-		rh.fail(msg, attempt, route, err, ctrl_msg.ErrorTypeGeneric, log)
+		rh.fail(msg, attempt, route, err, ctrl_msg.ErrorTypeGeneric, dialDuration, log)
 		return
 	}
 
@@ -122,6 +122,9 @@ func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *
 	for k, v := range peerData {
 		response.Headers[int32(k)] = v
 	}
+	if dialDuration > 0 {
+		ctrl_msg.PutDialDuration(response, dialDuration)
+	}
 
 	response.ReplyTo(msg)
 
@@ -133,11 +136,14 @@ func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *
 	}
 }
 
-func (rh *routeHandler) fail(msg *channel.Message, attempt int, route *ctrl_pb.Route, err error, errorHeader byte, log *logrus.Entry) {
+func (rh *routeHandler) fail(msg *channel.Message, attempt int, route *ctrl_pb.Route, err error, errorHeader byte, dialDuration time.Duration, log *logrus.Entry) {
 	log.WithError(err).Error("failure while handling route update")
 
 	response := ctrl_msg.NewRouteResultFailedMessage(route.CircuitId, attempt, err.Error())
 	response.PutByteHeader(ctrl_msg.RouteResultErrorCodeHeader, errorHeader)
+	if dialDuration > 0 {
+		ctrl_msg.PutDialDuration(response, dialDuration)
+	}
 
 	response.ReplyTo(msg)
 	if err = response.WithTimeout(rh.ctrl.DefaultRequestTimeout()).Send(rh.ctrl.Channel()); err != nil {
@@ -168,8 +174,11 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 			}
 
 			params := newDialParams(route, bindHandler, ctx, deadline)
-			if peerData, err := dialer.Dial(params); err == nil {
-				rh.completeRoute(msg, attempt, route, peerData, log)
+			dialStart := time.Now()
+			peerData, err := dialer.Dial(params)
+			dialDuration := time.Since(dialStart)
+			if err == nil {
+				rh.completeRoute(msg, attempt, route, peerData, dialDuration, log)
 			} else {
 				var errCode byte
 
@@ -186,15 +195,15 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 					errCode = ctrl_msg.ErrorTypeGeneric
 				}
 
-				rh.fail(msg, attempt, route, errors.Wrapf(err, "error creating route for [c/%s]", route.CircuitId), errCode, log)
+				rh.fail(msg, attempt, route, errors.Wrapf(err, "error creating route for [c/%s]", route.CircuitId), errCode, dialDuration, log)
 			}
 		} else {
 			var errCode byte = ctrl_msg.ErrorTypeMisconfiguredTerminator
-			rh.fail(msg, attempt, route, errors.Wrapf(err, "unable to create dialer for [c/%s]", route.CircuitId), errCode, log)
+			rh.fail(msg, attempt, route, errors.Wrapf(err, "unable to create dialer for [c/%s]", route.CircuitId), errCode, 0, log)
 		}
 	} else {
 		var errCode byte = ctrl_msg.ErrorTypeMisconfiguredTerminator
-		rh.fail(msg, attempt, route, errors.Wrapf(err, "error creating route for [c/%s]", route.CircuitId), errCode, log)
+		rh.fail(msg, attempt, route, errors.Wrapf(err, "error creating route for [c/%s]", route.CircuitId), errCode, 0, log)
 	}
 }
 
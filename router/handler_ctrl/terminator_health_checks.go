@@ -0,0 +1,53 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/terminator_health"
+	"google.golang.org/protobuf/proto"
+)
+
+type terminatorHealthChecksHandler struct {
+	manager *terminator_health.Manager
+}
+
+func newTerminatorHealthChecksHandler(manager *terminator_health.Manager) *terminatorHealthChecksHandler {
+	return &terminatorHealthChecksHandler{
+		manager: manager,
+	}
+}
+
+func (handler *terminatorHealthChecksHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_TerminatorHealthChecksType)
+}
+
+func (handler *terminatorHealthChecksHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	checks := &ctrl_pb.TerminatorHealthChecks{}
+	if err := proto.Unmarshal(msg.Body, checks); err != nil {
+		log.Errorf("error unmarshaling terminator health checks msg (%v)", err)
+		return
+	}
+
+	log.Debugf("received terminator health checks: %v checks, %v removed, full sync? %v",
+		len(checks.Checks), len(checks.RemovedTerminatorIds), checks.FullSync)
+	handler.manager.Sync(checks)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/AppsFlyer/go-sundheit/checks"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
+	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/controller/xctrl"
 	"github.com/openziti/fabric/health"
 	fabricMetrics "github.com/openziti/fabric/metrics"
@@ -34,6 +35,8 @@ import (
 	"github.com/openziti/fabric/router/handler_ctrl"
 	"github.com/openziti/fabric/router/handler_link"
 	"github.com/openziti/fabric/router/handler_xgress"
+	"github.com/openziti/fabric/router/terminator_health"
+	"github.com/openziti/fabric/router/terminator_lease"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xgress_proxy"
 	"github.com/openziti/fabric/router/xgress_proxy_udp"
@@ -79,6 +82,9 @@ type Router struct {
 	xwebs               []xweb.Instance
 	xwebFactoryRegistry xweb.Registry
 	agentBindHandlers   []channel.BindHandler
+
+	terminatorHealthChecks *terminator_health.Manager
+	terminatorLeases       *terminator_lease.Manager
 }
 
 func (self *Router) GetRouterId() *identity.TokenId {
@@ -113,6 +119,14 @@ func (self *Router) GetMetricsRegistry() metrics.UsageRegistry {
 	return self.metricsRegistry
 }
 
+func (self *Router) GetTerminatorHealthChecks() *terminator_health.Manager {
+	return self.terminatorHealthChecks
+}
+
+func (self *Router) GetTerminatorLeases() *terminator_lease.Manager {
+	return self.terminatorLeases
+}
+
 func (self *Router) Channel() channel.Channel {
 	// if we're just starting up, we may be nil. wait till initialized
 	// The initial control channel connect has a timeout, so if that timeouts the process will exit
@@ -125,6 +139,22 @@ func (self *Router) Channel() channel.Channel {
 	return self.ctrl.Load()
 }
 
+// reportCircuits sends the forward tables for all circuits on this router to the controller, so that a controller
+// which has restarted can rebuild the circuits it no longer knows about
+func (self *Router) reportCircuits() {
+	report := self.forwarder.ReportCircuits()
+	if len(report.Circuits) == 0 {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("circuitCount", len(report.Circuits))
+	if err := protobufs.MarshalTyped(report).Send(self.Channel()); err != nil {
+		log.WithError(err).Error("error reporting circuits to controller")
+	} else {
+		log.Info("reported circuits to controller")
+	}
+}
+
 func (self *Router) DefaultRequestTimeout() time.Duration {
 	return self.config.Ctrl.DefaultRequestTimeout
 }
@@ -143,7 +173,7 @@ func Create(config *Config, versionProvider versions.VersionProvider) *Router {
 	xgress.InitAcker(fwd, metricsRegistry, closeNotify)
 	xgress.InitRetransmitter(fwd, fwd, metricsRegistry, closeNotify)
 
-	return &Router{
+	router := &Router{
 		config:              config,
 		faulter:             faulter,
 		scanner:             scanner,
@@ -156,6 +186,9 @@ func Create(config *Config, versionProvider versions.VersionProvider) *Router {
 		xwebFactoryRegistry: xweb.NewRegistryMap(),
 		xlinkRegistry:       NewLinkRegistry(),
 	}
+	router.terminatorHealthChecks = terminator_health.NewManager(router)
+	router.terminatorLeases = terminator_lease.NewManager(router)
+	return router
 }
 
 func (self *Router) RegisterXctrl(x xctrl.Xctrl) error {
@@ -208,6 +241,8 @@ func (self *Router) Start() error {
 		go web.Run()
 	}
 
+	go self.terminatorLeases.Run()
+
 	if err := self.startControlPlane(); err != nil {
 		return err
 	}
@@ -438,6 +473,7 @@ func (self *Router) startControlPlane() error {
 		for _, x := range self.xctrls {
 			go x.NotifyOfReconnect()
 		}
+		go self.reportCircuits()
 	}
 
 	if "" != self.config.Ctrl.LocalBinding {
@@ -0,0 +1,242 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package terminator_health
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+type Env interface {
+	xgress.CtrlChannel
+	GetDialerCfg() map[string]xgress.OptionsData
+	GetCloseNotify() <-chan struct{}
+}
+
+// Manager runs the health checks configured for the terminators hosted by this router and reports the results to
+// the controller
+type Manager struct {
+	env    Env
+	lock   sync.Mutex
+	checks map[string]*check
+}
+
+func NewManager(env Env) *Manager {
+	return &Manager{
+		env:    env,
+		checks: map[string]*check{},
+	}
+}
+
+// Sync applies a set of health check changes sent by the controller
+func (self *Manager) Sync(msg *ctrl_pb.TerminatorHealthChecks) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	current := map[string]struct{}{}
+	for _, config := range msg.Checks {
+		current[config.TerminatorId] = struct{}{}
+		if existing, found := self.checks[config.TerminatorId]; found {
+			if proto.Equal(existing.config, config) {
+				continue
+			}
+			existing.stop()
+		}
+		c := &check{
+			manager: self,
+			config:  config,
+			closeC:  make(chan struct{}),
+		}
+		self.checks[config.TerminatorId] = c
+		go c.run()
+	}
+
+	for _, terminatorId := range msg.RemovedTerminatorIds {
+		self.removeUnlocked(terminatorId)
+	}
+
+	if msg.FullSync {
+		for terminatorId := range self.checks {
+			if _, found := current[terminatorId]; !found {
+				self.removeUnlocked(terminatorId)
+			}
+		}
+	}
+}
+
+func (self *Manager) removeUnlocked(terminatorId string) {
+	if c, found := self.checks[terminatorId]; found {
+		c.stop()
+		delete(self.checks, terminatorId)
+	}
+}
+
+func (self *Manager) reportStatus(terminatorId string, err error) {
+	status := &ctrl_pb.TerminatorHealthStatus{
+		TerminatorId: terminatorId,
+		Healthy:      err == nil,
+		CheckedAt:    time.Now().UnixMilli(),
+	}
+	if err != nil {
+		status.Message = err.Error()
+	}
+
+	ch := self.env.Channel()
+	if ch == nil {
+		return
+	}
+	if err := protobufs.MarshalTyped(status).Send(ch); err != nil {
+		pfxlog.Logger().WithField("terminatorId", terminatorId).WithError(err).Error("failed to send terminator health status")
+	}
+}
+
+type check struct {
+	manager  *Manager
+	config   *ctrl_pb.TerminatorHealthChecks_Check
+	closeC   chan struct{}
+	stopOnce sync.Once
+}
+
+func (self *check) stop() {
+	self.stopOnce.Do(func() {
+		close(self.closeC)
+	})
+}
+
+func (self *check) run() {
+	log := pfxlog.Logger().WithField("terminatorId", self.config.TerminatorId).WithField("type", self.config.Type)
+	log.Debug("starting terminator health check")
+	defer log.Debug("stopping terminator health check")
+
+	interval := time.Duration(self.config.Interval)
+	if interval < xt.MinHealthCheckInterval {
+		interval = xt.DefaultHealthCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := self.check()
+		select {
+		case <-self.closeC:
+			return
+		case <-self.manager.env.GetCloseNotify():
+			return
+		default:
+		}
+
+		if err != nil {
+			log.WithError(err).Debug("terminator health check failed")
+		}
+		self.manager.reportStatus(self.config.TerminatorId, err)
+
+		select {
+		case <-ticker.C:
+		case <-self.closeC:
+			return
+		case <-self.manager.env.GetCloseNotify():
+			return
+		}
+	}
+}
+
+func (self *check) check() error {
+	timeout := time.Duration(self.config.Timeout)
+	if timeout <= 0 {
+		timeout = xt.DefaultHealthCheckTimeout
+	}
+
+	switch self.config.Type {
+	case xt.HealthCheckTypeTcp:
+		return self.checkTcp(timeout)
+	case xt.HealthCheckTypeHttp:
+		return self.checkHttp(timeout)
+	case xt.HealthCheckTypeBinding:
+		return self.checkBinding(timeout)
+	default:
+		return errors.Errorf("unsupported health check type [%v]", self.config.Type)
+	}
+}
+
+func (self *check) checkTcp(timeout time.Duration) error {
+	address := self.config.Address
+	if address == "" {
+		address = self.config.TerminatorAddress
+	}
+	address = strings.TrimPrefix(address, "tcp:")
+
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (self *check) checkHttp(timeout time.Duration) error {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(self.config.Address)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	expectedStatus := int(self.config.ExpectedStatus)
+	if expectedStatus == 0 {
+		expectedStatus = xt.DefaultHealthCheckExpectedStatus
+	}
+	if resp.StatusCode != expectedStatus {
+		return fmt.Errorf("unexpected http status %v, expected %v", resp.StatusCode, expectedStatus)
+	}
+	return nil
+}
+
+func (self *check) checkBinding(timeout time.Duration) error {
+	binding := self.config.Binding
+	factory, err := xgress.GlobalRegistry().Factory(binding)
+	if err != nil {
+		return errors.Wrapf(err, "no xgress factory for binding [%v]", binding)
+	}
+
+	dialer, err := factory.CreateDialer(self.manager.env.GetDialerCfg()[binding])
+	if err != nil {
+		return errors.Wrapf(err, "unable to create dialer for binding [%v]", binding)
+	}
+
+	healthCheckingDialer, ok := dialer.(xgress.HealthCheckingDialer)
+	if !ok {
+		return errors.Errorf("binding [%v] does not support health checks", binding)
+	}
+
+	address := self.config.Address
+	if address == "" {
+		address = self.config.TerminatorAddress
+	}
+	return healthCheckingDialer.CheckTerminatorHealth(self.config.TerminatorId, address, timeout)
+}
@@ -0,0 +1,134 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package terminator_health
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	closeNotify chan struct{}
+}
+
+func (self *testEnv) Channel() channel.Channel {
+	return nil
+}
+
+func (self *testEnv) DefaultRequestTimeout() time.Duration {
+	return time.Second
+}
+
+func (self *testEnv) GetDialerCfg() map[string]xgress.OptionsData {
+	return nil
+}
+
+func (self *testEnv) GetCloseNotify() <-chan struct{} {
+	return self.closeNotify
+}
+
+func TestTcpCheck(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+
+	c := &check{config: &ctrl_pb.TerminatorHealthChecks_Check{
+		Type:              xt.HealthCheckTypeTcp,
+		TerminatorAddress: "tcp:" + listener.Addr().String(),
+	}}
+	req.NoError(c.check())
+
+	req.NoError(listener.Close())
+	req.Error(c.check())
+}
+
+func TestHttpCheck(t *testing.T) {
+	req := require.New(t)
+
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	c := &check{config: &ctrl_pb.TerminatorHealthChecks_Check{
+		Type:    xt.HealthCheckTypeHttp,
+		Address: server.URL,
+	}}
+	req.NoError(c.check())
+
+	status = http.StatusServiceUnavailable
+	req.EqualError(c.check(), "unexpected http status 503, expected 200")
+
+	c.config.ExpectedStatus = http.StatusServiceUnavailable
+	req.NoError(c.check())
+}
+
+func TestManagerSync(t *testing.T) {
+	req := require.New(t)
+
+	env := &testEnv{closeNotify: make(chan struct{})}
+	defer close(env.closeNotify)
+
+	newCheck := func(id string) *ctrl_pb.TerminatorHealthChecks_Check {
+		return &ctrl_pb.TerminatorHealthChecks_Check{
+			TerminatorId:      id,
+			Type:              xt.HealthCheckTypeTcp,
+			TerminatorAddress: "tcp:127.0.0.1:1",
+			Interval:          int64(time.Minute),
+		}
+	}
+
+	manager := NewManager(env)
+	manager.Sync(&ctrl_pb.TerminatorHealthChecks{
+		Checks: []*ctrl_pb.TerminatorHealthChecks_Check{newCheck("t1"), newCheck("t2")},
+	})
+	req.Len(manager.checks, 2)
+	t1 := manager.checks["t1"]
+
+	// unchanged checks keep running, changed checks are restarted
+	changed := newCheck("t2")
+	changed.Interval = int64(2 * time.Minute)
+	manager.Sync(&ctrl_pb.TerminatorHealthChecks{
+		Checks: []*ctrl_pb.TerminatorHealthChecks_Check{newCheck("t1"), changed},
+	})
+	req.Same(t1, manager.checks["t1"])
+	req.Equal(changed.Interval, manager.checks["t2"].config.Interval)
+
+	manager.Sync(&ctrl_pb.TerminatorHealthChecks{
+		RemovedTerminatorIds: []string{"t1"},
+	})
+	req.Len(manager.checks, 1)
+	req.NotContains(manager.checks, "t1")
+
+	manager.Sync(&ctrl_pb.TerminatorHealthChecks{
+		Checks:   []*ctrl_pb.TerminatorHealthChecks_Check{newCheck("t3")},
+		FullSync: true,
+	})
+	req.Len(manager.checks, 1)
+	req.Contains(manager.checks, "t3")
+}
@@ -0,0 +1,132 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package terminator_lease
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+)
+
+// RenewalCheckInterval is how often the manager checks for leases which are due for renewal
+const RenewalCheckInterval = time.Second
+
+type Env interface {
+	xgress.CtrlChannel
+	GetCloseNotify() <-chan struct{}
+}
+
+type lease struct {
+	ttl         time.Duration
+	nextRenewal time.Time
+}
+
+// Manager renews the leases of terminators hosted by this router. Leases are renewed when a third of the ttl has
+// passed, and all leases due at the same time are renewed with a single message to the controller. Components which
+// create leased terminators add them once created, and remove them when the terminator is no longer needed.
+type Manager struct {
+	env    Env
+	lock   sync.Mutex
+	leases map[string]*lease
+}
+
+func NewManager(env Env) *Manager {
+	return &Manager{
+		env:    env,
+		leases: map[string]*lease{},
+	}
+}
+
+// Add starts renewing the lease of the given terminator
+func (self *Manager) Add(terminatorId string, ttl time.Duration) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.leases[terminatorId] = &lease{
+		ttl:         ttl,
+		nextRenewal: time.Now().Add(ttl / 3),
+	}
+}
+
+// Remove stops renewing the lease of the given terminator, letting it expire
+func (self *Manager) Remove(terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.leases, terminatorId)
+}
+
+func (self *Manager) Run() {
+	ticker := time.NewTicker(RenewalCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			self.renew(now)
+		case <-self.env.GetCloseNotify():
+			return
+		}
+	}
+}
+
+func (self *Manager) renew(now time.Time) {
+	terminatorIds := self.getDue(now)
+	if len(terminatorIds) == 0 {
+		return
+	}
+
+	ch := self.env.Channel()
+	if ch == nil {
+		return
+	}
+
+	msg := &ctrl_pb.TerminatorLeaseRenewals{TerminatorIds: terminatorIds}
+	if err := protobufs.MarshalTyped(msg).Send(ch); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to send terminator lease renewals, will retry")
+		return
+	}
+
+	self.markRenewed(terminatorIds, now)
+}
+
+// getDue returns the terminators whose leases are due for renewal
+func (self *Manager) getDue(now time.Time) []string {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []string
+	for terminatorId, l := range self.leases {
+		if !now.Before(l.nextRenewal) {
+			result = append(result, terminatorId)
+		}
+	}
+	return result
+}
+
+func (self *Manager) markRenewed(terminatorIds []string, now time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, terminatorId := range terminatorIds {
+		if l, found := self.leases[terminatorId]; found {
+			l.nextRenewal = now.Add(l.ttl / 3)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package terminator_lease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/channel"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	closeNotify chan struct{}
+}
+
+func (self *testEnv) Channel() channel.Channel {
+	return nil
+}
+
+func (self *testEnv) DefaultRequestTimeout() time.Duration {
+	return time.Second
+}
+
+func (self *testEnv) GetCloseNotify() <-chan struct{} {
+	return self.closeNotify
+}
+
+func TestLeaseRenewalBatching(t *testing.T) {
+	req := require.New(t)
+
+	manager := NewManager(&testEnv{closeNotify: make(chan struct{})})
+	manager.Add("t1", 30*time.Second)
+	manager.Add("t2", 30*time.Second)
+	manager.Add("t3", 3*time.Minute)
+
+	now := time.Now()
+	req.Empty(manager.getDue(now))
+
+	// leases are renewed once a third of the ttl has passed
+	due := manager.getDue(now.Add(10 * time.Second))
+	req.ElementsMatch([]string{"t1", "t2"}, due)
+
+	manager.markRenewed(due, now.Add(10*time.Second))
+	req.Empty(manager.getDue(now.Add(15 * time.Second)))
+	req.ElementsMatch([]string{"t1", "t2"}, manager.getDue(now.Add(20*time.Second)))
+
+	manager.Remove("t1")
+	req.ElementsMatch([]string{"t2", "t3"}, manager.getDue(now.Add(time.Minute)))
+
+	// without a controller connection, due leases stay due
+	manager.renew(now.Add(time.Minute))
+	req.ElementsMatch([]string{"t2", "t3"}, manager.getDue(now.Add(time.Minute)))
+}
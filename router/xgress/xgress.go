@@ -71,6 +71,13 @@ type Dialer interface {
 	IsTerminatorValid(id string, destination string) bool
 }
 
+// HealthCheckingDialer is implemented by dialers which can check the health of the terminators they host. It's
+// used for terminators with the binding health check type
+type HealthCheckingDialer interface {
+	Dialer
+	CheckTerminatorHealth(terminatorId string, address string, timeout time.Duration) error
+}
+
 type Factory interface {
 	CreateListener(optionsData OptionsData) (Listener, error)
 	CreateDialer(optionsData OptionsData) (Dialer, error)
@@ -50,6 +50,20 @@ func newDialer(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Op
 	return txd, nil
 }
 
+// CheckTerminatorHealth verifies that a connection can be made to the terminator address
+func (txd *dialer) CheckTerminatorHealth(terminatorId string, address string, timeout time.Duration) error {
+	txDestination, err := transport.ParseAddress(address)
+	if err != nil {
+		return errors.Wrapf(err, "invalid terminator address [%s]", address)
+	}
+
+	conn, err := txDestination.Dial("health/"+terminatorId, txd.id, timeout, txd.tcfg)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 func (txd *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 	destination := params.GetDestination()
 	circuitId := params.GetCircuitId()